@@ -0,0 +1,32 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                          = "parent.Spec.Namespace"
+	CRDRedisRedisRedisOpstreelabsIn             = "redis.redis.redis.opstreelabs.in"
+	CRDRedisclustersRedisRedisOpstreelabsIn     = "redisclusters.redis.redis.opstreelabs.in"
+	CRDRedisreplicationsRedisRedisOpstreelabsIn = "redisreplications.redis.redis.opstreelabs.in"
+	CRDRedissentinelsRedisRedisOpstreelabsIn    = "redissentinels.redis.redis.opstreelabs.in"
+	ServiceAccountNamespaceRedisOperator        = "redis-operator"
+	ClusterRoleRedisOperator                    = "redis-operator"
+	ClusterRoleBindingRedisOperator             = "redis-operator"
+	DeploymentNamespaceRedisOperator            = "redis-operator"
+)
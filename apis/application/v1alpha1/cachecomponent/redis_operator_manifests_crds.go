@@ -0,0 +1,308 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/cachecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDRedisRedisRedisOpstreelabsIn creates the CustomResourceDefinition resource with name redis.redis.redis.opstreelabs.in.
+func CreateCRDRedisRedisRedisOpstreelabsIn(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redis.redis.redis.opstreelabs.in",
+			},
+			"spec": map[string]interface{}{
+				"group": "redis.redis.opstreelabs.in",
+				"names": map[string]interface{}{
+					"kind":     "Redis",
+					"listKind": "RedisList",
+					"plural":   "redis",
+					"singular": "redis",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDRedisRedisRedisOpstreelabsIn(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDRedisclustersRedisRedisOpstreelabsIn creates the CustomResourceDefinition resource with name redisclusters.redis.redis.opstreelabs.in.
+func CreateCRDRedisclustersRedisRedisOpstreelabsIn(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redisclusters.redis.redis.opstreelabs.in",
+			},
+			"spec": map[string]interface{}{
+				"group": "redis.redis.opstreelabs.in",
+				"names": map[string]interface{}{
+					"kind":     "RedisCluster",
+					"listKind": "RedisClusterList",
+					"plural":   "redisclusters",
+					"singular": "rediscluster",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDRedisclustersRedisRedisOpstreelabsIn(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDRedisreplicationsRedisRedisOpstreelabsIn creates the CustomResourceDefinition resource with name redisreplications.redis.redis.opstreelabs.in.
+func CreateCRDRedisreplicationsRedisRedisOpstreelabsIn(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redisreplications.redis.redis.opstreelabs.in",
+			},
+			"spec": map[string]interface{}{
+				"group": "redis.redis.opstreelabs.in",
+				"names": map[string]interface{}{
+					"kind":     "RedisReplication",
+					"listKind": "RedisReplicationList",
+					"plural":   "redisreplications",
+					"singular": "redisreplication",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDRedisreplicationsRedisRedisOpstreelabsIn(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDRedissentinelsRedisRedisOpstreelabsIn creates the CustomResourceDefinition resource with name redissentinels.redis.redis.opstreelabs.in.
+func CreateCRDRedissentinelsRedisRedisOpstreelabsIn(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redissentinels.redis.redis.opstreelabs.in",
+			},
+			"spec": map[string]interface{}{
+				"group": "redis.redis.opstreelabs.in",
+				"names": map[string]interface{}{
+					"kind":     "RedisSentinel",
+					"listKind": "RedisSentinelList",
+					"plural":   "redissentinels",
+					"singular": "redissentinel",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDRedissentinelsRedisRedisOpstreelabsIn(resourceObj, parent, collection, reconciler, req)
+}
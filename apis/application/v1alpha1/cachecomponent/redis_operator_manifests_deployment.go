@@ -0,0 +1,125 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/cachecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceRedisOperator creates the Deployment resource with name redis-operator.
+func CreateDeploymentNamespaceRedisOperator(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+					"app.kubernetes.io/name":          "redis-operator",
+				},
+				"name":      "redis-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: redisOperator.replicas
+				//  Number of replicas to use for the redis operator deployment.
+				"replicas": parent.Spec.RedisOperator.Replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "redis-operator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"application.nukleros.io/group":   "cache",
+							"application.nukleros.io/project": "redis-operator",
+							"app.kubernetes.io/name":          "redis-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "redis-operator",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "redis-operator",
+								// controlled by field: redisOperator.image
+								// controlled by field: redisOperator.version
+								//  Image repo and name to use for the redis operator.
+								//  Version of the redis operator to use.
+								"image":           "" + parent.Spec.RedisOperator.Image + ":" + parent.Spec.RedisOperator.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/manager",
+								},
+								"args": []interface{}{
+									"--leader-elect",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "WATCH_NAMESPACE",
+										"value": "",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "500m",
+										"memory": "500Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsNonRoot":             true,
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+							},
+						},
+						"terminationGracePeriodSeconds": 10,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceRedisOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/cachecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceRedisOperator creates the ServiceAccount resource with name redis-operator.
+func CreateServiceAccountNamespaceRedisOperator(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name":      "redis-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceRedisOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redis,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redis/finalizers,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redis/status,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisclusters,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisclusters/finalizers,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisclusters/status,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisreplications,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisreplications/finalizers,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisreplications/status,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redissentinels,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redissentinels/finalizers,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redissentinels/status,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;delete;get;list;patch;update;watch
+
+// CreateClusterRoleRedisOperator creates the ClusterRole resource with name redis-operator.
+func CreateClusterRoleRedisOperator(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redis-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"redis.redis.opstreelabs.in",
+					},
+					"resources": []interface{}{
+						"redis",
+						"redis/finalizers",
+						"redis/status",
+						"redisclusters",
+						"redisclusters/finalizers",
+						"redisclusters/status",
+						"redisreplications",
+						"redisreplications/finalizers",
+						"redisreplications/status",
+						"redissentinels",
+						"redissentinels/finalizers",
+						"redissentinels/status",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"configmaps",
+						"events",
+						"persistentvolumeclaims",
+						"pods",
+						"secrets",
+						"services",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods/exec",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"statefulsets",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"policy",
+					},
+					"resources": []interface{}{
+						"poddisruptionbudgets",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleRedisOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingRedisOperator creates the ClusterRoleBinding resource with name redis-operator.
+func CreateClusterRoleBindingRedisOperator(
+	parent *applicationv1alpha1.CacheComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis-operator",
+				},
+				"name": "redis-operator",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "redis-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "redis-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingRedisOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertCacheComponent = errors.New("unable to convert to CacheComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// CacheComponentSpec defines the desired state of CacheComponent.
+type CacheComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection CacheComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-cache-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-cache-system")
+	//
+	//	Namespace to use for cache support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	RedisOperator CacheComponentSpecRedisOperator `json:"redisOperator,omitempty"`
+}
+
+type CacheComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type CacheComponentSpecRedisOperator struct {
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	// (Default: 1)
+	//
+	//	Number of replicas to use for the redis operator deployment.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:default="quay.io/opstree/redis-operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/opstree/redis-operator")
+	//
+	//	Image repo and name to use for the redis operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the redis operator image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v0.14.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v0.14.0")
+	//
+	//	Version of the redis operator to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the redis operator pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the redis operator container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// CacheComponentStatus defines the observed state of CacheComponent.
+type CacheComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// CacheComponent is the Schema for the cachecomponents API.
+type CacheComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CacheComponentSpec   `json:"spec,omitempty"`
+	Status            CacheComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheComponentList contains a list of CacheComponent.
+type CacheComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *CacheComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *CacheComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *CacheComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *CacheComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *CacheComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *CacheComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *CacheComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *CacheComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *CacheComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*CacheComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*CacheComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("CacheComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *CacheComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *CacheComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *CacheComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	// the redis operator elects a leader, so it is not replicated by the tier
+	return map[string]int{
+		"redis-operator": component.Spec.RedisOperator.Replicas,
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&CacheComponent{}, &CacheComponentList{})
+}
@@ -28,4 +28,5 @@ const (
 	ClusterRoleBindingPostgresOperator      = "postgres-operator"
 	ClusterRolePostgresPod                  = "postgres-pod"
 	ServiceNamespacePostgresOperator        = "postgres-operator"
+	PrometheusRuleNamespacePostgresOperator = "postgres-operator"
 )
@@ -0,0 +1,93 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databasecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/databasecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespacePostgresOperator creates the PrometheusRule resource with name postgres-operator.
+func CreatePrometheusRuleNamespacePostgresOperator(
+	parent *applicationv1alpha1.DatabaseComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "postgres-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"application":            "postgres-operator",
+					"app.kubernetes.io/name": "postgres-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "postgres-operator",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "PostgresOperatorUnavailable",
+								// controlled by field: namespace
+								"expr": "kube_deployment_status_replicas_available{namespace=\"" + parent.Spec.Namespace + "\", deployment=\"postgres-operator\"} < 1",
+								"for":  "10m",
+								"labels": map[string]interface{}{
+									"severity": "critical",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "The postgres-operator is unavailable.",
+									"description": "The postgres-operator has been unavailable for 10 minutes, so postgres clusters are not reconciled.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "PostgresOperatorRestarting",
+								// controlled by field: namespace
+								"expr": "increase(kube_pod_container_status_restarts_total{namespace=\"" + parent.Spec.Namespace + "\", container=\"postgres-operator\"}[30m]) > 2",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "The postgres-operator is restarting.",
+									"description": "The postgres-operator has restarted more than twice in 30 minutes, e.g. because it fails to reconcile a postgres cluster.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespacePostgresOperator(resourceObj, parent, collection, reconciler, req)
+}
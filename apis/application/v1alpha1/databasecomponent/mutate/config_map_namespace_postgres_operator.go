@@ -17,26 +17,101 @@ limitations under the License.
 package mutate
 
 import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 
 	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
 )
 
+// operatorImageKeys are the keys of the postgres operator configuration which hold images that
+// the operator uses when creating database clusters.
+var operatorImageKeys = []string{
+	"docker_image",
+	"connection_pooler_image",
+	"logical_backup_docker_image",
+}
+
 // MutateConfigMapNamespacePostgresOperator mutates the ConfigMap resource with name postgres-operator.
 func MutateConfigMapNamespacePostgresOperator(
 	original client.Object,
 	parent *applicationv1alpha1.DatabaseComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// images in the operator configuration are pulled by pods which the postgres operator
+	// creates, so the registry mirrors are applied here rather than on a pod template.
+	if err := setOperatorImages(original, collection); err != nil {
+		return nil, err
+	}
+
+	if err := setDebugLogging(original, collection); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// write the logical backups to the object storage of an object storage component, if
+	// referenced.
+	if err := setLogicalBackupStorage(original, parent, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
 }
+
+// setOperatorImages rewrites the images of the postgres operator configuration to use the registry
+// mirrors of the collection.
+func setOperatorImages(original client.Object, collection *setupv1alpha1.SupportServices) error {
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, ok := configMap.Object["data"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, key := range operatorImageKeys {
+		if image, ok := data[key].(string); ok {
+			data[key] = podtemplate.MirrorImage(image, collection.Spec.RegistryMirrors)
+		}
+	}
+
+	return nil
+}
+
+// setDebugLogging enables debug logging of the postgres operator when the tier of the collection
+// logs at the debug level.
+func setDebugLogging(original client.Object, collection *setupv1alpha1.SupportServices) error {
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, ok := configMap.Object["data"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	data["debug_logging"] = strconv.FormatBool(profile.LogLevel == tier.LogLevelDebug)
+
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 
 	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
 )
 
 // MutateDeploymentNamespacePostgresOperator mutates the Deployment resource with name postgres-operator.
@@ -31,11 +32,36 @@ func MutateDeploymentNamespacePostgresOperator(
 	parent *applicationv1alpha1.DatabaseComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "postgres-operator", parent.Spec.ZalandoPostgres.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, parent.Spec.ZalandoPostgres.Scheduling); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "postgres-operator", parent.Spec.ZalandoPostgres.Resources); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	// restart the postgres operator when the object storage of the logical backups changes.
+	if err := setLogicalBackupHash(original, parent, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
@@ -0,0 +1,118 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/objectstorage"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// logicalBackupSettings returns the settings of the postgres operator configuration which write
+// the logical backups to the bucket of the referenced object storage component, or nil if the
+// logical backups do not reference one.  The postgres operator only reads the credentials of the
+// bucket from its configuration, so they are copied from the bucket credentials secret which the
+// object storage component publishes in the namespace of this component.
+func logicalBackupSettings(
+	parent *applicationv1alpha1.DatabaseComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) (map[string]string, error) {
+	backup := parent.Spec.ZalandoPostgres.LogicalBackup
+	if backup.ObjectStorage == "" {
+		return nil, nil
+	}
+
+	component, err := objectstorage.Get(reconciler, req, backup.ObjectStorage, backup.Bucket, parent.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: platformv1alpha1.BucketCredentialsSecret(backup.Bucket), Namespace: parent.Spec.Namespace}
+
+	if err := reconciler.Get(req.Context, name, secret); err != nil {
+		return nil, fmt.Errorf("unable to get bucket credentials secret %s, %w", name, err)
+	}
+
+	return map[string]string{
+		"logical_backup_provider":             "s3",
+		"logical_backup_s3_bucket":            backup.Bucket,
+		"logical_backup_s3_endpoint":          component.Endpoint(),
+		"logical_backup_s3_region":            platformv1alpha1.ObjectStorageRegion,
+		"logical_backup_s3_access_key_id":     string(secret.Data["AWS_ACCESS_KEY_ID"]),
+		"logical_backup_s3_secret_access_key": string(secret.Data["AWS_SECRET_ACCESS_KEY"]),
+		// minio only encrypts objects on the server side when it is configured with a key
+		// management service, so server side encryption must not be requested.
+		"logical_backup_s3_sse": "",
+	}, nil
+}
+
+// setLogicalBackupStorage sets the object storage of the logical backups in the postgres operator
+// configuration, if the logical backups reference an object storage component.
+func setLogicalBackupStorage(
+	original client.Object,
+	parent *applicationv1alpha1.DatabaseComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	settings, err := logicalBackupSettings(parent, reconciler, req)
+	if err != nil || settings == nil {
+		return err
+	}
+
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, ok := configMap.Object["data"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("postgres operator configuration %s has no data", original.GetName())
+	}
+
+	for key, value := range settings {
+		data[key] = value
+	}
+
+	return nil
+}
+
+// setLogicalBackupHash annotates the pods of the postgres operator with the hash of the object
+// storage settings of the logical backups, if any.  The postgres operator only reads its
+// configuration on startup, so it is restarted whenever the settings, e.g. the credentials of the
+// bucket, change.
+func setLogicalBackupHash(
+	original client.Object,
+	parent *applicationv1alpha1.DatabaseComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	settings, err := logicalBackupSettings(parent, reconciler, req)
+	if err != nil || settings == nil {
+		return err
+	}
+
+	return podtemplate.SetConfigHash(original, settings)
+}
@@ -41,7 +41,11 @@ spec:
   zalandoPostgres:
     replicas: 1
     image: "registry.opensource.zalan.do/acid/postgres-operator"
+    #digest: ""
     version: "v1.8.2"
+  monitoring:
+    enabled: false
+    alerts: false
 `
 
 // sampleDatabaseComponentRequired is a sample containing only required fields
@@ -128,6 +132,7 @@ var CreateFuncs = []func(
 	CreateClusterRoleBindingPostgresOperator,
 	CreateClusterRolePostgresPod,
 	CreateServiceNamespacePostgresOperator,
+	CreatePrometheusRuleNamespacePostgresOperator,
 }
 
 // InitFuncs is an array of functions that are called prior to starting the controller manager.  This is
@@ -21,8 +21,12 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 )
 
 var ErrUnableToConvertDatabaseComponent = errors.New("unable to convert to DatabaseComponent")
@@ -52,6 +56,12 @@ type DatabaseComponentSpec struct {
 
 	// +kubebuilder:validation:Optional
 	ZalandoPostgres DatabaseComponentSpecZalandoPostgres `json:"zalandoPostgres,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Monitoring resources for postgres-operator.  Requires the prometheus-operator custom resource
+	//	definitions, e.g. from the monitoring component.  The postgres-operator does not export
+	//	metrics, so only alerts are generated, which are based on the metrics of kube-state-metrics.
+	Monitoring setupv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
 }
 
 type DatabaseComponentCollectionSpec struct {
@@ -81,12 +91,46 @@ type DatabaseComponentSpecZalandoPostgres struct {
 	//	Image repo and name to use for postgres operator.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the postgres operator image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="v1.8.2"
 	// +kubebuilder:validation:Optional
 	// (Default: "v1.8.2")
 	//
 	//	Version of postgres operator to use.
 	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the postgres operator pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the postgres operator container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Object storage to write the logical backups of the database clusters to.  Logical backups
+	//	are enabled for each database cluster with the enableLogicalBackup field of the cluster.
+	LogicalBackup DatabaseComponentSpecZalandoPostgresLogicalBackup `json:"logicalBackup,omitempty"`
+}
+
+type DatabaseComponentSpecZalandoPostgresLogicalBackup struct {
+	// +kubebuilder:validation:Optional
+	//	Name of an ObjectStorageComponent whose object storage to write the logical backups to.
+	//	The component must declare the bucket and publish its credentials in the namespace of
+	//	this component.  The reference is resolved when reconciling, so manifests generated from
+	//	the CLI do not configure the object storage of the logical backups.
+	ObjectStorage string `json:"objectStorage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Bucket of the object storage component to write the logical backups to.
+	Bucket string `json:"bucket,omitempty"`
 }
 
 // DatabaseComponentStatus defines the observed state of DatabaseComponent.
@@ -98,6 +142,9 @@ type DatabaseComponentStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -183,8 +230,26 @@ func (component *DatabaseComponent) SetChildResourceCondition(resource *status.C
 	component.Status.Resources = append(component.Status.Resources, resource)
 }
 
-// GetDependencies returns the dependencies for a component.
-func (*DatabaseComponent) GetDependencies() []workload.Workload {
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *DatabaseComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.  Logical backups which are written to
+// the object storage of an ObjectStorageComponent depend on the component.
+func (component *DatabaseComponent) GetDependencies() []workload.Workload {
+	if component.Spec.ZalandoPostgres.LogicalBackup.ObjectStorage != "" {
+		return []workload.Workload{&platformv1alpha1.ObjectStorageComponent{}}
+	}
+
 	return []workload.Workload{}
 }
 
@@ -193,6 +258,25 @@ func (*DatabaseComponent) GetWorkloadGVK() schema.GroupVersionKind {
 	return GroupVersion.WithKind("DatabaseComponent")
 }
 
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *DatabaseComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *DatabaseComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *DatabaseComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	// the postgres operator runs as a single replica, so it is not defaulted from the tier
+	return map[string]int{
+		"postgres-operator": component.Spec.ZalandoPostgres.Replicas,
+	}
+}
+
 func init() {
 	SchemeBuilder.Register(&DatabaseComponent{}, &DatabaseComponentList{})
 }
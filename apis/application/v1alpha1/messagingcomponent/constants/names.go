@@ -0,0 +1,65 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                                               = "parent.Spec.Namespace"
+	CRDRabbitmqclustersRabbitmqCom                                   = "rabbitmqclusters.rabbitmq.com"
+	ServiceAccountNamespaceRabbitmqClusterOperator                   = "rabbitmq-cluster-operator"
+	ClusterRoleRabbitmqClusterOperator                               = "rabbitmq-cluster-operator"
+	ClusterRoleBindingRabbitmqClusterOperator                        = "rabbitmq-cluster-operator"
+	RoleNamespaceRabbitmqClusterLeaderElection                       = "rabbitmq-cluster-leader-election"
+	RoleBindingNamespaceRabbitmqClusterLeaderElection                = "rabbitmq-cluster-leader-election"
+	DeploymentNamespaceRabbitmqClusterOperator                       = "rabbitmq-cluster-operator"
+	CRDNatsclustersNatsIo                                            = "natsclusters.nats.io"
+	CRDNatsservicerolesNatsIo                                        = "natsserviceroles.nats.io"
+	ServiceAccountNamespaceNatsOperator                              = "nats-operator"
+	ClusterRoleNatsOperator                                          = "nats-operator"
+	ClusterRoleBindingNatsOperator                                   = "nats-operator"
+	ServiceAccountNamespaceNatsServer                                = "nats-server"
+	ClusterRoleNatsServer                                            = "nats-server"
+	ClusterRoleBindingNatsServer                                     = "nats-server"
+	DeploymentNamespaceNatsOperator                                  = "nats-operator"
+	CRDKafkasKafkaStrimziIo                                          = "kafkas.kafka.strimzi.io"
+	CRDKafkaconnectsKafkaStrimziIo                                   = "kafkaconnects.kafka.strimzi.io"
+	CRDKafkatopicsKafkaStrimziIo                                     = "kafkatopics.kafka.strimzi.io"
+	CRDKafkausersKafkaStrimziIo                                      = "kafkausers.kafka.strimzi.io"
+	CRDKafkamirrormakersKafkaStrimziIo                               = "kafkamirrormakers.kafka.strimzi.io"
+	CRDKafkabridgesKafkaStrimziIo                                    = "kafkabridges.kafka.strimzi.io"
+	CRDKafkaconnectorsKafkaStrimziIo                                 = "kafkaconnectors.kafka.strimzi.io"
+	CRDKafkamirrormaker2sKafkaStrimziIo                              = "kafkamirrormaker2s.kafka.strimzi.io"
+	CRDKafkarebalancesKafkaStrimziIo                                 = "kafkarebalances.kafka.strimzi.io"
+	CRDStrimzipodsetsCoreStrimziIo                                   = "strimzipodsets.core.strimzi.io"
+	ServiceAccountNamespaceStrimziClusterOperator                    = "strimzi-cluster-operator"
+	ClusterRoleStrimziClusterOperatorNamespaced                      = "strimzi-cluster-operator-namespaced"
+	ClusterRoleStrimziClusterOperatorGlobal                          = "strimzi-cluster-operator-global"
+	ClusterRoleStrimziClusterOperatorLeaderElection                  = "strimzi-cluster-operator-leader-election"
+	ClusterRoleStrimziKafkaBroker                                    = "strimzi-kafka-broker"
+	ClusterRoleStrimziEntityOperator                                 = "strimzi-entity-operator"
+	ClusterRoleStrimziKafkaClient                                    = "strimzi-kafka-client"
+	ClusterRoleBindingStrimziClusterOperatorNamespaced               = "strimzi-cluster-operator-namespaced"
+	ClusterRoleBindingStrimziClusterOperatorGlobal                   = "strimzi-cluster-operator-global"
+	ClusterRoleBindingStrimziClusterOperatorKafkaBrokerDelegation    = "strimzi-cluster-operator-kafka-broker-delegation"
+	ClusterRoleBindingStrimziClusterOperatorEntityOperatorDelegation = "strimzi-cluster-operator-entity-operator-delegation"
+	ClusterRoleBindingStrimziClusterOperatorKafkaClientDelegation    = "strimzi-cluster-operator-kafka-client-delegation"
+	RoleBindingNamespaceStrimziClusterOperatorLeaderElection         = "strimzi-cluster-operator-leader-election"
+	ConfigMapNamespaceStrimziClusterOperator                         = "strimzi-cluster-operator"
+	DeploymentNamespaceStrimziClusterOperator                        = "strimzi-cluster-operator"
+)
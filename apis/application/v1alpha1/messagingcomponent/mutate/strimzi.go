@@ -0,0 +1,95 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+const strimziClusterOperator = "strimzi-cluster-operator"
+
+// strimziOperatorImages are the environment variables of the strimzi cluster operator which hold
+// the images of the operands that are built from the cluster operator image itself.
+var strimziOperatorImages = []string{
+	"STRIMZI_DEFAULT_TOPIC_OPERATOR_IMAGE",
+	"STRIMZI_DEFAULT_USER_OPERATOR_IMAGE",
+	"STRIMZI_DEFAULT_KAFKA_INIT_IMAGE",
+}
+
+// setStrimziImages sets the images which the strimzi cluster operator deploys for its operands.
+// The topic operator, user operator and kafka init images follow the final image of the cluster
+// operator, so that they are mirrored and pinned along with it, while the remaining images, some
+// of which hold a list of version=image pairs, are rewritten to use the registry mirrors.
+func setStrimziImages(original client.Object, collection *setupv1alpha1.SupportServices) error {
+	container, err := podtemplate.Container(original, strimziClusterOperator)
+	if err != nil {
+		return err
+	}
+
+	image, _ := container["image"].(string)
+
+	for _, variable := range strimziOperatorImages {
+		if err := podtemplate.SetEnv(original, strimziClusterOperator, variable, image); err != nil {
+			return err
+		}
+	}
+
+	env, _ := container["env"].([]interface{})
+
+	for i := range env {
+		variable, ok := env[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := variable["name"].(string)
+		value, isString := variable["value"].(string)
+
+		if !isString || !strings.HasSuffix(name, "_IMAGE") && !strings.HasSuffix(name, "_IMAGES") {
+			continue
+		}
+
+		variable["value"] = mirrorImages(value, collection.Spec.RegistryMirrors)
+	}
+
+	return nil
+}
+
+// mirrorImages rewrites an image, or a newline separated list of version=image pairs, to use the
+// given registry mirrors.
+func mirrorImages(value string, mirrors map[string]string) string {
+	lines := strings.Split(value, "\n")
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		if version, image, found := strings.Cut(line, "="); found {
+			lines[i] = version + "=" + podtemplate.MirrorImage(image, mirrors)
+		} else {
+			lines[i] = podtemplate.MirrorImage(line, mirrors)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
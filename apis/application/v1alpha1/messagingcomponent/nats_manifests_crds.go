@@ -0,0 +1,179 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDNatsclustersNatsIo creates the CustomResourceDefinition resource with name natsclusters.nats.io.
+func CreateCRDNatsclustersNatsIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "natsclusters.nats.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "nats.io",
+				"names": map[string]interface{}{
+					"kind":     "NatsCluster",
+					"listKind": "NatsClusterList",
+					"plural":   "natsclusters",
+					"singular": "natscluster",
+					"shortNames": []interface{}{
+						"nats",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDNatsclustersNatsIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDNatsservicerolesNatsIo creates the CustomResourceDefinition resource with name natsserviceroles.nats.io.
+func CreateCRDNatsservicerolesNatsIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "natsserviceroles.nats.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "nats.io",
+				"names": map[string]interface{}{
+					"kind":     "NatsServiceRole",
+					"listKind": "NatsServiceRoleList",
+					"plural":   "natsserviceroles",
+					"singular": "natsservicerole",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDNatsservicerolesNatsIo(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceNatsOperator creates the Deployment resource with name nats-operator.
+func CreateDeploymentNamespaceNatsOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+					"app.kubernetes.io/name":          "nats-operator",
+				},
+				"name":      "nats-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: operator.replicas
+				//  Number of replicas to use for the operator deployment.
+				"replicas": parent.Spec.Operator.Replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "nats-operator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"application.nukleros.io/group":   "messaging",
+							"application.nukleros.io/project": "nats",
+							"app.kubernetes.io/name":          "nats-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "nats-operator",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "nats-operator",
+								// controlled by field: operator.nats.image
+								// controlled by field: operator.nats.version
+								//  Image repo and name to use for the nats operator.
+								//  Version of the nats operator to use.
+								"image":           "" + parent.Spec.Operator.NATS.Image + ":" + parent.Spec.Operator.NATS.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"nats-operator",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "MY_POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "MY_POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "readyz",
+										"containerPort": 8080,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/readyz",
+										"port": "readyz",
+									},
+									"initialDelaySeconds": 15,
+									"timeoutSeconds":      3,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "64Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceNatsOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceNatsOperator creates the ServiceAccount resource with name nats-operator.
+func CreateServiceAccountNamespaceNatsOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name":      "nats-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceNatsOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;create;update;watch
+// +kubebuilder:rbac:groups=nats.io,resources=natsclusters,verbs=*
+// +kubebuilder:rbac:groups=nats.io,resources=natsserviceroles,verbs=*
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=*
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=*
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=*
+// +kubebuilder:rbac:groups=core,resources=services,verbs=*
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=*
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts/token,verbs=*
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=*
+// +kubebuilder:rbac:groups=core,resources=events,verbs=*
+
+// CreateClusterRoleNatsOperator creates the ClusterRole resource with name nats-operator.
+func CreateClusterRoleNatsOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "nats-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apiextensions.k8s.io",
+					},
+					"resources": []interface{}{
+						"customresourcedefinitions",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"create",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"nats.io",
+					},
+					"resources": []interface{}{
+						"natsclusters",
+						"natsserviceroles",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"configmaps",
+						"secrets",
+						"pods",
+						"services",
+						"serviceaccounts",
+						"serviceaccounts/token",
+						"endpoints",
+						"events",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleNatsOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingNatsOperator creates the ClusterRoleBinding resource with name nats-operator.
+func CreateClusterRoleBindingNatsOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "nats-operator",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "nats-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "nats-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingNatsOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceNatsServer creates the ServiceAccount resource with name nats-server.
+func CreateServiceAccountNamespaceNatsServer(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name":      "nats-server",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceNatsServer(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get
+
+// CreateClusterRoleNatsServer creates the ClusterRole resource with name nats-server.
+func CreateClusterRoleNatsServer(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "nats-server",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleNatsServer(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingNatsServer creates the ClusterRoleBinding resource with name nats-server.
+func CreateClusterRoleBindingNatsServer(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "nats" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="nats",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "nats",
+				},
+				"name": "nats-server",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "nats-server",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "nats-server",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingNatsServer(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceRabbitmqClusterOperator creates the Deployment resource with name rabbitmq-cluster-operator.
+func CreateDeploymentNamespaceRabbitmqClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+					"app.kubernetes.io/name":          "rabbitmq-cluster-operator",
+				},
+				"name":      "rabbitmq-cluster-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: operator.replicas
+				//  Number of replicas to use for the operator deployment.
+				"replicas": parent.Spec.Operator.Replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "rabbitmq-cluster-operator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"application.nukleros.io/group":   "messaging",
+							"application.nukleros.io/project": "rabbitmq",
+							"app.kubernetes.io/name":          "rabbitmq-cluster-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "rabbitmq-cluster-operator",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "operator",
+								// controlled by field: operator.rabbitmq.image
+								// controlled by field: operator.rabbitmq.version
+								//  Image repo and name to use for the rabbitmq cluster operator.
+								//  Version of the rabbitmq cluster operator to use.
+								"image":           "" + parent.Spec.Operator.RabbitMQ.Image + ":" + parent.Spec.Operator.RabbitMQ.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/manager",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "OPERATOR_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 9782,
+										"name":          "metrics",
+										"protocol":      "TCP",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "500Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "500Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsNonRoot":             true,
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+							},
+						},
+						"terminationGracePeriodSeconds": 10,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceRabbitmqClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceRabbitmqClusterOperator creates the ServiceAccount resource with name rabbitmq-cluster-operator.
+func CreateServiceAccountNamespaceRabbitmqClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+				},
+				"name":      "rabbitmq-cluster-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceRabbitmqClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;get;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;update;watch
+// +kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=create;delete;get;list;update;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters/status,verbs=get;update
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=create;get;list;update;watch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=create;get;list;update;watch
+
+// CreateClusterRoleRabbitmqClusterOperator creates the ClusterRole resource with name rabbitmq-cluster-operator.
+func CreateClusterRoleRabbitmqClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+				},
+				"name": "rabbitmq-cluster-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"configmaps",
+						"persistentvolumeclaims",
+						"secrets",
+						"serviceaccounts",
+						"services",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"list",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"endpoints",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods/exec",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"statefulsets",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rabbitmq.com",
+					},
+					"resources": []interface{}{
+						"rabbitmqclusters",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"list",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rabbitmq.com",
+					},
+					"resources": []interface{}{
+						"rabbitmqclusters/finalizers",
+					},
+					"verbs": []interface{}{
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rabbitmq.com",
+					},
+					"resources": []interface{}{
+						"rabbitmqclusters/status",
+					},
+					"verbs": []interface{}{
+						"get",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rbac.authorization.k8s.io",
+					},
+					"resources": []interface{}{
+						"rolebindings",
+						"roles",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"list",
+						"update",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleRabbitmqClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingRabbitmqClusterOperator creates the ClusterRoleBinding resource with name rabbitmq-cluster-operator.
+func CreateClusterRoleBindingRabbitmqClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+				},
+				"name": "rabbitmq-cluster-operator",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "rabbitmq-cluster-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "rabbitmq-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingRabbitmqClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;get;list;update
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=create;get;list;update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+
+// CreateRoleNamespaceRabbitmqClusterLeaderElection creates the Role resource with name rabbitmq-cluster-leader-election.
+func CreateRoleNamespaceRabbitmqClusterLeaderElection(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "Role",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+				},
+				"name":      "rabbitmq-cluster-leader-election",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"list",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"configmaps",
+					},
+					"verbs": []interface{}{
+						"create",
+						"get",
+						"list",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleNamespaceRabbitmqClusterLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRoleBindingNamespaceRabbitmqClusterLeaderElection creates the RoleBinding resource with name rabbitmq-cluster-leader-election.
+func CreateRoleBindingNamespaceRabbitmqClusterLeaderElection(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "rabbitmq" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="rabbitmq",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "rabbitmq",
+				},
+				"name":      "rabbitmq-cluster-leader-election",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "Role",
+				"name":     "rabbitmq-cluster-leader-election",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "rabbitmq-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleBindingNamespaceRabbitmqClusterLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
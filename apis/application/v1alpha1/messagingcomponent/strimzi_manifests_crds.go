@@ -0,0 +1,798 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkasKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkas.kafka.strimzi.io.
+func CreateCRDKafkasKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkas.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "Kafka",
+					"listKind": "KafkaList",
+					"plural":   "kafkas",
+					"singular": "kafka",
+					"shortNames": []interface{}{
+						"k",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkasKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkaconnectsKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkaconnects.kafka.strimzi.io.
+func CreateCRDKafkaconnectsKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkaconnects.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaConnect",
+					"listKind": "KafkaConnectList",
+					"plural":   "kafkaconnects",
+					"singular": "kafkaconnect",
+					"shortNames": []interface{}{
+						"kc",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkaconnectsKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkatopicsKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkatopics.kafka.strimzi.io.
+func CreateCRDKafkatopicsKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkatopics.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaTopic",
+					"listKind": "KafkaTopicList",
+					"plural":   "kafkatopics",
+					"singular": "kafkatopic",
+					"shortNames": []interface{}{
+						"kt",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkatopicsKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkausersKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkausers.kafka.strimzi.io.
+func CreateCRDKafkausersKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkausers.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaUser",
+					"listKind": "KafkaUserList",
+					"plural":   "kafkausers",
+					"singular": "kafkauser",
+					"shortNames": []interface{}{
+						"ku",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkausersKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkamirrormakersKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkamirrormakers.kafka.strimzi.io.
+func CreateCRDKafkamirrormakersKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkamirrormakers.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaMirrorMaker",
+					"listKind": "KafkaMirrorMakerList",
+					"plural":   "kafkamirrormakers",
+					"singular": "kafkamirrormaker",
+					"shortNames": []interface{}{
+						"kmm",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkamirrormakersKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkabridgesKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkabridges.kafka.strimzi.io.
+func CreateCRDKafkabridgesKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkabridges.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaBridge",
+					"listKind": "KafkaBridgeList",
+					"plural":   "kafkabridges",
+					"singular": "kafkabridge",
+					"shortNames": []interface{}{
+						"kb",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkabridgesKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkaconnectorsKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkaconnectors.kafka.strimzi.io.
+func CreateCRDKafkaconnectorsKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkaconnectors.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaConnector",
+					"listKind": "KafkaConnectorList",
+					"plural":   "kafkaconnectors",
+					"singular": "kafkaconnector",
+					"shortNames": []interface{}{
+						"kctr",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkaconnectorsKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkamirrormaker2sKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkamirrormaker2s.kafka.strimzi.io.
+func CreateCRDKafkamirrormaker2sKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkamirrormaker2s.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaMirrorMaker2",
+					"listKind": "KafkaMirrorMaker2List",
+					"plural":   "kafkamirrormaker2s",
+					"singular": "kafkamirrormaker2",
+					"shortNames": []interface{}{
+						"kmm2",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkamirrormaker2sKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDKafkarebalancesKafkaStrimziIo creates the CustomResourceDefinition resource with name kafkarebalances.kafka.strimzi.io.
+func CreateCRDKafkarebalancesKafkaStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "kafkarebalances.kafka.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kafka.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "KafkaRebalance",
+					"listKind": "KafkaRebalanceList",
+					"plural":   "kafkarebalances",
+					"singular": "kafkarebalance",
+					"shortNames": []interface{}{
+						"kr",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDKafkarebalancesKafkaStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDStrimzipodsetsCoreStrimziIo creates the CustomResourceDefinition resource with name strimzipodsets.core.strimzi.io.
+func CreateCRDStrimzipodsetsCoreStrimziIo(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzipodsets.core.strimzi.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "core.strimzi.io",
+				"names": map[string]interface{}{
+					"kind":     "StrimziPodSet",
+					"listKind": "StrimziPodSetList",
+					"plural":   "strimzipodsets",
+					"singular": "strimzipodset",
+					"shortNames": []interface{}{
+						"sps",
+					},
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDStrimzipodsetsCoreStrimziIo(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceStrimziClusterOperator creates the Deployment resource with name strimzi-cluster-operator.
+func CreateDeploymentNamespaceStrimziClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+					"app.kubernetes.io/name":          "strimzi-cluster-operator",
+				},
+				"name":      "strimzi-cluster-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: operator.replicas
+				//  Number of replicas to use for the operator deployment.
+				"replicas": parent.Spec.Operator.Replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "strimzi-cluster-operator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"application.nukleros.io/group":   "messaging",
+							"application.nukleros.io/project": "strimzi",
+							"app.kubernetes.io/name":          "strimzi-cluster-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "strimzi-cluster-operator",
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "strimzi-tmp",
+								"emptyDir": map[string]interface{}{
+									"medium":    "Memory",
+									"sizeLimit": "1Mi",
+								},
+							},
+							map[string]interface{}{
+								"name": "co-config-volume",
+								"configMap": map[string]interface{}{
+									"name": "strimzi-cluster-operator",
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "strimzi-cluster-operator",
+								// controlled by field: operator.strimzi.image
+								// controlled by field: operator.strimzi.version
+								//  Image repo and name to use for the strimzi cluster operator.
+								//  Version of the strimzi cluster operator to use.
+								"image":           "" + parent.Spec.Operator.Strimzi.Image + ":" + parent.Spec.Operator.Strimzi.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"/opt/strimzi/bin/cluster_operator_run.sh",
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "strimzi-tmp",
+										"mountPath": "/tmp",
+									},
+									map[string]interface{}{
+										"name":      "co-config-volume",
+										"mountPath": "/opt/strimzi/custom-config/",
+									},
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "STRIMZI_NAMESPACE",
+										"value": "*",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_FULL_RECONCILIATION_INTERVAL_MS",
+										"value": "120000",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_OPERATION_TIMEOUT_MS",
+										"value": "300000",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_TLS_SIDECAR_ENTITY_OPERATOR_IMAGE",
+										"value": "quay.io/strimzi/kafka:0.33.2-kafka-3.4.0",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_KAFKA_EXPORTER_IMAGE",
+										"value": "quay.io/strimzi/kafka:0.33.2-kafka-3.4.0",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_CRUISE_CONTROL_IMAGE",
+										"value": "quay.io/strimzi/kafka:0.33.2-kafka-3.4.0",
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_KAFKA_IMAGES",
+										"value": `3.3.1=quay.io/strimzi/kafka:0.33.2-kafka-3.3.1
+3.3.2=quay.io/strimzi/kafka:0.33.2-kafka-3.3.2
+3.4.0=quay.io/strimzi/kafka:0.33.2-kafka-3.4.0
+`,
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_KAFKA_CONNECT_IMAGES",
+										"value": `3.3.1=quay.io/strimzi/kafka:0.33.2-kafka-3.3.1
+3.3.2=quay.io/strimzi/kafka:0.33.2-kafka-3.3.2
+3.4.0=quay.io/strimzi/kafka:0.33.2-kafka-3.4.0
+`,
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_KAFKA_MIRROR_MAKER_IMAGES",
+										"value": `3.3.1=quay.io/strimzi/kafka:0.33.2-kafka-3.3.1
+3.3.2=quay.io/strimzi/kafka:0.33.2-kafka-3.3.2
+3.4.0=quay.io/strimzi/kafka:0.33.2-kafka-3.4.0
+`,
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_KAFKA_MIRROR_MAKER_2_IMAGES",
+										"value": `3.3.1=quay.io/strimzi/kafka:0.33.2-kafka-3.3.1
+3.3.2=quay.io/strimzi/kafka:0.33.2-kafka-3.3.2
+3.4.0=quay.io/strimzi/kafka:0.33.2-kafka-3.4.0
+`,
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_TOPIC_OPERATOR_IMAGE",
+										"value": "quay.io/strimzi/operator:0.33.2",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_USER_OPERATOR_IMAGE",
+										"value": "quay.io/strimzi/operator:0.33.2",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_KAFKA_INIT_IMAGE",
+										"value": "quay.io/strimzi/operator:0.33.2",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_KAFKA_BRIDGE_IMAGE",
+										"value": "quay.io/strimzi/kafka-bridge:0.24.0",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_KANIKO_EXECUTOR_IMAGE",
+										"value": "quay.io/strimzi/kaniko-executor:0.33.2",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_DEFAULT_MAVEN_BUILDER",
+										"value": "quay.io/strimzi/maven-builder:0.33.2",
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_OPERATOR_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_FEATURE_GATES",
+										"value": "",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_LEADER_ELECTION_ENABLED",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_LEADER_ELECTION_LEASE_NAME",
+										"value": "strimzi-cluster-operator",
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_LEADER_ELECTION_LEASE_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "STRIMZI_LEADER_ELECTION_IDENTITY",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "STRIMZI_LOG_LEVEL",
+										"value": "INFO",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthy",
+										"port": "http",
+									},
+									"initialDelaySeconds": 10,
+									"periodSeconds":       30,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": "http",
+									},
+									"initialDelaySeconds": 10,
+									"periodSeconds":       30,
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "http",
+										"containerPort": 8080,
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "384Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "1000m",
+										"memory": "384Mi",
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceStrimziClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,873 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/messagingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceStrimziClusterOperator creates the ServiceAccount resource with name strimzi-cluster-operator.
+func CreateServiceAccountNamespaceStrimziClusterOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name":      "strimzi-cluster-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceStrimziClusterOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;delete;patch
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=apps,resources=deployments/scale,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=apps,resources=deployments/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkas,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkas/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkaconnects,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkaconnects/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkaconnectors,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkaconnectors/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkamirrormakers,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkamirrormakers/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkabridges,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkabridges/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkamirrormaker2s,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkamirrormaker2s/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkarebalances,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkarebalances/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core.strimzi.io,resources=strimzipodsets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core.strimzi.io,resources=strimzipodsets/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;delete;patch;update
+
+// CreateClusterRoleStrimziClusterOperatorNamespaced creates the ClusterRole resource with name strimzi-cluster-operator-namespaced.
+func CreateClusterRoleStrimziClusterOperatorNamespaced(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-namespaced",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"serviceaccounts",
+						"configmaps",
+						"services",
+						"persistentvolumeclaims",
+						"secrets",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rbac.authorization.k8s.io",
+					},
+					"resources": []interface{}{
+						"rolebindings",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"delete",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"endpoints",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+						"events.k8s.io",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"deployments",
+						"deployments/scale",
+						"deployments/status",
+						"statefulsets",
+						"replicasets",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"kafka.strimzi.io",
+					},
+					"resources": []interface{}{
+						"kafkas",
+						"kafkas/status",
+						"kafkaconnects",
+						"kafkaconnects/status",
+						"kafkaconnectors",
+						"kafkaconnectors/status",
+						"kafkamirrormakers",
+						"kafkamirrormakers/status",
+						"kafkabridges",
+						"kafkabridges/status",
+						"kafkamirrormaker2s",
+						"kafkamirrormaker2s/status",
+						"kafkarebalances",
+						"kafkarebalances/status",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"core.strimzi.io",
+					},
+					"resources": []interface{}{
+						"strimzipodsets",
+						"strimzipodsets/status",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"policy",
+					},
+					"resources": []interface{}{
+						"poddisruptionbudgets",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"networking.k8s.io",
+					},
+					"resources": []interface{}{
+						"networkpolicies",
+						"ingresses",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziClusterOperatorNamespaced(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=list
+
+// CreateClusterRoleStrimziClusterOperatorGlobal creates the ClusterRole resource with name strimzi-cluster-operator-global.
+func CreateClusterRoleStrimziClusterOperatorGlobal(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-global",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rbac.authorization.k8s.io",
+					},
+					"resources": []interface{}{
+						"clusterrolebindings",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"storageclasses",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"list",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziClusterOperatorGlobal(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=create;get;list;watch;delete;patch;update
+
+// CreateClusterRoleStrimziClusterOperatorLeaderElection creates the ClusterRole resource with name strimzi-cluster-operator-leader-election.
+func CreateClusterRoleStrimziClusterOperatorLeaderElection(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-leader-election",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"resourceNames": []interface{}{
+						"strimzi-cluster-operator",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziClusterOperatorLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get
+
+// CreateClusterRoleStrimziKafkaBroker creates the ClusterRole resource with name strimzi-kafka-broker.
+func CreateClusterRoleStrimziKafkaBroker(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-kafka-broker",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziKafkaBroker(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkatopics/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkausers,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=kafka.strimzi.io,resources=kafkausers/status,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;delete;patch;update
+
+// CreateClusterRoleStrimziEntityOperator creates the ClusterRole resource with name strimzi-entity-operator.
+func CreateClusterRoleStrimziEntityOperator(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-entity-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"kafka.strimzi.io",
+					},
+					"resources": []interface{}{
+						"kafkatopics",
+						"kafkatopics/status",
+						"kafkausers",
+						"kafkausers/status",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+						"events.k8s.io",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"secrets",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"delete",
+						"patch",
+						"update",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziEntityOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get
+
+// CreateClusterRoleStrimziKafkaClient creates the ClusterRole resource with name strimzi-kafka-client.
+func CreateClusterRoleStrimziKafkaClient(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-kafka-client",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleStrimziKafkaClient(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingStrimziClusterOperatorNamespaced creates the ClusterRoleBinding resource with name strimzi-cluster-operator-namespaced.
+func CreateClusterRoleBindingStrimziClusterOperatorNamespaced(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-namespaced",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-cluster-operator-namespaced",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingStrimziClusterOperatorNamespaced(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingStrimziClusterOperatorGlobal creates the ClusterRoleBinding resource with name strimzi-cluster-operator-global.
+func CreateClusterRoleBindingStrimziClusterOperatorGlobal(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-global",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-cluster-operator-global",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingStrimziClusterOperatorGlobal(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingStrimziClusterOperatorKafkaBrokerDelegation creates the ClusterRoleBinding resource with name strimzi-cluster-operator-kafka-broker-delegation.
+func CreateClusterRoleBindingStrimziClusterOperatorKafkaBrokerDelegation(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-kafka-broker-delegation",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-kafka-broker",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingStrimziClusterOperatorKafkaBrokerDelegation(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingStrimziClusterOperatorEntityOperatorDelegation creates the ClusterRoleBinding resource with name strimzi-cluster-operator-entity-operator-delegation.
+func CreateClusterRoleBindingStrimziClusterOperatorEntityOperatorDelegation(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-entity-operator-delegation",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-entity-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingStrimziClusterOperatorEntityOperatorDelegation(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingStrimziClusterOperatorKafkaClientDelegation creates the ClusterRoleBinding resource with name strimzi-cluster-operator-kafka-client-delegation.
+func CreateClusterRoleBindingStrimziClusterOperatorKafkaClientDelegation(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name": "strimzi-cluster-operator-kafka-client-delegation",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-kafka-client",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingStrimziClusterOperatorKafkaClientDelegation(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRoleBindingNamespaceStrimziClusterOperatorLeaderElection creates the RoleBinding resource with name strimzi-cluster-operator-leader-election.
+func CreateRoleBindingNamespaceStrimziClusterOperatorLeaderElection(
+	parent *applicationv1alpha1.MessagingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Operator.Type != "strimzi" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=operator.type,value="strimzi",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "messaging",
+					"application.nukleros.io/project": "strimzi",
+				},
+				"name":      "strimzi-cluster-operator-leader-election",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "strimzi-cluster-operator-leader-election",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "strimzi-cluster-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleBindingNamespaceStrimziClusterOperatorLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
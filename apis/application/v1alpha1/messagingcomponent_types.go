@@ -0,0 +1,333 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertMessagingComponent = errors.New("unable to convert to MessagingComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// MessagingComponentSpec defines the desired state of MessagingComponent.
+type MessagingComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection MessagingComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-messaging-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-messaging-system")
+	//
+	//	Namespace to use for messaging support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Operator MessagingComponentSpecOperator `json:"operator,omitempty"`
+}
+
+type MessagingComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type MessagingComponentSpecOperator struct {
+	// +kubebuilder:default="rabbitmq"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=rabbitmq;nats;strimzi
+	// (Default: "rabbitmq")
+	//
+	//	Messaging operator to install.  One of: rabbitmq | nats | strimzi.  The rabbitmq cluster
+	//	operator and strimzi manage the clusters of all namespaces, while the nats operator only
+	//	manages the clusters in the namespace of the component.  When the type is changed, the
+	//	workloads and RBAC of the previous operator are removed, but its custom resource
+	//	definitions are left in place so that existing clusters are not deleted along with them.
+	//	The clusters of the previous operator are no longer reconciled and must be migrated or
+	//	removed manually.
+	Type string `json:"type,omitempty"`
+
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	// (Default: 1)
+	//
+	//	Number of replicas to use for the operator deployment.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	RabbitMQ MessagingComponentSpecOperatorRabbitMQ `json:"rabbitmq,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NATS MessagingComponentSpecOperatorNATS `json:"nats,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Strimzi MessagingComponentSpecOperatorStrimzi `json:"strimzi,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the operator pods.  Settings which are set here take precedence
+	//	over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the operator container.  Requests and limits which are not
+	//	set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type MessagingComponentSpecOperatorRabbitMQ struct {
+	// +kubebuilder:default="rabbitmqoperator/cluster-operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "rabbitmqoperator/cluster-operator")
+	//
+	//	Image repo and name to use for the rabbitmq cluster operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the rabbitmq cluster operator image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="2.1.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "2.1.0")
+	//
+	//	Version of the rabbitmq cluster operator to use.
+	Version string `json:"version,omitempty"`
+}
+
+type MessagingComponentSpecOperatorNATS struct {
+	// +kubebuilder:default="natsio/nats-operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "natsio/nats-operator")
+	//
+	//	Image repo and name to use for the nats operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the nats operator image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="0.8.3"
+	// +kubebuilder:validation:Optional
+	// (Default: "0.8.3")
+	//
+	//	Version of the nats operator to use.
+	Version string `json:"version,omitempty"`
+}
+
+type MessagingComponentSpecOperatorStrimzi struct {
+	// +kubebuilder:default="quay.io/strimzi/operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/strimzi/operator")
+	//
+	//	Image repo and name to use for the strimzi cluster operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the strimzi cluster operator image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="0.33.2"
+	// +kubebuilder:validation:Optional
+	// (Default: "0.33.2")
+	//
+	//	Version of the strimzi cluster operator to use.
+	Version string `json:"version,omitempty"`
+}
+
+// MessagingComponentStatus defines the observed state of MessagingComponent.
+type MessagingComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// MessagingComponent is the Schema for the messagingcomponents API.
+type MessagingComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MessagingComponentSpec   `json:"spec,omitempty"`
+	Status            MessagingComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MessagingComponentList contains a list of MessagingComponent.
+type MessagingComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MessagingComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *MessagingComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *MessagingComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *MessagingComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *MessagingComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *MessagingComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *MessagingComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *MessagingComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *MessagingComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *MessagingComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*MessagingComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*MessagingComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("MessagingComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *MessagingComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *MessagingComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *MessagingComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	// the operators elect a leader, so they are not replicated by the tier
+	return map[string]int{
+		component.OperatorDeployment(): component.Spec.Operator.Replicas,
+	}
+}
+
+// OperatorDeployment returns the name of the deployment of the messaging operator.
+func (component *MessagingComponent) OperatorDeployment() string {
+	switch component.Spec.Operator.Type {
+	case "nats":
+		return "nats-operator"
+	case "strimzi":
+		return "strimzi-cluster-operator"
+	default:
+		return "rabbitmq-cluster-operator"
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&MessagingComponent{}, &MessagingComponentList{})
+}
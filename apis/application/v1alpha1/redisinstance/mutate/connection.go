@@ -0,0 +1,141 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+)
+
+const (
+	redisPort      = 6379
+	sentinelPort   = 26379
+	passwordLength = 24
+
+	// masterName is the name under which the sentinels of the sentinel topology monitor the redis
+	// master, which must match the master group name of the redis sentinel manifest.
+	masterName = "mymaster"
+)
+
+// connectionSecret returns the name of the secret which holds the connection details of the
+// instance.  The secret also holds the password from which the redis operator configures redis.
+func connectionSecret(parent *applicationv1alpha1.RedisInstance) string {
+	return parent.Name + "-connection"
+}
+
+// connection returns the connection details of the instance, keyed as in the connection secret.
+// Applications connect to the sentinels of the sentinel topology, which return the address of the
+// current master, and to the leaders of the cluster topology, which redirect to the other shards.
+func connection(parent *applicationv1alpha1.RedisInstance, password string) map[string]interface{} {
+	var service, master, scheme string
+
+	port := redisPort
+
+	switch parent.Spec.Topology {
+	case "sentinel":
+		service, port, master, scheme = parent.Name+"-sentinel", sentinelPort, masterName, "redis+sentinel"
+	case "cluster":
+		service, scheme = parent.Name+"-leader", "redis"
+	default:
+		service, scheme = parent.Name, "redis"
+	}
+
+	host := fmt.Sprintf("%s.%s.svc", service, parent.Namespace)
+
+	uri := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword("", password),
+		Host:   host + ":" + strconv.Itoa(port),
+	}
+
+	if master != "" {
+		uri.Path = "/" + master
+	}
+
+	return map[string]interface{}{
+		"host":        host,
+		"port":        strconv.Itoa(port),
+		"password":    password,
+		"uri":         uri.String(),
+		"topology":    parent.Spec.Topology,
+		"master-name": master,
+	}
+}
+
+// setConnection sets the connection details of the instance in the connection secret.
+func setConnection(original client.Object, parent *applicationv1alpha1.RedisInstance, password string) error {
+	secret, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	secret.Object["stringData"] = connection(parent, password)
+
+	return nil
+}
+
+// instancePassword returns the password of the instance from its connection secret, or a newly
+// generated password if the secret does not yet exist or when generating manifests from the CLI.
+// Deleting the connection secret therefore rotates the password of the instance.
+func instancePassword(
+	parent *applicationv1alpha1.RedisInstance,
+	reconciler workload.Reconciler, req *workload.Request,
+) (string, error) {
+	if reconciler == nil || req == nil {
+		return generatePassword()
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: connectionSecret(parent), Namespace: parent.Namespace}
+
+	if err := reconciler.Get(req.Context, name, secret); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return "", fmt.Errorf("unable to get connection secret %s; %w", name, err)
+		}
+	}
+
+	if password := string(secret.Data["password"]); password != "" {
+		return password, nil
+	}
+
+	return generatePassword()
+}
+
+// generatePassword returns a random password.  The password is hex encoded, so that it is safe to
+// use in the redis configuration and in connection URIs without escaping.
+func generatePassword() (string, error) {
+	password := make([]byte, passwordLength)
+
+	if _, err := rand.Read(password); err != nil {
+		return "", fmt.Errorf("unable to generate password; %w", err)
+	}
+
+	return hex.EncodeToString(password), nil
+}
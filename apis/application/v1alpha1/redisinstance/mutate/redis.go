@@ -0,0 +1,178 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/reload"
+)
+
+// size holds the resources of each redis server of an instance of a given size.
+type size struct {
+	cpu     string
+	memory  string
+	storage string
+}
+
+// sizes are the sizes of instances which may be requested.  The memory limit bounds the data
+// set of each server, so the volume is sized to hold several snapshots of it.
+var sizes = map[string]size{
+	"small":  {cpu: "100m", memory: "256Mi", storage: "1Gi"},
+	"medium": {cpu: "500m", memory: "1Gi", storage: "10Gi"},
+	"large":  {cpu: "2", memory: "4Gi", storage: "50Gi"},
+}
+
+// mutateRedis applies the settings which are common to all redis resources of the instance.  The
+// images use the registry mirrors and image pull secrets of the collection and the resources are
+// annotated so that reloader restarts redis when the password in the connection secret rotates.
+// The redis operator does not propagate the annotation, so the Reload-StatefulSets phase copies it
+// to the statefulsets which the redis operator creates.
+func mutateRedis(
+	original client.Object,
+	parent *applicationv1alpha1.RedisInstance, collection *setupv1alpha1.SupportServices,
+) error {
+	redis, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	annotations := redis.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[reload.SecretAnnotation] = connectionSecret(parent)
+	redis.SetAnnotations(annotations)
+
+	// generated objects contain values which are not deep copyable (e.g. int), so we must
+	// modify the kubernetes config in place rather than using the unstructured setters.
+	config, err := nestedMap(redis, "spec", "kubernetesConfig")
+	if err != nil {
+		return err
+	}
+
+	if image, ok := config["image"].(string); ok {
+		config["image"] = podtemplate.MirrorImage(image, collection.Spec.RegistryMirrors)
+	}
+
+	if len(collection.Spec.ImagePullSecrets) > 0 {
+		pullSecrets := []interface{}{}
+
+		for _, secret := range collection.Spec.ImagePullSecrets {
+			pullSecrets = append(pullSecrets, map[string]interface{}{"name": secret.Name})
+		}
+
+		config["imagePullSecrets"] = pullSecrets
+	}
+
+	return nil
+}
+
+// reconcileRedis applies the settings which are common to all redis resources of the instance
+// when reconciling.
+func reconcileRedis(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
+
+// setSize sets the resources and the volumes of the redis servers of the instance from the size
+// of the instance.
+func setSize(original client.Object, parent *applicationv1alpha1.RedisInstance) error {
+	redis, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	serverSize, ok := sizes[parent.Spec.Size]
+	if !ok {
+		return fmt.Errorf("unknown size %q of redis instance %s", parent.Spec.Size, parent.Name)
+	}
+
+	config, err := nestedMap(redis, "spec", "kubernetesConfig")
+	if err != nil {
+		return err
+	}
+
+	config["resources"] = map[string]interface{}{
+		"requests": map[string]interface{}{"cpu": serverSize.cpu, "memory": serverSize.memory},
+		"limits":   map[string]interface{}{"cpu": serverSize.cpu, "memory": serverSize.memory},
+	}
+
+	claim, err := nestedMap(redis, "spec", "storage", "volumeClaimTemplate", "spec")
+	if err != nil {
+		return err
+	}
+
+	claim["resources"] = map[string]interface{}{
+		"requests": map[string]interface{}{"storage": serverSize.storage},
+	}
+
+	return setStorageClass(redis, parent.Spec.StorageClassName)
+}
+
+// setStorageClass sets the storage class of all volume claim templates of a redis resource.
+func setStorageClass(redis *unstructured.Unstructured, storageClass string) error {
+	if storageClass == "" {
+		return nil
+	}
+
+	storage, err := nestedMap(redis, "spec", "storage")
+	if err != nil {
+		return err
+	}
+
+	for _, template := range []string{"volumeClaimTemplate", "nodeConfVolumeClaimTemplate"} {
+		claim, ok := storage[template].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if spec, ok := claim["spec"].(map[string]interface{}); ok {
+			spec["storageClassName"] = storageClass
+		}
+	}
+
+	return nil
+}
+
+// nestedMap returns the map at the given path of a generated object.
+func nestedMap(object *unstructured.Unstructured, fields ...string) (map[string]interface{}, error) {
+	value, found, err := unstructured.NestedFieldNoCopy(object.Object, fields...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %v of %s, %w", fields, object.GetName(), err)
+	}
+
+	nested, ok := value.(map[string]interface{})
+	if !found || !ok {
+		return nil, fmt.Errorf("unable to find %v of %s", fields, object.GetName())
+	}
+
+	return nested, nil
+}
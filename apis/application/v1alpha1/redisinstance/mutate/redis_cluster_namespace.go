@@ -0,0 +1,58 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// MutateRedisClusterNamespace mutates the RedisCluster resource with name parent.Name.
+func MutateRedisClusterNamespace(
+	original client.Object,
+	parent *applicationv1alpha1.RedisInstance, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	// apply the settings which are common to all redis resources of the instance.
+	if err := mutateRedis(original, parent, collection); err != nil {
+		return nil, err
+	}
+
+	// size the redis servers of the instance.
+	if err := setSize(original, parent); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// apply the settings which are common to all redis resources of the instance when
+	// reconciling.
+	if err := reconcileRedis(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
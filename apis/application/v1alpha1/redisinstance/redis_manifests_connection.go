@@ -0,0 +1,67 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisinstance
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/redisinstance/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateSecretNamespaceConnection creates the Secret resource with name parent.Name-connection.
+func CreateSecretNamespaceConnection(
+	parent *applicationv1alpha1.RedisInstance,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis",
+				},
+				"annotations": map[string]interface{}{
+					"reloader.stakater.com/match": "true",
+				},
+				"name":      "" + parent.Name + "-connection",
+				"namespace": parent.Namespace,
+			},
+			"type": "Opaque",
+			"stringData": map[string]interface{}{
+				"host":        "",
+				"port":        "",
+				"password":    "",
+				"uri":         "",
+				"topology":    "",
+				"master-name": "",
+			},
+		},
+	}
+
+	return mutate.MutateSecretNamespaceConnection(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redisinstance
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/application/v1alpha1/redisinstance/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redisreplications,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRedisReplicationNamespace creates the RedisReplication resource with name parent.Name.
+func CreateRedisReplicationNamespace(
+	parent *applicationv1alpha1.RedisInstance,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Topology != "sentinel" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=topology,value="sentinel",include
+			"apiVersion": "redis.redis.opstreelabs.in/v1beta1",
+			"kind":       "RedisReplication",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis",
+				},
+				"name":      parent.Name,
+				"namespace": parent.Namespace,
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: replicas
+				//  Number of redis replicas of the sentinel topology, or number of redis leaders and of redis
+				//  followers of the cluster topology.  Ignored by the standalone topology.
+				"clusterSize": parent.Spec.Replicas,
+				"kubernetesConfig": map[string]interface{}{
+					// controlled by field: image
+					// controlled by field: version
+					//  Image repo and name to use for redis.
+					//  Version of redis to use.
+					"image":           "" + parent.Spec.Image + ":" + parent.Spec.Version + "",
+					"imagePullPolicy": "IfNotPresent",
+					"redisSecret": map[string]interface{}{
+						"name": "" + parent.Name + "-connection",
+						"key":  "password",
+					},
+					"resources": map[string]interface{}{
+						"requests": map[string]interface{}{
+							"cpu":    "100m",
+							"memory": "128Mi",
+						},
+						"limits": map[string]interface{}{
+							"cpu":    "100m",
+							"memory": "128Mi",
+						},
+					},
+				},
+				"storage": map[string]interface{}{
+					"volumeClaimTemplate": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"accessModes": []interface{}{
+								"ReadWriteOnce",
+							},
+							"resources": map[string]interface{}{
+								"requests": map[string]interface{}{
+									"storage": "1Gi",
+								},
+							},
+						},
+					},
+				},
+				"securityContext": map[string]interface{}{
+					"runAsUser": 1000,
+					"fsGroup":   1000,
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRedisReplicationNamespace(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redissentinels,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRedisSentinelNamespace creates the RedisSentinel resource with name parent.Name.
+func CreateRedisSentinelNamespace(
+	parent *applicationv1alpha1.RedisInstance,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Topology != "sentinel" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=topology,value="sentinel",include
+			"apiVersion": "redis.redis.opstreelabs.in/v1beta1",
+			"kind":       "RedisSentinel",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"application.nukleros.io/group":   "cache",
+					"application.nukleros.io/project": "redis",
+				},
+				"name":      parent.Name,
+				"namespace": parent.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"clusterSize": 3,
+				"kubernetesConfig": map[string]interface{}{
+					// controlled by field: sentinelImage
+					// controlled by field: version
+					//  Image repo and name to use for redis sentinel.  The sentinel uses the same version as redis.
+					"image":           "" + parent.Spec.SentinelImage + ":" + parent.Spec.Version + "",
+					"imagePullPolicy": "IfNotPresent",
+					"redisSecret": map[string]interface{}{
+						"name": "" + parent.Name + "-connection",
+						"key":  "password",
+					},
+					"resources": map[string]interface{}{
+						"requests": map[string]interface{}{
+							"cpu":    "50m",
+							"memory": "64Mi",
+						},
+						"limits": map[string]interface{}{
+							"cpu":    "100m",
+							"memory": "128Mi",
+						},
+					},
+				},
+				"redisSentinelConfig": map[string]interface{}{
+					"redisReplicationName": parent.Name,
+					"masterGroupName":      "mymaster",
+					"redisPort":            "6379",
+					"quorum":               "2",
+				},
+				"securityContext": map[string]interface{}{
+					"runAsUser": 1000,
+					"fsGroup":   1000,
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRedisSentinelNamespace(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertRedisInstance = errors.New("unable to convert to RedisInstance")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// RedisInstanceSpec defines the desired state of RedisInstance.
+type RedisInstanceSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection RedisInstanceCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="standalone"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=standalone;sentinel;cluster
+	// (Default: "standalone")
+	//
+	//	Topology of the instance.  One of: standalone | sentinel | cluster.  A standalone instance
+	//	runs a single redis server, a sentinel instance runs replicated redis servers which fail
+	//	over under the supervision of redis sentinel and a cluster instance shards the keys across
+	//	redis leaders, each of which is replicated to a follower.
+	Topology string `json:"topology,omitempty"`
+
+	// +kubebuilder:default="small"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=small;medium;large
+	// (Default: "small")
+	//
+	//	Size of each redis server of the instance, which sets its resource requests and limits and
+	//	the size of its volume.  One of: small | medium | large.
+	Size string `json:"size,omitempty"`
+
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// (Default: 3)
+	//
+	//	Number of redis replicas of the sentinel topology, or number of redis leaders and of redis
+	//	followers of the cluster topology.  Ignored by the standalone topology.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:default="quay.io/opstree/redis"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/opstree/redis")
+	//
+	//	Image repo and name to use for redis.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:default="quay.io/opstree/redis-sentinel"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/opstree/redis-sentinel")
+	//
+	//	Image repo and name to use for redis sentinel.  The sentinel uses the same version as redis.
+	SentinelImage string `json:"sentinelImage,omitempty"`
+
+	// +kubebuilder:default="v7.0.12"
+	// +kubebuilder:validation:Optional
+	// (Default: "v7.0.12")
+	//
+	//	Version of redis to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Storage class of the volumes of the redis servers.  Defaults to the default storage class
+	//	of the cluster when unset.
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+type RedisInstanceCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+// RedisInstanceStatus defines the observed state of RedisInstance.
+type RedisInstanceStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// RedisInstance is the Schema for the redisinstances API.
+type RedisInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RedisInstanceSpec   `json:"spec,omitempty"`
+	Status            RedisInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisInstanceList contains a list of RedisInstance.
+type RedisInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisInstance `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *RedisInstance) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *RedisInstance) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *RedisInstance) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *RedisInstance) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *RedisInstance) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *RedisInstance) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *RedisInstance) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *RedisInstance) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *RedisInstance) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*RedisInstance) GetDependencies() []workload.Workload {
+	return []workload.Workload{
+		&CacheComponent{},
+	}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*RedisInstance) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("RedisInstance")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *RedisInstance) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *RedisInstance) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each statefulset of the instance, keyed by
+// the statefulset name.  The statefulsets are created by the redis operator and are sized by the
+// spec rather than by the tier.
+func (component *RedisInstance) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return component.StatefulSets()
+}
+
+// StatefulSets returns the number of replicas of each statefulset which the redis operator
+// creates for the topology of the instance, keyed by the statefulset name.
+func (component *RedisInstance) StatefulSets() map[string]int {
+	switch component.Spec.Topology {
+	case "sentinel":
+		return map[string]int{
+			component.Name:               component.Spec.Replicas,
+			component.Name + "-sentinel": 3,
+		}
+	case "cluster":
+		return map[string]int{
+			component.Name + "-leader":   component.Spec.Replicas,
+			component.Name + "-follower": component.Spec.Replicas,
+		}
+	default:
+		return map[string]int{
+			component.Name: 1,
+		}
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&RedisInstance{}, &RedisInstanceList{})
+}
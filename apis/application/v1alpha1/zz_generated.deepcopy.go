@@ -23,15 +23,166 @@ package v1alpha1
 
 import (
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponent) DeepCopyInto(out *CacheComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponent.
+func (in *CacheComponent) DeepCopy() *CacheComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponentCollectionSpec) DeepCopyInto(out *CacheComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponentCollectionSpec.
+func (in *CacheComponentCollectionSpec) DeepCopy() *CacheComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponentList) DeepCopyInto(out *CacheComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponentList.
+func (in *CacheComponentList) DeepCopy() *CacheComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponentSpec) DeepCopyInto(out *CacheComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.RedisOperator.DeepCopyInto(&out.RedisOperator)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponentSpec.
+func (in *CacheComponentSpec) DeepCopy() *CacheComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponentSpecRedisOperator) DeepCopyInto(out *CacheComponentSpecRedisOperator) {
+	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponentSpecRedisOperator.
+func (in *CacheComponentSpecRedisOperator) DeepCopy() *CacheComponentSpecRedisOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponentSpecRedisOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheComponentStatus) DeepCopyInto(out *CacheComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheComponentStatus.
+func (in *CacheComponentStatus) DeepCopy() *CacheComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseComponent) DeepCopyInto(out *DatabaseComponent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -104,7 +255,8 @@ func (in *DatabaseComponentList) DeepCopyObject() runtime.Object {
 func (in *DatabaseComponentSpec) DeepCopyInto(out *DatabaseComponentSpec) {
 	*out = *in
 	out.Collection = in.Collection
-	out.ZalandoPostgres = in.ZalandoPostgres
+	in.ZalandoPostgres.DeepCopyInto(&out.ZalandoPostgres)
+	out.Monitoring = in.Monitoring
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseComponentSpec.
@@ -120,6 +272,9 @@ func (in *DatabaseComponentSpec) DeepCopy() *DatabaseComponentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseComponentSpecZalandoPostgres) DeepCopyInto(out *DatabaseComponentSpecZalandoPostgres) {
 	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+	out.LogicalBackup = in.LogicalBackup
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseComponentSpecZalandoPostgres.
@@ -132,6 +287,21 @@ func (in *DatabaseComponentSpecZalandoPostgres) DeepCopy() *DatabaseComponentSpe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseComponentSpecZalandoPostgresLogicalBackup) DeepCopyInto(out *DatabaseComponentSpecZalandoPostgresLogicalBackup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseComponentSpecZalandoPostgresLogicalBackup.
+func (in *DatabaseComponentSpecZalandoPostgresLogicalBackup) DeepCopy() *DatabaseComponentSpecZalandoPostgresLogicalBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseComponentSpecZalandoPostgresLogicalBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseComponentStatus) DeepCopyInto(out *DatabaseComponentStatus) {
 	*out = *in
@@ -157,6 +327,11 @@ func (in *DatabaseComponentStatus) DeepCopyInto(out *DatabaseComponentStatus) {
 			}
 		}
 	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseComponentStatus.
@@ -168,3 +343,333 @@ func (in *DatabaseComponentStatus) DeepCopy() *DatabaseComponentStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponent) DeepCopyInto(out *MessagingComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponent.
+func (in *MessagingComponent) DeepCopy() *MessagingComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessagingComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentCollectionSpec) DeepCopyInto(out *MessagingComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentCollectionSpec.
+func (in *MessagingComponentCollectionSpec) DeepCopy() *MessagingComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentList) DeepCopyInto(out *MessagingComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MessagingComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentList.
+func (in *MessagingComponentList) DeepCopy() *MessagingComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessagingComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentSpec) DeepCopyInto(out *MessagingComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.Operator.DeepCopyInto(&out.Operator)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentSpec.
+func (in *MessagingComponentSpec) DeepCopy() *MessagingComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentSpecOperator) DeepCopyInto(out *MessagingComponentSpecOperator) {
+	*out = *in
+	out.RabbitMQ = in.RabbitMQ
+	out.NATS = in.NATS
+	out.Strimzi = in.Strimzi
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentSpecOperator.
+func (in *MessagingComponentSpecOperator) DeepCopy() *MessagingComponentSpecOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentSpecOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentSpecOperatorNATS) DeepCopyInto(out *MessagingComponentSpecOperatorNATS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentSpecOperatorNATS.
+func (in *MessagingComponentSpecOperatorNATS) DeepCopy() *MessagingComponentSpecOperatorNATS {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentSpecOperatorNATS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentSpecOperatorRabbitMQ) DeepCopyInto(out *MessagingComponentSpecOperatorRabbitMQ) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentSpecOperatorRabbitMQ.
+func (in *MessagingComponentSpecOperatorRabbitMQ) DeepCopy() *MessagingComponentSpecOperatorRabbitMQ {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentSpecOperatorRabbitMQ)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentSpecOperatorStrimzi) DeepCopyInto(out *MessagingComponentSpecOperatorStrimzi) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentSpecOperatorStrimzi.
+func (in *MessagingComponentSpecOperatorStrimzi) DeepCopy() *MessagingComponentSpecOperatorStrimzi {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentSpecOperatorStrimzi)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingComponentStatus) DeepCopyInto(out *MessagingComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingComponentStatus.
+func (in *MessagingComponentStatus) DeepCopy() *MessagingComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstance) DeepCopyInto(out *RedisInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstance.
+func (in *RedisInstance) DeepCopy() *RedisInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceCollectionSpec) DeepCopyInto(out *RedisInstanceCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceCollectionSpec.
+func (in *RedisInstanceCollectionSpec) DeepCopy() *RedisInstanceCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceList) DeepCopyInto(out *RedisInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceList.
+func (in *RedisInstanceList) DeepCopy() *RedisInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceSpec) DeepCopyInto(out *RedisInstanceSpec) {
+	*out = *in
+	out.Collection = in.Collection
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceSpec.
+func (in *RedisInstanceSpec) DeepCopy() *RedisInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceStatus) DeepCopyInto(out *RedisInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceStatus.
+func (in *RedisInstanceStatus) DeepCopy() *RedisInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
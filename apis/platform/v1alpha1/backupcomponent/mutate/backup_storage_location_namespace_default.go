@@ -0,0 +1,50 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// MutateBackupStorageLocationNamespaceDefault mutates the BackupStorageLocation resource with name default.
+func MutateBackupStorageLocationNamespaceDefault(
+	original client.Object,
+	parent *platformv1alpha1.BackupComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	// set the prefix and the endpoint of the object storage.
+	if err := setStorageLocation(original, parent); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// use the endpoint of the referenced object storage component, if any.
+	if err := setObjectStorageLocation(original, parent, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	return []client.Object{original}, nil
+}
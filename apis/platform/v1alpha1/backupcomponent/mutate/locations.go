@@ -0,0 +1,61 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// setStorageLocation sets the prefix and the endpoint of the object storage of the backup storage
+// location.  Object storage other than AWS, e.g. MinIO, is addressed by path rather than by
+// virtual host.
+func setStorageLocation(original client.Object, parent *platformv1alpha1.BackupComponent) error {
+	location, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, _ := location.Object["spec"].(map[string]interface{})
+	objectStorage, _ := spec["objectStorage"].(map[string]interface{})
+	config, _ := spec["config"].(map[string]interface{})
+
+	if objectStorage == nil || config == nil {
+		return fmt.Errorf("backup storage location %s has no object storage settings", original.GetName())
+	}
+
+	if parent.Spec.Storage.Prefix != "" {
+		objectStorage["prefix"] = parent.Spec.Storage.Prefix
+	}
+
+	if parent.Spec.Storage.Endpoint == "" {
+		return nil
+	}
+
+	config["s3Url"] = parent.Spec.Storage.Endpoint
+	config["s3ForcePathStyle"] = "true"
+
+	if parent.Spec.Storage.InsecureSkipTLSVerify {
+		config["insecureSkipTLSVerify"] = "true"
+	}
+
+	return nil
+}
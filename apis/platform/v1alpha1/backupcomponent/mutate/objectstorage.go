@@ -0,0 +1,97 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/objectstorage"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// objectStorage returns the ObjectStorageComponent which the storage settings of the component
+// reference, or nil if they do not reference one.  The component must declare the bucket of the
+// backups and publish its credentials in the namespace of velero.
+func objectStorage(
+	parent *platformv1alpha1.BackupComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) (*platformv1alpha1.ObjectStorageComponent, error) {
+	if parent.Spec.Storage.ObjectStorage == "" {
+		return nil, nil
+	}
+
+	return objectstorage.Get(
+		reconciler, req, parent.Spec.Storage.ObjectStorage, parent.Spec.Storage.Bucket, parent.Spec.Namespace,
+	)
+}
+
+// setObjectStorageLocation sets the endpoint and the region of the object storage of the backup
+// storage location to those of the referenced object storage component, if any.
+func setObjectStorageLocation(
+	original client.Object,
+	parent *platformv1alpha1.BackupComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	component, err := objectStorage(parent, reconciler, req)
+	if err != nil || component == nil {
+		return err
+	}
+
+	location, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, _ := location.Object["spec"].(map[string]interface{})
+	config, _ := spec["config"].(map[string]interface{})
+
+	if config == nil {
+		return fmt.Errorf("backup storage location %s has no object storage settings", original.GetName())
+	}
+
+	config["region"] = platformv1alpha1.ObjectStorageRegion
+	config["s3Url"] = component.Endpoint()
+	config["s3ForcePathStyle"] = "true"
+
+	delete(config, "insecureSkipTLSVerify")
+
+	return nil
+}
+
+// setObjectStorageCredentials mounts the credentials of the bucket of the referenced object
+// storage component, if any, in place of the credentials secret of the storage settings.
+func setObjectStorageCredentials(
+	original client.Object,
+	parent *platformv1alpha1.BackupComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	component, err := objectStorage(parent, reconciler, req)
+	if err != nil || component == nil {
+		return err
+	}
+
+	return podtemplate.MountSecret(
+		original, velero, "cloud-credentials",
+		platformv1alpha1.BucketCredentialsSecret(parent.Spec.Storage.Bucket), "/credentials",
+	)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// systemNamespaces are the namespaces of kubernetes, of the operator and of the support services
+// with their default names, which are excluded from the schedules when the component does not
+// set its own excluded namespaces.
+var systemNamespaces = []string{
+	"kube-system",
+	"kube-public",
+	"kube-node-lease",
+	"support-services-operator-system",
+	"nukleros-certs-system",
+	"nukleros-ingress-system",
+	"nukleros-secrets-system",
+	"nukleros-monitoring-system",
+	"nukleros-logging-system",
+	"nukleros-storage-system",
+	"nukleros-policy-system",
+	"nukleros-backup-system",
+	"nukleros-database-system",
+}
+
+// MutateScheduleNamespaceSchedule mutates a Schedule resource generated from a schedule of the
+// component.
+func MutateScheduleNamespaceSchedule(
+	original client.Object,
+	parent *platformv1alpha1.BackupComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	// exclude the excluded namespaces of the component from the schedule.
+	if err := setExcludedNamespaces(original, parent); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
+
+// excludedNamespaces returns the namespaces which are excluded from every schedule.  The
+// namespace of the component is always excluded, as it only holds velero itself.
+func excludedNamespaces(parent *platformv1alpha1.BackupComponent) []string {
+	namespaces := parent.Spec.ExcludedNamespaces
+	if len(namespaces) == 0 {
+		namespaces = systemNamespaces
+	}
+
+	return appendMissing(namespaces, parent.Spec.Namespace)
+}
+
+// setExcludedNamespaces prepends the excluded namespaces of the component to the excluded
+// namespaces of the template of a schedule.
+func setExcludedNamespaces(original client.Object, parent *platformv1alpha1.BackupComponent) error {
+	schedule, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, _ := schedule.Object["spec"].(map[string]interface{})
+	template, _ := spec["template"].(map[string]interface{})
+
+	if template == nil {
+		return fmt.Errorf("schedule %s has no backup template", original.GetName())
+	}
+
+	namespaces := excludedNamespaces(parent)
+
+	existing, _ := template["excludedNamespaces"].([]interface{})
+	for _, namespace := range existing {
+		if name, ok := namespace.(string); ok {
+			namespaces = appendMissing(namespaces, name)
+		}
+	}
+
+	list := make([]interface{}, len(namespaces))
+	for i, namespace := range namespaces {
+		list[i] = namespace
+	}
+
+	template["excludedNamespaces"] = list
+
+	return nil
+}
+
+// appendMissing appends a value to a list of values which does not contain it yet, without
+// modifying the given list.
+func appendMissing(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+
+	return append(append([]string{}, values...), value)
+}
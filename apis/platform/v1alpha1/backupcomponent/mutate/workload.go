@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+const velero = "velero"
+
+// mutateWorkload applies the settings which are common to all workloads of the component.
+func mutateWorkload(
+	original client.Object,
+	parent *platformv1alpha1.BackupComponent, collection *setupv1alpha1.SupportServices,
+	scheduling *setupv1alpha1.SchedulingSpec,
+) error {
+	if err := podtemplate.SetImageRegistry(original, collection); err != nil {
+		return err
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	if err := podtemplate.ScaleResources(original, profile.ResourcePercent); err != nil {
+		return err
+	}
+
+	if err := setLogLevel(original, profile.LogLevel); err != nil {
+		return err
+	}
+
+	return podtemplate.SetScheduling(original, collection.Spec.Scheduling.Override(*scheduling))
+}
+
+// setLogLevel sets the log level of velero, which names the warn level warning.
+func setLogLevel(original client.Object, level string) error {
+	if level == tier.LogLevelWarn {
+		level = "warning"
+	}
+
+	return podtemplate.SetArg(original, velero, "--log-level", level)
+}
+
+// reconcileWorkload applies the settings which are common to all workloads of the component when
+// reconciling.
+func reconcileWorkload(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	if err := podtemplate.PruneNodeSelector(req.Context, reconciler, original); err != nil {
+		return err
+	}
+
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcomponent
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/backupcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrReservedSchedule = errors.New("schedule name is reserved")
+
+// supportServicesSchedule is the name of the schedule which backs up the support services custom
+// resources.
+const supportServicesSchedule = "support-services"
+
+// defaultTTL is how long the backups of a schedule are kept when the schedule does not say
+// otherwise, which matches the default of velero.
+const defaultTTL = "720h0m0s"
+
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch;create;update;patch;delete
+
+// CreateScheduleNamespaceSchedules creates a Schedule resource for each schedule of the component.
+func CreateScheduleNamespaceSchedules(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	resourceObjs := []client.Object{}
+
+	for _, schedule := range parent.Spec.Schedules {
+		if schedule.Name == supportServicesSchedule && parent.Spec.SupportServices.Enabled {
+			return nil, fmt.Errorf("%w %s, which backs up the support services", ErrReservedSchedule, schedule.Name)
+		}
+
+		// volumes are only snapshotted when volume snapshots are enabled, unless the schedule
+		// says otherwise
+		snapshotVolumes := parent.Spec.VolumeSnapshots.Enabled
+		if schedule.SnapshotVolumes != nil {
+			snapshotVolumes = *schedule.SnapshotVolumes
+		}
+
+		ttl := schedule.TTL
+		if ttl == "" {
+			ttl = defaultTTL
+		}
+
+		includedNamespaces := []interface{}{"*"}
+		if len(schedule.IncludedNamespaces) > 0 {
+			includedNamespaces = stringList(schedule.IncludedNamespaces)
+		}
+
+		var resourceObj = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "velero.io/v1",
+				"kind":       "Schedule",
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"component":                    "velero",
+						"platform.nukleros.io/group":   "backup",
+						"platform.nukleros.io/project": "velero",
+					},
+					"name":      schedule.Name,
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+				"spec": map[string]interface{}{
+					"schedule":                   schedule.Schedule,
+					"useOwnerReferencesInBackup": false,
+					"template": map[string]interface{}{
+						"ttl":                ttl,
+						"storageLocation":    "default",
+						"snapshotVolumes":    snapshotVolumes,
+						"includedNamespaces": includedNamespaces,
+						"excludedNamespaces": stringList(schedule.ExcludedNamespaces),
+					},
+				},
+			},
+		}
+
+		mutated, err := mutate.MutateScheduleNamespaceSchedule(resourceObj, parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, mutated...)
+	}
+
+	return resourceObjs, nil
+}
+
+// stringList returns a list of strings as a list of values of an unstructured object.
+func stringList(values []string) []interface{} {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+
+	return list
+}
@@ -0,0 +1,818 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/backupcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDBackupsVeleroIo creates the CustomResourceDefinition resource with name backups.velero.io.
+func CreateCRDBackupsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "backups.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "Backup",
+					"listKind": "BackupList",
+					"plural":   "backups",
+					"singular": "backup",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDBackupsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDBackuprepositoriesVeleroIo creates the CustomResourceDefinition resource with name backuprepositories.velero.io.
+func CreateCRDBackuprepositoriesVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "backuprepositories.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "BackupRepository",
+					"listKind": "BackupRepositoryList",
+					"plural":   "backuprepositories",
+					"singular": "backuprepository",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDBackuprepositoriesVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDBackupstoragelocationsVeleroIo creates the CustomResourceDefinition resource with name backupstoragelocations.velero.io.
+func CreateCRDBackupstoragelocationsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "backupstoragelocations.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "BackupStorageLocation",
+					"listKind": "BackupStorageLocationList",
+					"plural":   "backupstoragelocations",
+					"shortNames": []interface{}{
+						"bsl",
+					},
+					"singular": "backupstoragelocation",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDBackupstoragelocationsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDDeletebackuprequestsVeleroIo creates the CustomResourceDefinition resource with name deletebackuprequests.velero.io.
+func CreateCRDDeletebackuprequestsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "deletebackuprequests.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "DeleteBackupRequest",
+					"listKind": "DeleteBackupRequestList",
+					"plural":   "deletebackuprequests",
+					"singular": "deletebackuprequest",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDDeletebackuprequestsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDDownloadrequestsVeleroIo creates the CustomResourceDefinition resource with name downloadrequests.velero.io.
+func CreateCRDDownloadrequestsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "downloadrequests.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "DownloadRequest",
+					"listKind": "DownloadRequestList",
+					"plural":   "downloadrequests",
+					"singular": "downloadrequest",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDDownloadrequestsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPodvolumebackupsVeleroIo creates the CustomResourceDefinition resource with name podvolumebackups.velero.io.
+func CreateCRDPodvolumebackupsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "podvolumebackups.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "PodVolumeBackup",
+					"listKind": "PodVolumeBackupList",
+					"plural":   "podvolumebackups",
+					"singular": "podvolumebackup",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPodvolumebackupsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPodvolumerestoresVeleroIo creates the CustomResourceDefinition resource with name podvolumerestores.velero.io.
+func CreateCRDPodvolumerestoresVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "podvolumerestores.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "PodVolumeRestore",
+					"listKind": "PodVolumeRestoreList",
+					"plural":   "podvolumerestores",
+					"singular": "podvolumerestore",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPodvolumerestoresVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDRestoresVeleroIo creates the CustomResourceDefinition resource with name restores.velero.io.
+func CreateCRDRestoresVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "restores.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "Restore",
+					"listKind": "RestoreList",
+					"plural":   "restores",
+					"singular": "restore",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDRestoresVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDSchedulesVeleroIo creates the CustomResourceDefinition resource with name schedules.velero.io.
+func CreateCRDSchedulesVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "schedules.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "Schedule",
+					"listKind": "ScheduleList",
+					"plural":   "schedules",
+					"singular": "schedule",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDSchedulesVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDServerstatusrequestsVeleroIo creates the CustomResourceDefinition resource with name serverstatusrequests.velero.io.
+func CreateCRDServerstatusrequestsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "serverstatusrequests.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "ServerStatusRequest",
+					"listKind": "ServerStatusRequestList",
+					"plural":   "serverstatusrequests",
+					"shortNames": []interface{}{
+						"ssr",
+					},
+					"singular": "serverstatusrequest",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDServerstatusrequestsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDVolumesnapshotlocationsVeleroIo creates the CustomResourceDefinition resource with name volumesnapshotlocations.velero.io.
+func CreateCRDVolumesnapshotlocationsVeleroIo(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+					"component":                    "velero",
+				},
+				"name": "volumesnapshotlocations.velero.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "velero.io",
+				"names": map[string]interface{}{
+					"kind":     "VolumeSnapshotLocation",
+					"listKind": "VolumeSnapshotLocationList",
+					"plural":   "volumesnapshotlocations",
+					"shortNames": []interface{}{
+						"vsl",
+					},
+					"singular": "volumesnapshotlocation",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDVolumesnapshotlocationsVeleroIo(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/backupcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceVelero creates the Deployment resource with name velero.
+func CreateDeploymentNamespaceVelero(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"component":                    "velero",
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+				},
+				"name":      "velero",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"deploy": "velero",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"prometheus.io/path":   "/metrics",
+							"prometheus.io/port":   "8085",
+							"prometheus.io/scrape": "true",
+						},
+						"labels": map[string]interface{}{
+							"component":                    "velero",
+							"deploy":                       "velero",
+							"platform.nukleros.io/group":   "backup",
+							"platform.nukleros.io/project": "velero",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "velero",
+						"restartPolicy":      "Always",
+						"initContainers": []interface{}{
+							map[string]interface{}{
+								"name": "velero-plugin-for-aws",
+								// controlled by field: velero.plugin.image
+								// controlled by field: velero.plugin.version
+								//  Image repo and name to use for the velero plugin for S3-compatible object storage.
+								//  Version of the velero plugin for S3-compatible object storage to use.
+								"image":           "" + parent.Spec.Velero.Plugin.Image + ":" + parent.Spec.Velero.Plugin.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath": "/target",
+										"name":      "plugins",
+									},
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "velero",
+								// controlled by field: velero.image
+								// controlled by field: velero.version
+								//  Image repo and name to use for velero.
+								//  Version of velero to use.
+								"image":           "" + parent.Spec.Velero.Image + ":" + parent.Spec.Velero.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/velero",
+								},
+								"args": []interface{}{
+									"server",
+									"--uploader-type=restic",
+									"--log-level=info",
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "metrics",
+										"containerPort": 8085,
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "500m",
+										"memory": "128Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "1000m",
+										"memory": "512Mi",
+									},
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "VELERO_SCRATCH_DIR",
+										"value": "/scratch",
+									},
+									map[string]interface{}{
+										"name": "VELERO_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LD_LIBRARY_PATH",
+										"value": "/plugins",
+									},
+									map[string]interface{}{
+										"name":  "AWS_SHARED_CREDENTIALS_FILE",
+										"value": "/credentials/cloud",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "plugins",
+										"mountPath": "/plugins",
+									},
+									map[string]interface{}{
+										"name":      "scratch",
+										"mountPath": "/scratch",
+									},
+									map[string]interface{}{
+										"name":      "cloud-credentials",
+										"mountPath": "/credentials",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "plugins",
+								"emptyDir": map[string]interface{}{},
+							},
+							map[string]interface{}{
+								"name":     "scratch",
+								"emptyDir": map[string]interface{}{},
+							},
+							map[string]interface{}{
+								"name": "cloud-credentials",
+								"secret": map[string]interface{}{
+									// controlled by field: storage.credentialsSecret
+									//  Name of the secret in the namespace of the component holding the credentials for the
+									//  object storage, in the AWS credentials file format under the cloud key.
+									"secretName": parent.Spec.Storage.CredentialsSecret,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceVelero(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/backupcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateBackupStorageLocationNamespaceDefault creates the BackupStorageLocation resource with name default.
+func CreateBackupStorageLocationNamespaceDefault(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "velero.io/v1",
+			"kind":       "BackupStorageLocation",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"component":                    "velero",
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+				},
+				"name":      "default",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"default":  true,
+				"provider": "aws",
+				"objectStorage": map[string]interface{}{
+					// controlled by field: storage.bucket
+					//  Name of the bucket of the S3-compatible object storage to store the backups in.
+					"bucket": parent.Spec.Storage.Bucket,
+				},
+				"config": map[string]interface{}{
+					// controlled by field: storage.region
+					//  Region of the S3-compatible object storage.
+					"region": parent.Spec.Storage.Region,
+				},
+			},
+		},
+	}
+
+	return mutate.MutateBackupStorageLocationNamespaceDefault(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=volumesnapshotlocations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateVolumeSnapshotLocationNamespaceDefault creates the VolumeSnapshotLocation resource with name default.
+func CreateVolumeSnapshotLocationNamespaceDefault(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.VolumeSnapshots.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=volumeSnapshots.enabled,value=true,include
+			"apiVersion": "velero.io/v1",
+			"kind":       "VolumeSnapshotLocation",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"component":                    "velero",
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+				},
+				"name":      "default",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"provider": "aws",
+				"config": map[string]interface{}{
+					// controlled by field: volumeSnapshots.region
+					//  Region of the volumes to snapshot.
+					"region": parent.Spec.VolumeSnapshots.Region,
+				},
+			},
+		},
+	}
+
+	return mutate.MutateVolumeSnapshotLocationNamespaceDefault(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/backupcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch;create;update;patch;delete
+
+// CreateScheduleNamespaceSupportServices creates the Schedule resource with name support-services.
+func CreateScheduleNamespaceSupportServices(
+	parent *platformv1alpha1.BackupComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.SupportServices.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=supportServices.enabled,value=true,include
+			"apiVersion": "velero.io/v1",
+			"kind":       "Schedule",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"component":                    "velero",
+					"platform.nukleros.io/group":   "backup",
+					"platform.nukleros.io/project": "velero",
+				},
+				"name":      "support-services",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: supportServices.schedule
+				//  Cron schedule of the backups of the support services custom resources.
+				"schedule":                   parent.Spec.SupportServices.Schedule,
+				"useOwnerReferencesInBackup": false,
+				"template": map[string]interface{}{
+					// controlled by field: supportServices.ttl
+					//  How long to keep the backups of the support services custom resources.
+					"ttl":                     parent.Spec.SupportServices.TTL,
+					"storageLocation":         "default",
+					"includeClusterResources": true,
+					"snapshotVolumes":         false,
+					"includedResources": []interface{}{
+						"supportservices.setup.addons.nukleros.io",
+						"tierprofiles.setup.addons.nukleros.io",
+						"certificatescomponents.platform.addons.nukleros.io",
+						"ingresscomponents.platform.addons.nukleros.io",
+						"secretscomponents.platform.addons.nukleros.io",
+						"monitoringcomponents.platform.addons.nukleros.io",
+						"loggingcomponents.platform.addons.nukleros.io",
+						"storagecomponents.platform.addons.nukleros.io",
+						"policycomponents.platform.addons.nukleros.io",
+						"backupcomponents.platform.addons.nukleros.io",
+						"databasecomponents.application.addons.nukleros.io",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateScheduleNamespaceSupportServices(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertBackupComponent = errors.New("unable to convert to BackupComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// BackupComponentSpec defines the desired state of BackupComponent.
+type BackupComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection BackupComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-backup-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-backup-system")
+	//
+	//	Namespace to use for backup support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Velero BackupComponentSpecVelero `json:"velero,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Storage BackupComponentSpecStorage `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	VolumeSnapshots BackupComponentSpecVolumeSnapshots `json:"volumeSnapshots,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	SupportServices BackupComponentSpecSupportServices `json:"supportServices,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespaces which are excluded from every schedule.  Defaults to the system namespaces of
+	//	kubernetes, of the operator and of the support services.
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Schedules of the backups of the namespaces of the cluster.
+	Schedules []BackupComponentSchedule `json:"schedules,omitempty"`
+}
+
+type BackupComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type BackupComponentSpecVelero struct {
+	// +kubebuilder:default="velero/velero"
+	// +kubebuilder:validation:Optional
+	// (Default: "velero/velero")
+	//
+	//	Image repo and name to use for velero.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the velero image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.10.2"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.10.2")
+	//
+	//	Version of velero to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Plugin BackupComponentSpecVeleroPlugin `json:"plugin,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the velero pod.  Settings which are set here take precedence over
+	//	the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the velero container.  Requests and limits which are not
+	//	set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type BackupComponentSpecVeleroPlugin struct {
+	// +kubebuilder:default="velero/velero-plugin-for-aws"
+	// +kubebuilder:validation:Optional
+	// (Default: "velero/velero-plugin-for-aws")
+	//
+	//	Image repo and name to use for the velero plugin for S3-compatible object storage.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:default="v1.6.1"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.6.1")
+	//
+	//	Version of the velero plugin for S3-compatible object storage to use.
+	Version string `json:"version,omitempty"`
+}
+
+type BackupComponentSpecStorage struct {
+	// +kubebuilder:default="velero"
+	// +kubebuilder:validation:Optional
+	// (Default: "velero")
+	//
+	//	Name of the bucket of the S3-compatible object storage to store the backups in.
+	Bucket string `json:"bucket,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Prefix within the bucket under which to store the backups.
+	Prefix string `json:"prefix,omitempty"`
+
+	// +kubebuilder:default="us-east-1"
+	// +kubebuilder:validation:Optional
+	// (Default: "us-east-1")
+	//
+	//	Region of the S3-compatible object storage.
+	Region string `json:"region,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	URL of S3-compatible object storage other than AWS, e.g. a MinIO endpoint such as
+	//	http://minio.nukleros-object-storage-system.svc:9000.  Buckets are addressed by path
+	//	rather than by virtual host when set.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to skip verifying the TLS certificate of the endpoint.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// +kubebuilder:default="velero-credentials"
+	// +kubebuilder:validation:Optional
+	// (Default: "velero-credentials")
+	//
+	//	Name of the secret in the namespace of the component holding the credentials for the
+	//	object storage, in the AWS credentials file format under the cloud key.
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of an ObjectStorageComponent whose object storage to store the backups in, in place of
+	//	the endpoint, region and credentials secret.  The component must declare the bucket and
+	//	publish its credentials in the namespace of this component.  The reference is resolved when
+	//	reconciling, so manifests generated from the CLI use the endpoint settings instead.
+	ObjectStorage string `json:"objectStorage,omitempty"`
+}
+
+type BackupComponentSpecVolumeSnapshots struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install a volume snapshot location, which snapshots the persistent volumes
+	//	of the backed up namespaces.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="us-east-1"
+	// +kubebuilder:validation:Optional
+	// (Default: "us-east-1")
+	//
+	//	Region of the volumes to snapshot.
+	Region string `json:"region,omitempty"`
+}
+
+type BackupComponentSpecSupportServices struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to back up the support services custom resources, from which the support
+	//	services of the cluster can be rebuilt.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="0 */6 * * *"
+	// +kubebuilder:validation:Optional
+	// (Default: "0 */6 * * *")
+	//
+	//	Cron schedule of the backups of the support services custom resources.
+	Schedule string `json:"schedule,omitempty"`
+
+	// +kubebuilder:default="720h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "720h0m0s")
+	//
+	//	How long to keep the backups of the support services custom resources.
+	TTL string `json:"ttl,omitempty"`
+}
+
+type BackupComponentSchedule struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//	Name of the schedule.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	//	Cron schedule of the backups.
+	Schedule string `json:"schedule"`
+
+	// +kubebuilder:default="720h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "720h0m0s")
+	//
+	//	How long to keep the backups.
+	TTL string `json:"ttl,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespaces to back up.  Defaults to every namespace.
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespaces to exclude from the backups, in addition to the excluded namespaces of the
+	//	component.
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to snapshot the persistent volumes of the backed up namespaces.  Defaults to
+	//	whether volume snapshots are enabled.
+	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
+}
+
+type BackupComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// BackupComponent is the Schema for the backupcomponents API.
+type BackupComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BackupComponentSpec   `json:"spec,omitempty"`
+	Status            BackupComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupComponentList contains a list of BackupComponent.
+type BackupComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *BackupComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *BackupComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *BackupComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *BackupComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *BackupComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *BackupComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *BackupComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *BackupComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *BackupComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.  Backups which are stored in the
+// object storage of an ObjectStorageComponent depend on the component.
+func (component *BackupComponent) GetDependencies() []workload.Workload {
+	if component.Spec.Storage.ObjectStorage != "" {
+		return []workload.Workload{&ObjectStorageComponent{}}
+	}
+
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*BackupComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("BackupComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *BackupComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *BackupComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name.  velero does not support running more than one server, so none of the
+// workloads of the component are replicated.
+func (component *BackupComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return map[string]int{}
+}
+
+func init() {
+	SchemeBuilder.Register(&BackupComponent{}, &BackupComponentList{})
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatescomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/certificatescomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=clusterissuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterIssuerNuklerosSelfsigned creates the ClusterIssuer resource with name nukleros-selfsigned.
+func CreateClusterIssuerNuklerosSelfsigned(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "ClusterIssuer",
+			"metadata": map[string]interface{}{
+				"name": "nukleros-selfsigned",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"selfSigned": map[string]interface{}{},
+			},
+		},
+	}
+
+	return mutate.MutateClusterIssuerNuklerosSelfsigned(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceNuklerosPlatformCa creates the Certificate resource with name nukleros-platform-ca.
+func CreateCertNamespaceNuklerosPlatformCa(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      "nukleros-platform-ca",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"isCA":       true,
+				"commonName": "nukleros-platform-ca",
+				"secretName": "nukleros-platform-ca",
+				"duration":   "87600h",
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+					"size":      256,
+				},
+				"issuerRef": map[string]interface{}{
+					"name":  "nukleros-selfsigned",
+					"kind":  "ClusterIssuer",
+					"group": "cert-manager.io",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceNuklerosPlatformCa(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=clusterissuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterIssuerNuklerosPlatformCa creates the ClusterIssuer resource with name nukleros-platform-ca.
+func CreateClusterIssuerNuklerosPlatformCa(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "ClusterIssuer",
+			"metadata": map[string]interface{}{
+				"name": "nukleros-platform-ca",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"ca": map[string]interface{}{
+					"secretName": "nukleros-platform-ca",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterIssuerNuklerosPlatformCa(resourceObj, parent, collection, reconciler, req)
+}
@@ -67,4 +67,9 @@ const (
 	ServiceNamespaceCertManagerWebhook                                = "cert-manager-webhook"
 	MutatingWebhookCertManagerWebhook                                 = "cert-manager-webhook"
 	ValidatingWebhookCertManagerWebhook                               = "cert-manager-webhook"
+	ClusterIssuerNuklerosSelfsigned                                   = "nukleros-selfsigned"
+	CertNamespaceNuklerosPlatformCa                                   = "nukleros-platform-ca"
+	ClusterIssuerNuklerosPlatformCa                                   = "nukleros-platform-ca"
+	ServiceMonitorNamespaceCertManager                                = "cert-manager"
+	PrometheusRuleNamespaceCertManager                                = "cert-manager"
 )
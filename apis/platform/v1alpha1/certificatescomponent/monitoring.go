@@ -0,0 +1,158 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatescomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/certificatescomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceMonitorNamespaceCertManager creates the ServiceMonitor resource with name cert-manager.
+func CreateServiceMonitorNamespaceCertManager(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "ServiceMonitor",
+			"metadata": map[string]interface{}{
+				"name":      "cert-manager",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "cert-manager",
+					"app.kubernetes.io/instance":   "cert-manager",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"jobLabel": "app.kubernetes.io/name",
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "cert-manager",
+						"app.kubernetes.io/instance":  "cert-manager",
+						"app.kubernetes.io/component": "controller",
+					},
+				},
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"port":          "tcp-prometheus-servicemonitor",
+						"path":          "/metrics",
+						"interval":      "60s",
+						"scrapeTimeout": "30s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceMonitorNamespaceCertManager(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespaceCertManager creates the PrometheusRule resource with name cert-manager.
+func CreatePrometheusRuleNamespaceCertManager(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "cert-manager",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "cert-manager",
+					"app.kubernetes.io/instance":   "cert-manager",
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "cert-manager",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "CertManagerCertificateExpiringSoon",
+								"expr":  "(certmanager_certificate_expiration_timestamp_seconds - time()) < 21 * 24 * 3600",
+								"for":   "1h",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Certificate is about to expire.",
+									"description": "The certificate {{ $labels.name }} in namespace {{ $labels.exported_namespace }} expires in less than 21 days.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "CertManagerCertificateNotReady",
+								"expr":  "max by (name, exported_namespace, condition) (certmanager_certificate_ready_status{condition!=\"True\"} == 1)",
+								"for":   "10m",
+								"labels": map[string]interface{}{
+									"severity": "critical",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Certificate is not ready.",
+									"description": "The certificate {{ $labels.name }} in namespace {{ $labels.exported_namespace }} has not been ready for 10 minutes.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "CertManagerACMERequestFailures",
+								"expr":  "sum by (host, status) (rate(certmanager_http_acme_client_request_count{status=~\"429|5..\"}[5m])) > 0",
+								"for":   "15m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Requests to the ACME server are failing.",
+									"description": "Requests to the ACME server {{ $labels.host }} have failed with status {{ $labels.status }} for 15 minutes.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespaceCertManager(resourceObj, parent, collection, reconciler, req)
+}
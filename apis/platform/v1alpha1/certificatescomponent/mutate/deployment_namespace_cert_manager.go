@@ -23,20 +23,65 @@ import (
 
 	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
 )
 
+// acmeSolverImage is the image which the cert-manager controller uses for http01 challenge solver pods.
+const acmeSolverImage = "quay.io/jetstack/cert-manager-acmesolver"
+
 // MutateDeploymentNamespaceCertManager mutates the Deployment resource with name cert-manager.
 func MutateDeploymentNamespaceCertManager(
 	original client.Object,
 	parent *platformv1alpha1.CertificatesComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "cert-manager", parent.Spec.CertManager.Controller.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, parent.Spec.CertManager.Controller.Scheduling); err != nil {
+		return nil, err
+	}
+
+	if err := setACMESolverImage(original, parent, collection); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "cert-manager", parent.Spec.CertManager.Controller.Resources); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
 }
+
+// setACMESolverImage points the cert-manager controller at the mirrored http01 solver image.  The
+// solver pods are created by the controller rather than the operator, so their image is not
+// otherwise subject to the registry mirrors of the collection.
+func setACMESolverImage(
+	original client.Object,
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+) error {
+	if len(collection.Spec.RegistryMirrors) == 0 {
+		return nil
+	}
+
+	image := podtemplate.MirrorImage(acmeSolverImage+":"+parent.Spec.CertManager.Version, collection.Spec.RegistryMirrors)
+
+	return podtemplate.SetArg(original, "cert-manager", "--acme-http01-solver-image", image)
+}
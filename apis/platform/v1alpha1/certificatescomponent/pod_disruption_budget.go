@@ -0,0 +1,179 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatescomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/certificatescomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodDisruptionBudgetNamespaceCertManagerCainjector creates the PodDisruptionBudget resource with name cert-manager-cainjector.
+func CreatePodDisruptionBudgetNamespaceCertManagerCainjector(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	// only protect the workload when it runs more than one replica
+	protected, err := tier.ProtectsWorkload(collection, parent, "cert-manager-cainjector")
+	if err != nil {
+		return nil, err
+	}
+
+	if !protected {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata": map[string]interface{}{
+				"name":      "cert-manager-cainjector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+					"app.kubernetes.io/name":       "cainjector",
+					"app.kubernetes.io/instance":   "cert-manager",
+					"app.kubernetes.io/component":  "cainjector",
+				},
+			},
+			"spec": map[string]interface{}{
+				"maxUnavailable": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "cainjector",
+						"app.kubernetes.io/instance":  "cert-manager",
+						"app.kubernetes.io/component": "cainjector",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodDisruptionBudgetNamespaceCertManagerCainjector(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodDisruptionBudgetNamespaceCertManager creates the PodDisruptionBudget resource with name cert-manager.
+func CreatePodDisruptionBudgetNamespaceCertManager(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	// only protect the workload when it runs more than one replica
+	protected, err := tier.ProtectsWorkload(collection, parent, "cert-manager")
+	if err != nil {
+		return nil, err
+	}
+
+	if !protected {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata": map[string]interface{}{
+				"name":      "cert-manager",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+					"app.kubernetes.io/name":       "cert-manager",
+					"app.kubernetes.io/instance":   "cert-manager",
+					"app.kubernetes.io/component":  "controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"maxUnavailable": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "cert-manager",
+						"app.kubernetes.io/instance":  "cert-manager",
+						"app.kubernetes.io/component": "controller",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodDisruptionBudgetNamespaceCertManager(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodDisruptionBudgetNamespaceCertManagerWebhook creates the PodDisruptionBudget resource with name cert-manager-webhook.
+func CreatePodDisruptionBudgetNamespaceCertManagerWebhook(
+	parent *platformv1alpha1.CertificatesComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	// only protect the workload when it runs more than one replica
+	protected, err := tier.ProtectsWorkload(collection, parent, "cert-manager-webhook")
+	if err != nil {
+		return nil, err
+	}
+
+	if !protected {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata": map[string]interface{}{
+				"name":      "cert-manager-webhook",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "certificates",
+					"platform.nukleros.io/project": "cert-manager",
+					"app.kubernetes.io/name":       "webhook",
+					"app.kubernetes.io/instance":   "cert-manager",
+					"app.kubernetes.io/component":  "webhook",
+				},
+			},
+			"spec": map[string]interface{}{
+				"maxUnavailable": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "webhook",
+						"app.kubernetes.io/instance":  "cert-manager",
+						"app.kubernetes.io/component": "webhook",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodDisruptionBudgetNamespaceCertManagerWebhook(resourceObj, parent, collection, reconciler, req)
+}
@@ -40,15 +40,21 @@ spec:
   namespace: "nukleros-certs-system"
   certManager:
     cainjector:
-      replicas: 2
+      #replicas: 2
       image: "quay.io/jetstack/cert-manager-cainjector"
+      #digest: ""
     version: "v1.9.1"
     controller:
-      replicas: 2
+      #replicas: 2
       image: "quay.io/jetstack/cert-manager-controller"
+      #digest: ""
     webhook:
-      replicas: 2
+      #replicas: 2
       image: "quay.io/jetstack/cert-manager-webhook"
+      #digest: ""
+  monitoring:
+    enabled: false
+    alerts: false
 `
 
 // sampleCertificatesComponentRequired is a sample containing only required fields
@@ -137,8 +143,14 @@ var CreateFuncs = []func(
 	CreateDeploymentNamespaceCertManagerCainjector,
 	CreateDeploymentNamespaceCertManager,
 	CreateDeploymentNamespaceCertManagerWebhook,
+	CreatePodDisruptionBudgetNamespaceCertManagerCainjector,
+	CreatePodDisruptionBudgetNamespaceCertManager,
+	CreatePodDisruptionBudgetNamespaceCertManagerWebhook,
 	CreateClusterIssuerLetsencryptStaging,
 	CreateClusterIssuerLetsencryptProduction,
+	CreateClusterIssuerNuklerosSelfsigned,
+	CreateCertNamespaceNuklerosPlatformCa,
+	CreateClusterIssuerNuklerosPlatformCa,
 	CreateServiceAccountNamespaceCertManagerCainjector,
 	CreateServiceAccountNamespaceCertManager,
 	CreateServiceAccountNamespaceCertManagerWebhook,
@@ -174,6 +186,8 @@ var CreateFuncs = []func(
 	CreateServiceNamespaceCertManagerWebhook,
 	CreateMutatingWebhookCertManagerWebhook,
 	CreateValidatingWebhookCertManagerWebhook,
+	CreateServiceMonitorNamespaceCertManager,
+	CreatePrometheusRuleNamespaceCertManager,
 }
 
 // InitFuncs is an array of functions that are called prior to starting the controller manager.  This is
@@ -21,8 +21,11 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 )
 
 var ErrUnableToConvertCertificatesComponent = errors.New("unable to convert to CertificatesComponent")
@@ -52,6 +55,11 @@ type CertificatesComponentSpec struct {
 
 	// +kubebuilder:validation:Optional
 	CertManager CertificatesComponentSpecCertManager `json:"certManager,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Monitoring resources for cert-manager.  Requires the prometheus-operator custom resource
+	//	definitions, e.g. from the monitoring component.
+	Monitoring setupv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
 }
 
 type CertificatesComponentCollectionSpec struct {
@@ -85,51 +93,111 @@ type CertificatesComponentSpecCertManager struct {
 }
 
 type CertificatesComponentSpecCertManagerCainjector struct {
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the cert-manager cainjector deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the cert-manager cainjector deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the cert-manager cainjector deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
 	// +kubebuilder:default="quay.io/jetstack/cert-manager-cainjector"
 	// +kubebuilder:validation:Optional
 	// (Default: "quay.io/jetstack/cert-manager-cainjector")
 	//
 	//	Image repo and name to use for cert-manager cainjector.
 	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the cert-manager cainjector image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the cert-manager cainjector pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the cert-manager cainjector container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type CertificatesComponentSpecCertManagerController struct {
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the cert-manager controller deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the cert-manager controller deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the cert-manager controller deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
 	// +kubebuilder:default="quay.io/jetstack/cert-manager-controller"
 	// +kubebuilder:validation:Optional
 	// (Default: "quay.io/jetstack/cert-manager-controller")
 	//
 	//	Image repo and name to use for cert-manager controller.
 	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the cert-manager controller image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the cert-manager controller pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the cert-manager controller container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type CertificatesComponentSpecCertManagerWebhook struct {
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the cert-manager webhook deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the cert-manager webhook deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the cert-manager webhook deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
 	// +kubebuilder:default="quay.io/jetstack/cert-manager-webhook"
 	// +kubebuilder:validation:Optional
 	// (Default: "quay.io/jetstack/cert-manager-webhook")
 	//
 	//	Image repo and name to use for cert-manager webhook.
 	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the cert-manager webhook image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the cert-manager webhook pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the cert-manager webhook container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // CertificatesComponentStatus defines the observed state of CertificatesComponent.
@@ -141,6 +209,9 @@ type CertificatesComponentStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -226,6 +297,19 @@ func (component *CertificatesComponent) SetChildResourceCondition(resource *stat
 	component.Status.Resources = append(component.Status.Resources, resource)
 }
 
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *CertificatesComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
 // GetDependencies returns the dependencies for a component.
 func (*CertificatesComponent) GetDependencies() []workload.Workload {
 	return []workload.Workload{}
@@ -236,6 +320,39 @@ func (*CertificatesComponent) GetWorkloadGVK() schema.GroupVersionKind {
 	return GroupVersion.WithKind("CertificatesComponent")
 }
 
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *CertificatesComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *CertificatesComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *CertificatesComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return map[string]int{
+		"cert-manager":            profile.ReplicasFor(component.Spec.CertManager.Controller.Replicas),
+		"cert-manager-cainjector": profile.ReplicasFor(component.Spec.CertManager.Cainjector.Replicas),
+		"cert-manager-webhook":    profile.ReplicasFor(component.Spec.CertManager.Webhook.Replicas),
+	}
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *CertificatesComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	certManager := component.Spec.CertManager
+
+	return map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"cert-manager":            certManager.Controller.PodDisruptionBudget,
+		"cert-manager-cainjector": certManager.Cainjector.PodDisruptionBudget,
+		"cert-manager-webhook":    certManager.Webhook.PodDisruptionBudget,
+	}
+}
+
 func init() {
 	SchemeBuilder.Register(&CertificatesComponent{}, &CertificatesComponentList{})
 }
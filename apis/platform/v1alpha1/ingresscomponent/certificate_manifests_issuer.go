@@ -0,0 +1,133 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIssuerNamespaceLetsencryptDns01Staging creates the Issuer resource with name letsencrypt-dns01-staging.
+func CreateIssuerNamespaceLetsencryptDns01Staging(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Certificate.Wildcard {
+		return []client.Object{}, nil
+	}
+
+	// only the issuer of the tier of the collection is used
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Issuer != tier.IssuerStaging {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Issuer",
+			"metadata": map[string]interface{}{
+				// the issuer is namespaced so that it may use the credentials of external-dns
+				"name":      "letsencrypt-dns01-staging",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"acme": map[string]interface{}{
+					"server": "https://acme-staging-v02.api.letsencrypt.org/directory",
+					"email":  parent.Spec.Certificate.ContactEmail, //  controlled by field: certificate.contactEmail
+					"privateKeySecretRef": map[string]interface{}{
+						"name": "letsencrypt-dns01-staging",
+					},
+					"solvers": []interface{}{},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateIssuerNamespaceLetsencryptDns01Staging(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIssuerNamespaceLetsencryptDns01Production creates the Issuer resource with name letsencrypt-dns01-production.
+func CreateIssuerNamespaceLetsencryptDns01Production(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Certificate.Wildcard {
+		return []client.Object{}, nil
+	}
+
+	// only the issuer of the tier of the collection is used
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Issuer != tier.IssuerProduction {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Issuer",
+			"metadata": map[string]interface{}{
+				// the issuer is namespaced so that it may use the credentials of external-dns
+				"name":      "letsencrypt-dns01-production",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"acme": map[string]interface{}{
+					"server": "https://acme-v02.api.letsencrypt.org/directory",
+					"email":  parent.Spec.Certificate.ContactEmail, //  controlled by field: certificate.contactEmail
+					"privateKeySecretRef": map[string]interface{}{
+						"name": "letsencrypt-dns01-production",
+					},
+					"solvers": []interface{}{},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateIssuerNamespaceLetsencryptDns01Production(resourceObj, parent, collection, reconciler, req)
+}
@@ -31,6 +31,8 @@ const (
 	ServiceAccountNamespaceExternalDns                   = "external-dns"
 	ClusterRoleNamespaceExternalDns                      = "external-dns"
 	ClusterRoleBindingExternalDnsViewer                  = "external-dns-viewer"
+	PodMonitorNamespaceExternalDns                       = "external-dns"
+	PrometheusRuleNamespaceExternalDns                   = "external-dns"
 	CertNamespaceNginxDefaultServerSecretNonProd         = "nginx-default-server-secret-non-prod"
 	CertNamespaceNginxDefaultServerSecretProd            = "nginx-default-server-secret-prod"
 	ConfigMapNamespaceNginxConfig                        = "nginx-config"
@@ -48,6 +50,8 @@ const (
 	ClusterRoleBindingNginxIngress                       = "nginx-ingress"
 	ServiceNamespaceNginxIngressAws                      = "nginx-ingress-aws"
 	ServiceNamespaceNginxIngressGcpAzure                 = "nginx-ingress-gcp-azure"
+	PodMonitorNamespaceNginxIngress                      = "nginx-ingress"
+	PrometheusRuleNamespaceNginxIngress                  = "nginx-ingress"
 	CRDKongclusterpluginsConfigurationKonghqCom          = "kongclusterplugins.configuration.konghq.com"
 	CRDKongconsumersConfigurationKonghqCom               = "kongconsumers.configuration.konghq.com"
 	CRDKongingressesConfigurationKonghqCom               = "kongingresses.configuration.konghq.com"
@@ -63,4 +67,6 @@ const (
 	ClusterRoleBindingKongIngress                        = "kong-ingress"
 	ServiceNamespaceKongProxy                            = "kong-proxy"
 	ServiceNamespaceKongValidationWebhook                = "kong-validation-webhook"
+	PodMonitorNamespaceKong                              = "kong"
+	PrometheusRuleNamespaceKong                          = "kong"
 )
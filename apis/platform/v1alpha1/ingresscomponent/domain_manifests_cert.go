@@ -0,0 +1,95 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceDomains creates the Certificate resources of the domains which request a
+// dedicated certificate.
+func CreateCertNamespaceDomains(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	// the issuer of the tier of the collection is the default issuer of the domains
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+
+	for _, domain := range parent.Spec.Domains {
+		if !domain.Certificate {
+			continue
+		}
+
+		issuer := domain.Issuer
+		if issuer.Name == "" {
+			issuer.Name = profile.Issuer
+		}
+
+		if issuer.Kind == "" {
+			issuer.Kind = "ClusterIssuer"
+		}
+
+		name := domain.SecretName()
+
+		var resourceObj = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+				"spec": map[string]interface{}{
+					"secretName": name,
+					"dnsNames": []interface{}{
+						domain.Name, //  controlled by field: domains.name
+					},
+					"issuerRef": map[string]interface{}{
+						"name": issuer.Name, //  controlled by field: domains.issuer.name
+						"kind": issuer.Kind, //  controlled by field: domains.issuer.kind
+					},
+				},
+			},
+		}
+
+		mutated, err := mutate.MutateCertNamespaceDomain(resourceObj, parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, mutated...)
+	}
+
+	return resourceObjs, nil
+}
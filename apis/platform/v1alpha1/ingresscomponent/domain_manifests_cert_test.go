@@ -0,0 +1,73 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent_test
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+func TestCertNamespaceDomainsNames(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat(strings.Repeat("a", 63)+".", 3) + strings.Repeat("b", 49) + ".com"
+
+	parent := &platformv1alpha1.IngressComponent{}
+	parent.Spec.Namespace = "nukleros-ingress-system"
+	parent.Spec.Domains = []platformv1alpha1.IngressComponentSpecDomain{
+		{Name: "a-b.example.com", Certificate: true},
+		{Name: "a.b-example.com", Certificate: true},
+		{Name: long, Certificate: true},
+		{Name: "plain.example.com"},
+	}
+
+	collection := &setupv1alpha1.SupportServices{}
+	collection.Spec.Tier = "development"
+
+	certificates, err := ingresscomponent.CreateCertNamespaceDomains(parent, collection, nil, nil)
+	if err != nil || len(certificates) != 3 {
+		t.Fatalf("CreateCertNamespaceDomains() = %v, %v, want 3 certificates", certificates, err)
+	}
+
+	names := map[string]bool{}
+
+	for _, certificate := range certificates {
+		name := certificate.GetName()
+		secretName, _, _ := unstructured.NestedString(certificate.(*unstructured.Unstructured).Object, "spec", "secretName")
+
+		if secretName != name {
+			t.Errorf("secretName = %s, want %s", secretName, name)
+		}
+
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			t.Errorf("name %s is invalid, %v", name, errs)
+		}
+
+		if names[name] {
+			t.Errorf("name %s is used by more than one domain", name)
+		}
+
+		names[name] = true
+	}
+}
@@ -97,6 +97,13 @@ func CreateDeploymentNamespaceExternalDnsActiveDirectory(
 									},
 								},
 								"imagePullPolicy": "IfNotPresent",
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "metrics",
+										"containerPort": 7979,
+										"protocol":      "TCP",
+									},
+								},
 								"securityContext": map[string]interface{}{
 									"allowPrivilegeEscalation": false,
 									"readOnlyRootFilesystem":   true,
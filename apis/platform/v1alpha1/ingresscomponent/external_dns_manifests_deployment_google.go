@@ -95,6 +95,13 @@ func CreateDeploymentNamespaceExternalDnsGoogle(
 									},
 								},
 								"imagePullPolicy": "IfNotPresent",
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "metrics",
+										"containerPort": 7979,
+										"protocol":      "TCP",
+									},
+								},
 								"securityContext": map[string]interface{}{
 									"allowPrivilegeEscalation": false,
 									"readOnlyRootFilesystem":   true,
@@ -0,0 +1,139 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodMonitorNamespaceExternalDns creates the PodMonitor resource with name external-dns.
+func CreatePodMonitorNamespaceExternalDns(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PodMonitor",
+			"metadata": map[string]interface{}{
+				"name":      "external-dns",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/instance":   "external-dns",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "external-dns",
+				},
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/instance": "external-dns",
+					},
+				},
+				"podMetricsEndpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "metrics",
+						"path":     "/metrics",
+						"interval": "60s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodMonitorNamespaceExternalDns(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespaceExternalDns creates the PrometheusRule resource with name external-dns.
+func CreatePrometheusRuleNamespaceExternalDns(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "external-dns",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/instance":   "external-dns",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "external-dns",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "external-dns",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "ExternalDNSRegistryErrors",
+								"expr":  "sum by (namespace, pod) (increase(external_dns_registry_errors_total[10m])) > 0",
+								"for":   "15m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "External-dns is failing to update DNS records.",
+									"description": "The external-dns pod {{ $labels.pod }} has failed to read or write the records of its DNS provider for 15 minutes.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "ExternalDNSSourceErrors",
+								"expr":  "sum by (namespace, pod) (increase(external_dns_source_errors_total[10m])) > 0",
+								"for":   "15m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "External-dns is failing to read its sources.",
+									"description": "The external-dns pod {{ $labels.pod }} has failed to read the services and ingresses of the cluster for 15 minutes.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespaceExternalDns(resourceObj, parent, collection, reconciler, req)
+}
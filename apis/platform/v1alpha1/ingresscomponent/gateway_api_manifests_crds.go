@@ -0,0 +1,358 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// The Gateway API CRDs are those of the v1alpha2 version of the API which is implemented by the
+// kong ingress controller.  Their schemas are structural only, leaving the validation of the
+// specs to the controller.
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDGatewayclassesGatewayNetworkingK8sIo creates the CustomResourceDefinition resource with name gatewayclasses.gateway.networking.k8s.io.
+func CreateCRDGatewayclassesGatewayNetworkingK8sIo(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled || !parent.Spec.GatewayAPI.InstallCRDs {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io":               "https://github.com/kubernetes-sigs/gateway-api/pull/891",
+					"gateway.networking.k8s.io/bundle-version": "v0.4.3",
+				},
+				"name": "gatewayclasses.gateway.networking.k8s.io",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "gateway-api",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "gateway.networking.k8s.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"gateway-api",
+					},
+					"kind":     "GatewayClass",
+					"listKind": "GatewayClassList",
+					"plural":   "gatewayclasses",
+					"shortNames": []interface{}{
+						"gc",
+					},
+					"singular": "gatewayclass",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDGatewayclassesGatewayNetworkingK8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDGatewaysGatewayNetworkingK8sIo creates the CustomResourceDefinition resource with name gateways.gateway.networking.k8s.io.
+func CreateCRDGatewaysGatewayNetworkingK8sIo(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled || !parent.Spec.GatewayAPI.InstallCRDs {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io":               "https://github.com/kubernetes-sigs/gateway-api/pull/891",
+					"gateway.networking.k8s.io/bundle-version": "v0.4.3",
+				},
+				"name": "gateways.gateway.networking.k8s.io",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "gateway-api",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "gateway.networking.k8s.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"gateway-api",
+					},
+					"kind":     "Gateway",
+					"listKind": "GatewayList",
+					"plural":   "gateways",
+					"shortNames": []interface{}{
+						"gtw",
+					},
+					"singular": "gateway",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDGatewaysGatewayNetworkingK8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDHttproutesGatewayNetworkingK8sIo creates the CustomResourceDefinition resource with name httproutes.gateway.networking.k8s.io.
+func CreateCRDHttproutesGatewayNetworkingK8sIo(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled || !parent.Spec.GatewayAPI.InstallCRDs {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io":               "https://github.com/kubernetes-sigs/gateway-api/pull/891",
+					"gateway.networking.k8s.io/bundle-version": "v0.4.3",
+				},
+				"name": "httproutes.gateway.networking.k8s.io",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "gateway-api",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "gateway.networking.k8s.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"gateway-api",
+					},
+					"kind":     "HTTPRoute",
+					"listKind": "HTTPRouteList",
+					"plural":   "httproutes",
+					"singular": "httproute",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDHttproutesGatewayNetworkingK8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDReferencepoliciesGatewayNetworkingK8sIo creates the CustomResourceDefinition resource with name referencepolicies.gateway.networking.k8s.io.
+func CreateCRDReferencepoliciesGatewayNetworkingK8sIo(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled || !parent.Spec.GatewayAPI.InstallCRDs {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io":               "https://github.com/kubernetes-sigs/gateway-api/pull/891",
+					"gateway.networking.k8s.io/bundle-version": "v0.4.3",
+				},
+				"name": "referencepolicies.gateway.networking.k8s.io",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "gateway-api",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "gateway.networking.k8s.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"gateway-api",
+					},
+					"kind":     "ReferencePolicy",
+					"listKind": "ReferencePolicyList",
+					"plural":   "referencepolicies",
+					"shortNames": []interface{}{
+						"refpol",
+					},
+					"singular": "referencepolicy",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDReferencepoliciesGatewayNetworkingK8sIo(resourceObj, parent, collection, reconciler, req)
+}
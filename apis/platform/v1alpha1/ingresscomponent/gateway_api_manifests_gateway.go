@@ -0,0 +1,130 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateGatewayClassKong creates the GatewayClass resource with name kong.
+func CreateGatewayClassKong(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled || parent.Spec.GatewayAPI.Controller != "kong" {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+			"kind":       "GatewayClass",
+			"metadata": map[string]interface{}{
+				"name": "kong",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"controllerName": "konghq.com/kic-gateway-controller",
+			},
+		},
+	}
+
+	return mutate.MutateGatewayClassKong(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;create;update;patch;delete
+
+// CreateGatewayNamespaceDefault creates the Gateway resource with name default.
+func CreateGatewayNamespaceDefault(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.GatewayAPI.Enabled {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+			"kind":       "Gateway",
+			"metadata": map[string]interface{}{
+				"name":      parent.Spec.GatewayAPI.GatewayName, //  controlled by field: gatewayAPI.gatewayName
+				"namespace": parent.Spec.Namespace,              //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "gateway-api",
+				},
+			},
+			"spec": map[string]interface{}{
+				"gatewayClassName": parent.Spec.GatewayAPI.Controller, //  controlled by field: gatewayAPI.controller
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"name":     "http",
+						"protocol": "HTTP",
+						"port":     80,
+						"hostname": parent.Spec.DomainName, //  controlled by field: domainName
+						"allowedRoutes": map[string]interface{}{
+							"namespaces": map[string]interface{}{
+								"from": parent.Spec.GatewayAPI.AllowedRoutes, //  controlled by field: gatewayAPI.allowedRoutes
+							},
+						},
+					},
+					map[string]interface{}{
+						"name":     "https",
+						"protocol": "HTTPS",
+						"port":     443,
+						"hostname": parent.Spec.DomainName, //  controlled by field: domainName
+						"tls": map[string]interface{}{
+							"mode": "Terminate",
+							// the certificate of the default server, which is issued for the domain name
+							"certificateRefs": []interface{}{
+								map[string]interface{}{
+									"group": "",
+									"kind":  "Secret",
+									"name":  "default-server-secret",
+								},
+							},
+						},
+						"allowedRoutes": map[string]interface{}{
+							"namespaces": map[string]interface{}{
+								"from": parent.Spec.GatewayAPI.AllowedRoutes, //  controlled by field: gatewayAPI.allowedRoutes
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateGatewayNamespaceDefault(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodMonitorNamespaceKong creates the PodMonitor resource with name kong.
+func CreatePodMonitorNamespaceKong(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PodMonitor",
+			"metadata": map[string]interface{}{
+				"name":      "kong",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app":                          "ingress-kong",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app": "ingress-kong",
+					},
+				},
+				"podMetricsEndpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "metrics",
+						"path":     "/metrics",
+						"interval": "30s",
+					},
+					map[string]interface{}{
+						"port":     "cmetrics",
+						"path":     "/metrics",
+						"interval": "30s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodMonitorNamespaceKong(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespaceKong creates the PrometheusRule resource with name kong.
+func CreatePrometheusRuleNamespaceKong(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "kong",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app":                          "ingress-kong",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "kong",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "KongHighErrorRate",
+								"expr":  "sum by (service) (rate(kong_http_status{code=~\"5..\"}[5m])) / sum by (service) (rate(kong_http_status[5m])) > 0.05",
+								"for":   "10m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Kong is returning a high rate of 5xx responses.",
+									"description": "More than 5% of the requests to the service {{ $labels.service }} have returned a 5xx response for 10 minutes.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "KongConfigurationPushFailed",
+								"expr":  "sum by (namespace, pod) (increase(ingress_controller_configuration_push_count{success=\"false\"}[10m])) > 0",
+								"for":   "10m",
+								"labels": map[string]interface{}{
+									"severity": "critical",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "The kong ingress controller failed to push its configuration.",
+									"description": "The ingress controller {{ $labels.pod }} has failed to push its configuration to kong, so changes to ingress resources are not applied.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespaceKong(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongclusterplugins,verbs=get;list;watch;create;update;patch;delete
+
+// CreateKongClusterPluginGlobalRateLimiting creates the KongClusterPlugin resource with name global-rate-limiting.
+func CreateKongClusterPluginGlobalRateLimiting(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Kong.Plugins.RateLimiting.Enabled {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "configuration.konghq.com/v1",
+			"kind":       "KongClusterPlugin",
+			"metadata": map[string]interface{}{
+				"name": "global-rate-limiting",
+				"annotations": map[string]interface{}{
+					"kubernetes.io/ingress.class": "kong",
+				},
+				"labels": map[string]interface{}{
+					// applies the plugin to all services proxied by kong
+					"global":                       "true",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"plugin": "rate-limiting",
+			"config": map[string]interface{}{
+				// kong is installed without a database, so counters are kept by each kong pod
+				"policy":   "local",
+				"limit_by": parent.Spec.Kong.Plugins.RateLimiting.LimitBy, //  controlled by field: kong.plugins.rateLimiting.limitBy
+			},
+		},
+	}
+
+	return mutate.MutateKongClusterPluginGlobalRateLimiting(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongclusterplugins,verbs=get;list;watch;create;update;patch;delete
+
+// CreateKongClusterPluginGlobalCors creates the KongClusterPlugin resource with name global-cors.
+func CreateKongClusterPluginGlobalCors(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Kong.Plugins.CORS.Enabled {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "configuration.konghq.com/v1",
+			"kind":       "KongClusterPlugin",
+			"metadata": map[string]interface{}{
+				"name": "global-cors",
+				"annotations": map[string]interface{}{
+					"kubernetes.io/ingress.class": "kong",
+				},
+				"labels": map[string]interface{}{
+					// applies the plugin to all services proxied by kong
+					"global":                       "true",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"plugin": "cors",
+			"config": map[string]interface{}{
+				"credentials": parent.Spec.Kong.Plugins.CORS.Credentials, //  controlled by field: kong.plugins.cors.credentials
+			},
+		},
+	}
+
+	return mutate.MutateKongClusterPluginGlobalCors(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongclusterplugins,verbs=get;list;watch;create;update;patch;delete
+
+// CreateKongClusterPluginGlobalPrometheus creates the KongClusterPlugin resource with name global-prometheus.
+func CreateKongClusterPluginGlobalPrometheus(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Kong.Plugins.Prometheus.Enabled {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "configuration.konghq.com/v1",
+			"kind":       "KongClusterPlugin",
+			"metadata": map[string]interface{}{
+				"name": "global-prometheus",
+				"annotations": map[string]interface{}{
+					"kubernetes.io/ingress.class": "kong",
+				},
+				"labels": map[string]interface{}{
+					// applies the plugin to all services proxied by kong
+					"global":                       "true",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"plugin": "prometheus",
+			"config": map[string]interface{}{
+				"per_consumer": parent.Spec.Kong.Plugins.Prometheus.PerConsumer, //  controlled by field: kong.plugins.prometheus.perConsumer
+			},
+		},
+	}
+
+	return mutate.MutateKongClusterPluginGlobalPrometheus(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongclusterplugins,verbs=get;list;watch;create;update;patch;delete
+
+// CreateKongClusterPluginGlobalRequestId creates the KongClusterPlugin resource with name global-request-id.
+func CreateKongClusterPluginGlobalRequestId(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if !parent.Spec.Kong.Plugins.RequestID.Enabled {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "configuration.konghq.com/v1",
+			"kind":       "KongClusterPlugin",
+			"metadata": map[string]interface{}{
+				"name": "global-request-id",
+				"annotations": map[string]interface{}{
+					"kubernetes.io/ingress.class": "kong",
+				},
+				"labels": map[string]interface{}{
+					// applies the plugin to all services proxied by kong
+					"global":                       "true",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "kong-ingress-controller",
+				},
+			},
+			"plugin": "correlation-id",
+			"config": map[string]interface{}{
+				"header_name":     parent.Spec.Kong.Plugins.RequestID.HeaderName,     //  controlled by field: kong.plugins.requestID.headerName
+				"generator":       parent.Spec.Kong.Plugins.RequestID.Generator,      //  controlled by field: kong.plugins.requestID.generator
+				"echo_downstream": parent.Spec.Kong.Plugins.RequestID.EchoDownstream, //  controlled by field: kong.plugins.requestID.echoDownstream
+			},
+		},
+	}
+
+	return mutate.MutateKongClusterPluginGlobalRequestId(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// setAutoscaling sets the minimum replicas and the metrics of a generated horizontal pod
+// autoscaler.  The autoscaler is named after the deployment which it scales, whose replicas
+// supply the default minimum.
+func setAutoscaling(
+	original client.Object,
+	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
+	autoscaling platformv1alpha1.IngressComponentSpecAutoscaling,
+) error {
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	minReplicas := parent.EffectiveReplicas(profile.TierProfileSpec)[original.GetName()]
+	if autoscaling.MaxReplicas < minReplicas {
+		return fmt.Errorf(
+			"maxReplicas (%d) of %s must be at least its minimum number of replicas (%d)",
+			autoscaling.MaxReplicas, original.GetName(), minReplicas,
+		)
+	}
+
+	autoscaler, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, ok := autoscaler.Object["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing spec for horizontal pod autoscaler %s", original.GetName())
+	}
+
+	spec["minReplicas"] = minReplicas
+
+	metrics := []interface{}{}
+
+	for _, target := range []struct {
+		resource    string
+		utilization int
+	}{
+		{resource: "cpu", utilization: autoscaling.TargetCPUUtilizationPercentage},
+		{resource: "memory", utilization: autoscaling.TargetMemoryUtilizationPercentage},
+	} {
+		if target.utilization == 0 {
+			continue
+		}
+
+		metrics = append(metrics, map[string]interface{}{
+			"type": "Resource",
+			"resource": map[string]interface{}{
+				"name": target.resource,
+				"target": map[string]interface{}{
+					"type":               "Utilization",
+					"averageUtilization": target.utilization,
+				},
+			},
+		})
+	}
+
+	// the autoscaler targets 80% cpu utilization when no metrics are set
+	if len(metrics) > 0 {
+		spec["metrics"] = metrics
+	}
+
+	return nil
+}
@@ -31,6 +31,11 @@ func MutateCertNamespaceNginxDefaultServerSecretProd(
 	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// cover all domains, requesting a wildcard certificate from the dns01 issuer if requested.
+	if err := setDefaultCertificate(original, parent, "letsencrypt-dns01-production"); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
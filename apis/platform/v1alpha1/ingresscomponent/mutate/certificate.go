@@ -0,0 +1,144 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+var (
+	ErrUnsupportedDNS01Provider = errors.New("wildcard certificates require the route53 or google external-dns provider")
+	ErrMissingGoogleProject     = errors.New("certificate.google.project must be set for wildcard certificates with the google provider")
+)
+
+// setDefaultCertificate sets the domains of the default-server certificate, which covers all
+// domains served by the cluster.  A wildcard certificate is requested from the named dns01 issuer,
+// if requested, which also covers all subdomains of the domains.
+func setDefaultCertificate(original client.Object, parent *platformv1alpha1.IngressComponent, dns01Issuer string) error {
+	spec, err := certManagerSpec(original)
+	if err != nil {
+		return err
+	}
+
+	wildcard := parent.Spec.Certificate.Wildcard
+
+	dnsNames := []interface{}{}
+
+	for _, name := range parent.Spec.DomainNames() {
+		dnsNames = append(dnsNames, name)
+
+		if wildcard {
+			dnsNames = append(dnsNames, "*."+name)
+		}
+	}
+
+	spec["dnsNames"] = dnsNames
+
+	if wildcard {
+		spec["issuerRef"] = map[string]interface{}{
+			"name": dns01Issuer,
+			"kind": "Issuer",
+		}
+	}
+
+	return nil
+}
+
+// setDNS01Solver sets the dns01 solver of a generated issuer, which uses the credentials of the
+// external-dns provider.
+func setDNS01Solver(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	spec, err := certManagerSpec(original)
+	if err != nil {
+		return err
+	}
+
+	acme, ok := spec["acme"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing acme settings for issuer %s", original.GetName())
+	}
+
+	certificate := parent.Spec.Certificate
+
+	var dns01 map[string]interface{}
+
+	switch parent.Spec.ExternalDNS.Provider {
+	case "route53":
+		route53 := map[string]interface{}{
+			"region": certificate.Route53.Region,
+			"accessKeyIDSecretRef": map[string]interface{}{
+				"name": "external-dns-route53",
+				"key":  "AWS_ACCESS_KEY_ID",
+			},
+			"secretAccessKeySecretRef": map[string]interface{}{
+				"name": "external-dns-route53",
+				"key":  "AWS_SECRET_ACCESS_KEY",
+			},
+		}
+
+		if certificate.Route53.HostedZoneID != "" {
+			route53["hostedZoneID"] = certificate.Route53.HostedZoneID
+		}
+
+		dns01 = map[string]interface{}{"route53": route53}
+	case "google":
+		if certificate.Google.Project == "" {
+			return ErrMissingGoogleProject
+		}
+
+		cloudDNS := map[string]interface{}{
+			"project": certificate.Google.Project,
+		}
+
+		if certificate.Google.ServiceAccountSecretName != "" {
+			cloudDNS["serviceAccountSecretRef"] = map[string]interface{}{
+				"name": certificate.Google.ServiceAccountSecretName,
+				"key":  "key.json",
+			}
+		}
+
+		dns01 = map[string]interface{}{"cloudDNS": cloudDNS}
+	default:
+		return fmt.Errorf("%w, found %q", ErrUnsupportedDNS01Provider, parent.Spec.ExternalDNS.Provider)
+	}
+
+	acme["solvers"] = []interface{}{
+		map[string]interface{}{"dns01": dns01},
+	}
+
+	return nil
+}
+
+// certManagerSpec returns the spec of a generated cert-manager resource.
+func certManagerSpec(original client.Object) (map[string]interface{}, error) {
+	resource, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, ok := resource.Object["spec"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("missing spec for %s %s", resource.GetKind(), original.GetName())
+	}
+
+	return spec, nil
+}
@@ -31,11 +31,21 @@ func MutateConfigMapNamespaceNginxConfig(
 	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// render the nginx settings of the component into the config map.
+	if err := setNginxConfig(original, parent); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// remove the settings which are no longer set when the config map is updated.
+	if err := pruneNginxConfig(original, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
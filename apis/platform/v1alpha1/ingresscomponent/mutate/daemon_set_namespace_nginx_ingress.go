@@ -23,6 +23,7 @@ import (
 
 	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
 )
 
 // MutateDaemonSetNamespaceNginxIngress mutates the DaemonSet resource with name nginx-ingress.
@@ -31,11 +32,48 @@ func MutateDaemonSetNamespaceNginxIngress(
 	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "nginx-ingress", parent.Spec.Nginx.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, parent.Spec.Nginx.Scheduling); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "nginx-ingress", parent.Spec.Nginx.Resources); err != nil {
+		return nil, err
+	}
+
+	// roll out the controller pods when the nginx settings change.
+	if err := podtemplate.SetConfigHash(original, nginxConfigData(parent.Spec.Nginx.Config)); err != nil {
+		return nil, err
+	}
+
+	// unlike the deployment, the daemonset only exports metrics when monitoring is enabled.
+	if parent.Spec.Monitoring.Enabled {
+		if err := podtemplate.SetArg(original, "nginx-ingress", "-enable-prometheus-metrics", "true"); err != nil {
+			return nil, err
+		}
+	}
+
+	// inject the linkerd proxy into the controller pods, if the controller joins a linkerd mesh.
+	if err := setMeshInjection(original, parent, nginxMeshInjection); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
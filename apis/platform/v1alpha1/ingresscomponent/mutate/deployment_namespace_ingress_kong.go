@@ -23,6 +23,7 @@ import (
 
 	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
 )
 
 // MutateDeploymentNamespaceIngressKong mutates the Deployment resource with name ingress-kong.
@@ -31,11 +32,49 @@ func MutateDeploymentNamespaceIngressKong(
 	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// pin the images to their digests, if specified.
+	if err := podtemplate.SetImageDigest(original, "proxy", parent.Spec.Kong.Gateway.Digest); err != nil {
+		return nil, err
+	}
+
+	if err := podtemplate.SetImageDigest(original, "ingress-controller", parent.Spec.Kong.IngressController.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, parent.Spec.Kong.Scheduling); err != nil {
+		return nil, err
+	}
+
+	// set the log level, listeners, license, default certificate and feature gates of kong.
+	if err := setKongConfig(original, parent); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the containers, if specified.
+	if err := podtemplate.SetResources(original, "proxy", parent.Spec.Kong.Gateway.Resources); err != nil {
+		return nil, err
+	}
+
+	if err := podtemplate.SetResources(original, "ingress-controller", parent.Spec.Kong.IngressController.Resources); err != nil {
+		return nil, err
+	}
+
+	// inject the linkerd proxy into the kong pods, if kong joins a linkerd mesh.
+	if err := setMeshInjection(original, parent, kongMeshInjection); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
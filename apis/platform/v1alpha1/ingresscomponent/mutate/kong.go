@@ -0,0 +1,213 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+const kongDefaultCertificatePath = "/etc/secrets/default-server"
+
+var ErrMissingRateLimit = errors.New("at least one of second, minute, hour or day must be set when rate limiting is enabled")
+
+// setKongConfig sets the log level, listeners, license, default certificate and feature gates of
+// the kong ingress deployment.
+func setKongConfig(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	kong := parent.Spec.Kong
+
+	// the gateway controller of the ingress controller is only enabled when kong implements the
+	// default gateway.
+	if gatewayAPI := parent.Spec.GatewayAPI; gatewayAPI.Enabled && gatewayAPI.Controller == "kong" {
+		if err := podtemplate.SetEnv(original, "ingress-controller", "CONTROLLER_FEATURE_GATES", "Gateway=true"); err != nil {
+			return err
+		}
+	}
+
+	// the log level of kong takes precedence over that of the tier.
+	if kong.LogLevel != "" {
+		if err := podtemplate.SetEnv(original, "proxy", "KONG_LOG_LEVEL", kong.LogLevel); err != nil {
+			return err
+		}
+
+		if err := podtemplate.SetEnv(original, "ingress-controller", "CONTROLLER_LOG_LEVEL", kong.LogLevel); err != nil {
+			return err
+		}
+	}
+
+	if err := podtemplate.SetEnv(original, "proxy", "KONG_PROXY_LISTEN", kongProxyListen(kong.Listeners.Proxy)); err != nil {
+		return err
+	}
+
+	// the ingress controller configures kong through the admin listener.
+	if port := kong.Listeners.Admin.Port; port != 0 {
+		if err := podtemplate.SetEnv(original, "proxy", "KONG_ADMIN_LISTEN", fmt.Sprintf("127.0.0.1:%d ssl", port)); err != nil {
+			return err
+		}
+
+		if err := podtemplate.SetEnv(original, "ingress-controller", "CONTROLLER_KONG_ADMIN_URL", fmt.Sprintf("https://127.0.0.1:%d", port)); err != nil {
+			return err
+		}
+	}
+
+	// the wildcard certificate of the domain name is the default certificate of the proxy.
+	if parent.Spec.Certificate.Wildcard {
+		if err := setKongDefaultCertificate(original); err != nil {
+			return err
+		}
+	}
+
+	// a license secret which is set explicitly is required to exist.
+	if license := kong.License; license.SecretName != "" {
+		key := license.Key
+		if key == "" {
+			key = "license"
+		}
+
+		return podtemplate.SetEnvFromSecret(original, "proxy", "KONG_LICENSE_DATA", license.SecretName, key, false)
+	}
+
+	return nil
+}
+
+// setKongDefaultCertificate mounts the default-server secret into the kong gateway and uses it
+// as the certificate of requests which do not match any other certificate.
+func setKongDefaultCertificate(original client.Object) error {
+	if err := podtemplate.MountSecret(original, "proxy", "default-server-secret", "default-server-secret", kongDefaultCertificatePath); err != nil {
+		return err
+	}
+
+	if err := podtemplate.SetEnv(original, "proxy", "KONG_SSL_CERT", kongDefaultCertificatePath+"/tls.crt"); err != nil {
+		return err
+	}
+
+	return podtemplate.SetEnv(original, "proxy", "KONG_SSL_CERT_KEY", kongDefaultCertificatePath+"/tls.key")
+}
+
+// kongProxyListen returns the value of KONG_PROXY_LISTEN for the proxy listener settings.
+func kongProxyListen(listener platformv1alpha1.IngressComponentSpecKongProxyListener) string {
+	var flags []string
+
+	if listener.ProxyProtocol {
+		flags = append(flags, "proxy_protocol")
+	}
+
+	if listener.Backlog != 0 {
+		flags = append(flags, "backlog="+strconv.Itoa(listener.Backlog))
+	}
+
+	httpListen := strings.Join(append([]string{"0.0.0.0:8000"}, flags...), " ")
+
+	httpsFlags := []string{"0.0.0.0:8443", "ssl"}
+	if listener.HTTP2 == nil || *listener.HTTP2 {
+		httpsFlags = append(httpsFlags, "http2")
+	}
+
+	return httpListen + ", " + strings.Join(append(httpsFlags, flags...), " ")
+}
+
+// pluginConfig returns the config of a generated kong cluster plugin.
+func pluginConfig(original client.Object) (map[string]interface{}, error) {
+	plugin, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	config, ok := plugin.Object["config"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("missing config for kong cluster plugin %s", original.GetName())
+	}
+
+	return config, nil
+}
+
+// setRateLimitingConfig sets the limits of the generated rate-limiting plugin.
+func setRateLimitingConfig(original client.Object, rateLimiting platformv1alpha1.IngressComponentSpecKongRateLimiting) error {
+	config, err := pluginConfig(original)
+	if err != nil {
+		return err
+	}
+
+	limits := 0
+
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{
+		{name: "second", value: rateLimiting.Second},
+		{name: "minute", value: rateLimiting.Minute},
+		{name: "hour", value: rateLimiting.Hour},
+		{name: "day", value: rateLimiting.Day},
+	} {
+		if limit.value == 0 {
+			continue
+		}
+
+		config[limit.name] = limit.value
+		limits++
+	}
+
+	if limits == 0 {
+		return ErrMissingRateLimit
+	}
+
+	return nil
+}
+
+// setCORSConfig sets the optional settings of the generated cors plugin.  Settings which are not
+// set are left to the defaults of the plugin.
+func setCORSConfig(original client.Object, cors platformv1alpha1.IngressComponentSpecKongCORS) error {
+	config, err := pluginConfig(original)
+	if err != nil {
+		return err
+	}
+
+	for _, list := range []struct {
+		name   string
+		values []string
+	}{
+		{name: "origins", values: cors.Origins},
+		{name: "methods", values: cors.Methods},
+		{name: "headers", values: cors.Headers},
+		{name: "exposed_headers", values: cors.ExposedHeaders},
+	} {
+		if len(list.values) == 0 {
+			continue
+		}
+
+		values := make([]interface{}, len(list.values))
+		for i := range list.values {
+			values[i] = list.values[i]
+		}
+
+		config[list.name] = values
+	}
+
+	if cors.MaxAge != 0 {
+		config["max_age"] = cors.MaxAge
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// meshInjection is the linkerd proxy injection mode and the ports of the listeners of an ingress
+// controller, whose inbound traffic bypasses the proxy so that the client addresses are kept.
+type meshInjection struct {
+	mode         string
+	inboundPorts string
+}
+
+var (
+	nginxMeshInjection = meshInjection{mode: "enabled", inboundPorts: "80,443"}
+
+	// kong runs the proxy in ingress mode, which routes the requests of kong by their destination
+	// service rather than the endpoint which kong picked.
+	kongMeshInjection = meshInjection{mode: "ingress", inboundPorts: "8000,8443"}
+)
+
+// setMeshInjection annotates the pods of an ingress controller for proxy injection, if the
+// ingress controllers join a linkerd mesh.  Joining an istio mesh is handled by the namespace.
+func setMeshInjection(original client.Object, parent *platformv1alpha1.IngressComponent, injection meshInjection) error {
+	if parent.Spec.ServiceMesh.Type != "linkerd" {
+		return nil
+	}
+
+	metadata, err := podtemplate.Metadata(original)
+	if err != nil {
+		return err
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+
+	annotations["linkerd.io/inject"] = injection.mode
+	annotations["config.linkerd.io/skip-inbound-ports"] = injection.inboundPorts
+
+	return nil
+}
+
+// setNamespaceMeshLabels adds the namespace of the component to the ambient mesh, if the ingress
+// controllers join an istio mesh.
+func setNamespaceMeshLabels(original client.Object, parent *platformv1alpha1.IngressComponent) {
+	if parent.Spec.ServiceMesh.Type != "istio" {
+		return
+	}
+
+	labels := original.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels["istio.io/dataplane-mode"] = "ambient"
+
+	original.SetLabels(labels)
+}
@@ -31,6 +31,9 @@ func MutateNamespaceNamespace(
 	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// add the namespace to the ambient mesh, if the controllers join an istio mesh.
+	setNamespaceMeshLabels(original, parent)
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
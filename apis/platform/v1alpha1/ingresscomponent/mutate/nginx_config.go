@@ -0,0 +1,183 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// nginxConfigKeys are the keys of the nginx-config config map which are managed from the nginx
+// settings of the component.
+var nginxConfigKeys = []string{
+	"proxy-connect-timeout",
+	"proxy-read-timeout",
+	"proxy-send-timeout",
+	"client-max-body-size",
+	"hsts",
+	"hsts-max-age",
+	"hsts-include-subdomains",
+	"hsts-behind-proxy",
+	"real-ip-header",
+	"set-real-ip-from",
+	"real-ip-recursive",
+	"proxy-protocol",
+	"log-format",
+	"log-format-escaping",
+	"ssl-protocols",
+	"ssl-ciphers",
+	"ssl-prefer-server-ciphers",
+	"worker-processes",
+	"worker-connections",
+	"worker-rlimit-nofile",
+}
+
+// nginxConfigData returns the data of the nginx-config config map for the nginx settings of the
+// component.  Only settings which are set are included, so that the controller defaults apply to
+// all others.
+func nginxConfigData(config platformv1alpha1.IngressComponentSpecNginxConfig) map[string]string {
+	data := map[string]string{}
+
+	setString := func(key, value string) {
+		if value != "" {
+			data[key] = value
+		}
+	}
+
+	setInt := func(key string, value int) {
+		if value > 0 {
+			data[key] = strconv.Itoa(value)
+		}
+	}
+
+	setBool := func(key string, value bool) {
+		if value {
+			data[key] = "true"
+		}
+	}
+
+	setString("proxy-connect-timeout", config.ProxyConnectTimeout)
+	setString("proxy-read-timeout", config.ProxyReadTimeout)
+	setString("proxy-send-timeout", config.ProxySendTimeout)
+	setString("client-max-body-size", config.ClientMaxBodySize)
+
+	if config.HSTS.Enabled {
+		data["hsts"] = "true"
+
+		setInt("hsts-max-age", config.HSTS.MaxAge)
+		setBool("hsts-include-subdomains", config.HSTS.IncludeSubdomains)
+		setBool("hsts-behind-proxy", config.HSTS.BehindProxy)
+	}
+
+	setString("real-ip-header", config.RealIP.Header)
+	setString("set-real-ip-from", strings.Join(config.RealIP.TrustedAddresses, ","))
+	setBool("real-ip-recursive", config.RealIP.Recursive)
+	setBool("proxy-protocol", config.ProxyProtocol)
+
+	setString("log-format", config.LogFormat)
+	setString("log-format-escaping", config.LogFormatEscaping)
+
+	protocols := make([]string, len(config.SSLProtocols))
+	for i := range config.SSLProtocols {
+		protocols[i] = string(config.SSLProtocols[i])
+	}
+
+	setString("ssl-protocols", strings.Join(protocols, " "))
+	setString("ssl-ciphers", config.SSLCiphers)
+	setBool("ssl-prefer-server-ciphers", config.SSLPreferServerCiphers)
+
+	setString("worker-processes", config.WorkerProcesses)
+	setInt("worker-connections", config.WorkerConnections)
+	setInt("worker-rlimit-nofile", config.WorkerRlimitNofile)
+
+	return data
+}
+
+// setNginxConfig merges the nginx settings of the component into the nginx-config config map.
+func setNginxConfig(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, ok := configMap.Object["data"].(map[string]interface{})
+	if !ok {
+		data = map[string]interface{}{}
+	}
+
+	for key, value := range nginxConfigData(parent.Spec.Nginx.Config) {
+		data[key] = value
+	}
+
+	if len(data) > 0 {
+		configMap.Object["data"] = data
+	}
+
+	return nil
+}
+
+// pruneNginxConfig sets the managed keys of the existing nginx-config config map which are no
+// longer set to null in the desired config map.  Updates are merged into the existing config map,
+// so keys which are omitted from the desired config map would otherwise be kept.  Only keys of
+// the existing config map are set to null, as null values would be created as empty values.
+func pruneNginxConfig(original client.Object, reconciler workload.Reconciler, req *workload.Request) error {
+	existing := &corev1.ConfigMap{}
+
+	if err := reconciler.Get(req.Context, client.ObjectKeyFromObject(original), existing); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to get config map %s, %w", original.GetName(), err)
+	}
+
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	desired, ok := configMap.Object["data"].(map[string]interface{})
+	if !ok {
+		desired = map[string]interface{}{}
+	}
+
+	for _, key := range nginxConfigKeys {
+		if _, found := existing.Data[key]; !found {
+			continue
+		}
+
+		if _, found := desired[key]; !found {
+			desired[key] = nil
+		}
+	}
+
+	if len(desired) > 0 {
+		configMap.Object["data"] = desired
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// MutateNginxIngressInstance mutates a copy of the nginx ingress workload into the workload of an
+// additional nginx instance.
+func MutateNginxIngressInstance(
+	original client.Object,
+	instance platformv1alpha1.IngressComponentSpecNginxInstance,
+	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	name := instance.WorkloadName()
+
+	original.SetName(name)
+
+	// the app label selects the pods of the instance, while the other labels are shared with the
+	// nginx instance so that all nginx pods are spread across nodes.
+	if err := setAppLabel(original, name); err != nil {
+		return nil, err
+	}
+
+	for _, arg := range []struct {
+		flag  string
+		value string
+	}{
+		{flag: "-ingress-class", value: instance.IngressClass},
+		{flag: "-leader-election-lock-name", value: instance.LeaderElectionLockName()},
+		{flag: "-external-service", value: name},
+	} {
+		if err := podtemplate.SetArg(original, "nginx-ingress", arg.flag, arg.value); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setReplicas(original, parent, profile.TierProfileSpec); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
+
+// setAppLabel sets the app label of a generated workload, its selector and its pod template.
+func setAppLabel(original client.Object, app string) error {
+	labels := original.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels["app"] = app
+	original.SetLabels(labels)
+
+	workload, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	if err := unstructured.SetNestedField(workload.Object, app, "spec", "selector", "matchLabels", "app"); err != nil {
+		return fmt.Errorf("unable to set selector of %s, %w", original.GetName(), err)
+	}
+
+	metadata, err := podtemplate.Metadata(original)
+	if err != nil {
+		return err
+	}
+
+	templateLabels, _ := metadata["labels"].(map[string]interface{})
+	if templateLabels == nil {
+		templateLabels = map[string]interface{}{}
+	}
+
+	templateLabels["app"] = app
+	metadata["labels"] = templateLabels
+
+	return nil
+}
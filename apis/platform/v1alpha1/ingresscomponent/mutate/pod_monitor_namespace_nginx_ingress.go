@@ -0,0 +1,96 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/monitoring"
+)
+
+// MutatePodMonitorNamespaceNginxIngress mutates the PodMonitor resource with name nginx-ingress.
+func MutatePodMonitorNamespaceNginxIngress(
+	original client.Object,
+	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	// also scrape the pods of the additional nginx instances, which are selected by their own app
+	// label.
+	if err := setInstanceSelector(original, parent); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// the resource may only be created once the prometheus-operator custom resource definitions
+	// are installed.
+	served, err := monitoring.Served(reconciler, original)
+	if err != nil {
+		return nil, err
+	}
+
+	if !served {
+		return []client.Object{}, nil
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
+
+// setInstanceSelector sets the values of the app label selector of the nginx pod monitor to the
+// app labels of the nginx instance and of all additional nginx instances.
+func setInstanceSelector(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	podMonitor, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to set selector of %s, unexpected type %T", original.GetName(), original)
+	}
+
+	expressions, _, err := unstructured.NestedFieldNoCopy(podMonitor.Object, "spec", "selector", "matchExpressions")
+	if err != nil {
+		return err
+	}
+
+	list, _ := expressions.([]interface{})
+	if len(list) == 0 {
+		return fmt.Errorf("unable to set selector of %s, missing match expressions", original.GetName())
+	}
+
+	expression, ok := list[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unable to set selector of %s, invalid match expression", original.GetName())
+	}
+
+	values := []interface{}{"nginx-ingress"}
+	for _, instance := range parent.Spec.Nginx.Instances {
+		values = append(values, instance.WorkloadName())
+	}
+
+	expression["values"] = values
+
+	return nil
+}
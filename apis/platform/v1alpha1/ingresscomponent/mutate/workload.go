@@ -0,0 +1,143 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// mutateWorkload applies the settings which are common to all workloads of the component.  These
+// settings are derived solely from the parent and collection specs, so they are applied regardless
+// of whether we are reconciling or generating manifests from the CLI.  The workload is sized,
+// replicated and configured for the tier of the collection and the scheduling settings of the
+// individual workload take precedence over those of the collection.  External-dns is limited to
+// the domains and ingress classes of the component.
+func mutateWorkload(
+	original client.Object,
+	parent *platformv1alpha1.IngressComponent, collection *setupv1alpha1.SupportServices,
+	scheduling setupv1alpha1.SchedulingSpec,
+) error {
+	if err := podtemplate.SetImageRegistry(original, collection); err != nil {
+		return err
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	if err := podtemplate.ScaleResources(original, profile.ResourcePercent); err != nil {
+		return err
+	}
+
+	if err := setReplicas(original, parent, profile.TierProfileSpec); err != nil {
+		return err
+	}
+
+	if err := setLogLevel(original, profile.LogLevel); err != nil {
+		return err
+	}
+
+	if err := setDomainFilters(original, parent); err != nil {
+		return err
+	}
+
+	if err := setIngressClasses(original, parent); err != nil {
+		return err
+	}
+
+	return podtemplate.SetScheduling(original, collection.Spec.Scheduling.Override(scheduling))
+}
+
+// setReplicas sets the replicas of an ingress workload.  The replicas of autoscaled workloads are
+// left to the autoscaler so that the operator does not fight it.
+func setReplicas(original client.Object, parent *platformv1alpha1.IngressComponent, profile setupv1alpha1.TierProfileSpec) error {
+	if parent.Autoscales(original.GetName()) {
+		return podtemplate.UnsetReplicas(original)
+	}
+
+	replicas, ok := parent.EffectiveReplicas(profile)[original.GetName()]
+	if !ok {
+		return nil
+	}
+
+	return podtemplate.SetReplicas(original, replicas)
+}
+
+// setLogLevel sets the log level of an ingress workload.
+func setLogLevel(original client.Object, level string) error {
+	switch name := original.GetName(); {
+	case name == "nginx-ingress":
+		return podtemplate.SetArg(original, "nginx-ingress", "-v", strconv.Itoa(tier.Verbosity(level)))
+	case name == "ingress-kong":
+		if err := podtemplate.SetEnv(original, "proxy", "KONG_LOG_LEVEL", level); err != nil {
+			return err
+		}
+
+		return podtemplate.SetEnv(original, "ingress-controller", "CONTROLLER_LOG_LEVEL", level)
+	case strings.HasPrefix(name, "external-dns"):
+		return podtemplate.SetArg(original, "external-dns", "--log-level", level)
+	}
+
+	return nil
+}
+
+// setDomainFilters limits external-dns to the domains served by the cluster.  The domain filters
+// of the flags take precedence over the domain filter of the external-dns secret.
+func setDomainFilters(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	if !strings.HasPrefix(original.GetName(), "external-dns") {
+		return nil
+	}
+
+	return podtemplate.SetArgs(original, "external-dns", "--domain-filter", parent.Spec.DomainFilters())
+}
+
+// setIngressClasses limits external-dns to the ingresses of the ingress classes of the component,
+// when an instance opts out of external-dns.
+func setIngressClasses(original client.Object, parent *platformv1alpha1.IngressComponent) error {
+	if !strings.HasPrefix(original.GetName(), "external-dns") {
+		return nil
+	}
+
+	return podtemplate.SetArgs(original, "external-dns", "--ingress-class", parent.IngressClasses())
+}
+
+// reconcileWorkload applies the settings which are common to all workloads of the component and
+// which require access to the cluster or to image registries, so they are only applied when
+// reconciling.
+func reconcileWorkload(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	if err := podtemplate.PruneNodeSelector(req.Context, reconciler, original); err != nil {
+		return err
+	}
+
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
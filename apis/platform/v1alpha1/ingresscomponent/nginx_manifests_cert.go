@@ -25,6 +25,7 @@ import (
 	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
 	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
 )
 
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
@@ -36,12 +37,18 @@ func CreateCertNamespaceNginxDefaultServerSecretNonProd(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
-	if collection.Spec.Tier == "production" {
+	// only the issuer of the tier of the collection is used
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Issuer != tier.IssuerStaging {
 		return []client.Object{}, nil
 	}
+
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
-			// +operator-builder:resource:collectionField=tier,value="production",include=false
 			"apiVersion": "cert-manager.io/v1",
 			"kind":       "Certificate",
 			"metadata": map[string]interface{}{
@@ -73,12 +80,18 @@ func CreateCertNamespaceNginxDefaultServerSecretProd(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
-	if collection.Spec.Tier != "production" {
+	// only the issuer of the tier of the collection is used
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Issuer != tier.IssuerProduction {
 		return []client.Object{}, nil
 	}
+
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
-			// +operator-builder:resource:collectionField=tier,value="production",include
 			"apiVersion": "cert-manager.io/v1",
 			"kind":       "Certificate",
 			"metadata": map[string]interface{}{
@@ -162,6 +162,7 @@ func CreateDeploymentNamespaceNginxIngress(
 									"-report-ingress-status",
 									"-external-service=nginx-ingress",
 									"-enable-prometheus-metrics",
+									"-enable-latency-metrics",
 								},
 							},
 						},
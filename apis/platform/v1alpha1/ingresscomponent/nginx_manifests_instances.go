@@ -0,0 +1,209 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateWorkloadNamespaceNginxIngressInstances creates the workloads of the additional nginx
+// instances.  The workload of each instance is a copy of the workload of the nginx instance, so
+// that the instances share its settings.
+func CreateWorkloadNamespaceNginxIngressInstances(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if len(parent.Spec.Nginx.Instances) == 0 {
+		return []client.Object{}, nil
+	}
+
+	primaries := []client.Object{}
+
+	for _, create := range []func(
+		*platformv1alpha1.IngressComponent,
+		*setupv1alpha1.SupportServices,
+		workload.Reconciler,
+		*workload.Request,
+	) ([]client.Object, error){
+		CreateDeploymentNamespaceNginxIngress,
+		CreateDaemonSetNamespaceNginxIngress,
+	} {
+		objects, err := create(parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		primaries = append(primaries, objects...)
+	}
+
+	resourceObjs := []client.Object{}
+
+	for _, primary := range primaries {
+		for _, instance := range parent.Spec.Nginx.Instances {
+			resourceObj, err := copyObject(primary)
+			if err != nil {
+				return nil, err
+			}
+
+			mutated, err := mutate.MutateNginxIngressInstance(resourceObj, instance, parent, collection, reconciler, req)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceObjs = append(resourceObjs, mutated...)
+		}
+	}
+
+	return resourceObjs, nil
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceNginxIngressInstances creates the load balancer services of the
+// additional nginx instances.
+func CreateServiceNamespaceNginxIngressInstances(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	resourceObjs := []client.Object{}
+
+	for _, instance := range parent.Spec.Nginx.Instances {
+		annotations := map[string]interface{}{}
+		for key, value := range instance.ServiceAnnotations {
+			annotations[key] = value
+		}
+
+		var resourceObj = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name":        instance.WorkloadName(),
+					"namespace":   parent.Spec.Namespace, //  controlled by field: namespace
+					"annotations": annotations,           //  controlled by field: nginx.instances.serviceAnnotations
+					"labels": map[string]interface{}{
+						"platform.nukleros.io/group":   "ingress",
+						"platform.nukleros.io/project": "nginx-ingress-controller",
+					},
+				},
+				"spec": map[string]interface{}{
+					"externalTrafficPolicy": "Local",
+					"type":                  "LoadBalancer",
+					"ports": []interface{}{
+						map[string]interface{}{
+							"port":       80,
+							"targetPort": 80,
+							"protocol":   "TCP",
+							"name":       "http",
+						},
+						map[string]interface{}{
+							"port":       443,
+							"targetPort": 443,
+							"protocol":   "TCP",
+							"name":       "https",
+						},
+					},
+					"selector": map[string]interface{}{
+						"app": instance.WorkloadName(),
+					},
+				},
+			},
+		}
+
+		mutated, err := mutate.MutateServiceNamespaceNginxIngressInstance(resourceObj, parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, mutated...)
+	}
+
+	return resourceObjs, nil
+}
+
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIngressClassNginxInstances creates the ingress classes of the additional nginx instances.
+func CreateIngressClassNginxInstances(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	resourceObjs := []client.Object{}
+
+	for _, instance := range parent.Spec.Nginx.Instances {
+		var resourceObj = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "networking.k8s.io/v1",
+				"kind":       "IngressClass",
+				"metadata": map[string]interface{}{
+					"name": instance.IngressClass, //  controlled by field: nginx.instances.ingressClass
+					"labels": map[string]interface{}{
+						"platform.nukleros.io/group":   "ingress",
+						"platform.nukleros.io/project": "nginx-ingress-controller",
+					},
+				},
+				"spec": map[string]interface{}{
+					"controller": "nginx.org/ingress-controller",
+				},
+			},
+		}
+
+		mutated, err := mutate.MutateIngressClassNginxInstance(resourceObj, parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, mutated...)
+	}
+
+	return resourceObjs, nil
+}
+
+// copyObject returns a deep copy of a generated object.  Generated objects contain values which
+// are not valid json values, e.g. int, so they are copied through their json representation.
+func copyObject(object client.Object) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy object %s, %w", object.GetName(), err)
+	}
+
+	resourceObj := &unstructured.Unstructured{}
+	if err := resourceObj.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("unable to copy object %s, %w", object.GetName(), err)
+	}
+
+	return resourceObj, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodMonitorNamespaceNginxIngress creates the PodMonitor resource with name nginx-ingress.
+func CreatePodMonitorNamespaceNginxIngress(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PodMonitor",
+			"metadata": map[string]interface{}{
+				"name":      "nginx-ingress",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "nginx-ingress",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "nginx-ingress-controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchExpressions": []interface{}{
+						map[string]interface{}{
+							"key":      "app",
+							"operator": "In",
+							"values": []interface{}{
+								"nginx-ingress",
+							},
+						},
+					},
+				},
+				"podMetricsEndpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "prometheus",
+						"path":     "/metrics",
+						"interval": "30s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodMonitorNamespaceNginxIngress(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespaceNginxIngress creates the PrometheusRule resource with name nginx-ingress.
+func CreatePrometheusRuleNamespaceNginxIngress(
+	parent *platformv1alpha1.IngressComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "nginx-ingress",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "nginx-ingress",
+					"platform.nukleros.io/group":   "ingress",
+					"platform.nukleros.io/project": "nginx-ingress-controller",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "nginx-ingress",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "NginxIngressHighErrorRate",
+								"expr":  "sum by (namespace, pod) (rate(nginx_ingress_controller_upstream_server_response_latency_ms_count{code=~\"5..\"}[5m])) / sum by (namespace, pod) (rate(nginx_ingress_controller_upstream_server_response_latency_ms_count[5m])) > 0.05",
+								"for":   "10m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Nginx is returning a high rate of 5xx responses.",
+									"description": "More than 5% of the upstream responses served by {{ $labels.pod }} have been 5xx responses for 10 minutes.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "NginxIngressReloadFailed",
+								"expr":  "nginx_ingress_controller_nginx_last_reload_status == 0",
+								"for":   "5m",
+								"labels": map[string]interface{}{
+									"severity": "critical",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "Nginx failed to reload its configuration.",
+									"description": "The last configuration reload of {{ $labels.pod }} failed, so changes to ingress resources are not applied.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespaceNginxIngress(resourceObj, parent, collection, reconciler, req)
+}
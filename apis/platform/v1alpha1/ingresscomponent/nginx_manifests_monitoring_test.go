@@ -0,0 +1,90 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresscomponent_test
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/ingresscomponent"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// latencyMetric is the metric of the open source nginx ingress controller which counts the
+// responses of the upstream servers by status code.
+const latencyMetric = "nginx_ingress_controller_upstream_server_response_latency_ms_count"
+
+func TestNginxHighErrorRateAlert(t *testing.T) {
+	t.Parallel()
+
+	parent := &platformv1alpha1.IngressComponent{}
+	parent.Spec.Namespace = "nukleros-ingress-system"
+	parent.Spec.Monitoring.Enabled = true
+	parent.Spec.Monitoring.Alerts = true
+	parent.Spec.Nginx.InstallType = "deployment"
+
+	collection := &setupv1alpha1.SupportServices{}
+	collection.Spec.Tier = "development"
+
+	rules, err := ingresscomponent.CreatePrometheusRuleNamespaceNginxIngress(parent, collection, nil, nil)
+	if err != nil || len(rules) != 1 {
+		t.Fatalf("CreatePrometheusRuleNamespaceNginxIngress() = %v, %v, want a prometheus rule", rules, err)
+	}
+
+	groups, _, _ := unstructured.NestedSlice(rules[0].(*unstructured.Unstructured).Object, "spec", "groups")
+
+	var expr string
+
+	for _, group := range groups {
+		groupRules, _, _ := unstructured.NestedSlice(group.(map[string]interface{}), "rules")
+
+		for _, rule := range groupRules {
+			if rule.(map[string]interface{})["alert"] == "NginxIngressHighErrorRate" {
+				expr, _ = rule.(map[string]interface{})["expr"].(string)
+			}
+		}
+	}
+
+	if !strings.Contains(expr, latencyMetric+`{code=~"5.."}`) {
+		t.Errorf("NginxIngressHighErrorRate expr = %q, want the 5xx ratio of %s", expr, latencyMetric)
+	}
+
+	// the metric is only exported when latency metrics are enabled on the controller
+	deployments, err := ingresscomponent.CreateDeploymentNamespaceNginxIngress(parent, collection, nil, nil)
+	if err != nil || len(deployments) != 1 {
+		t.Fatalf("CreateDeploymentNamespaceNginxIngress() = %v, %v, want a deployment", deployments, err)
+	}
+
+	container, err := podtemplate.Container(deployments[0], "nginx-ingress")
+	if err != nil {
+		t.Fatalf("unable to get nginx-ingress container, %v", err)
+	}
+
+	args, _ := container["args"].([]interface{})
+
+	for _, arg := range args {
+		if arg == "-enable-latency-metrics" {
+			return
+		}
+	}
+
+	t.Errorf("nginx-ingress args = %v, want -enable-latency-metrics", args)
+}
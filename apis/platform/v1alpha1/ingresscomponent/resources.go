@@ -40,22 +40,104 @@ spec:
   nginx:
     installType: "deployment"
     image: "nginx/nginx-ingress"
+    #digest: ""
     version: "2.3.0"
-    replicas: 2
+    #replicas: 2
+    autoscaling:
+      enabled: false
+      #minReplicas: 2
+      maxReplicas: 10
+      #targetCPUUtilizationPercentage: 80
+      #targetMemoryUtilizationPercentage: 80
+    #config:
+      #proxyReadTimeout: "60s"
+      #clientMaxBodySize: "1m"
+      #hsts:
+        #enabled: true
+        #maxAge: 2592000
+    #instances:
+    #- name: "internal"
+      #ingressClass: "nginx-internal"
+      #serviceAnnotations:
+        #service.beta.kubernetes.io/aws-load-balancer-internal: "true"
+      #replicas: 2
+      #electionID: "nginx-ingress-internal-leader-election"
+      #externalDNS: true
   namespace: "nukleros-ingress-system"
   externalDNS:
     provider: "none"
     image: "k8s.gcr.io/external-dns/external-dns"
+    #digest: ""
     version: "v0.12.2"
   domainName: "nukleros.io"
   kong:
-    replicas: 2
+    #replicas: 2
+    autoscaling:
+      enabled: false
+      #minReplicas: 2
+      maxReplicas: 10
+      #targetCPUUtilizationPercentage: 80
+      #targetMemoryUtilizationPercentage: 80
+    #logLevel: "info"
+    #listeners:
+      #proxy:
+        #http2: true
+        #proxyProtocol: false
+      #admin:
+        #port: 8444
+    #license:
+      #secretName: "kong-enterprise-license"
+      #key: "license"
+    plugins:
+      rateLimiting:
+        enabled: false
+        #minute: 600
+        limitBy: "ip"
+      cors:
+        enabled: false
+        #origins:
+        #- "https://app.nukleros.io"
+      prometheus:
+        enabled: false
+      requestID:
+        enabled: false
+        headerName: "Kong-Request-ID"
+        generator: "uuid#counter"
     gateway:
       image: "kong/kong-gateway"
+      #digest: ""
       version: "2.8"
     ingressController:
       image: "kong/kubernetes-ingress-controller"
+      #digest: ""
       version: "2.5.0"
+  #domains:
+  #- name: "apps.nukleros.io"
+    #domainFilter: "nukleros.io"
+    #certificate: true
+    #issuer:
+      #name: "letsencrypt-staging"
+      #kind: "ClusterIssuer"
+  certificate:
+    wildcard: false
+    #contactEmail: "admin@nukleros.io"
+    route53:
+      region: "us-east-1"
+      #hostedZoneID: ""
+    #google:
+      #project: ""
+      #serviceAccountSecretName: ""
+  gatewayAPI:
+    enabled: false
+    installCRDs: true
+    controller: "kong"
+    gatewayName: "default"
+    allowedRoutes: "All"
+  serviceMesh:
+    type: "none"
+  monitoring:
+    enabled: false
+    alerts: false
 `
 
 // sampleIngressComponentRequired is a sample containing only required fields
@@ -148,8 +230,13 @@ var CreateFuncs = []func(
 	CreateServiceAccountNamespaceExternalDns,
 	CreateClusterRoleNamespaceExternalDns,
 	CreateClusterRoleBindingExternalDnsViewer,
+	CreatePodMonitorNamespaceExternalDns,
+	CreatePrometheusRuleNamespaceExternalDns,
+	CreateIssuerNamespaceLetsencryptDns01Staging,
+	CreateIssuerNamespaceLetsencryptDns01Production,
 	CreateCertNamespaceNginxDefaultServerSecretNonProd,
 	CreateCertNamespaceNginxDefaultServerSecretProd,
+	CreateCertNamespaceDomains,
 	CreateConfigMapNamespaceNginxConfig,
 	CreateCRDDnsendpointsExternaldnsNginxOrg,
 	CreateCRDTransportserversK8sNginxOrg,
@@ -159,12 +246,19 @@ var CreateFuncs = []func(
 	CreateCRDVirtualserversK8sNginxOrg,
 	CreateDaemonSetNamespaceNginxIngress,
 	CreateDeploymentNamespaceNginxIngress,
+	CreatePodDisruptionBudgetNamespaceNginxIngress,
+	CreateHorizontalPodAutoscalerNamespaceNginxIngress,
+	CreateWorkloadNamespaceNginxIngressInstances,
 	CreateIngressClassNginx,
+	CreateIngressClassNginxInstances,
 	CreateServiceAccountNamespaceNginxIngress,
 	CreateClusterRoleNginxIngress,
 	CreateClusterRoleBindingNginxIngress,
 	CreateServiceNamespaceNginxIngressAws,
 	CreateServiceNamespaceNginxIngressGcpAzure,
+	CreateServiceNamespaceNginxIngressInstances,
+	CreatePodMonitorNamespaceNginxIngress,
+	CreatePrometheusRuleNamespaceNginxIngress,
 	CreateCRDKongclusterpluginsConfigurationKonghqCom,
 	CreateCRDKongconsumersConfigurationKonghqCom,
 	CreateCRDKongingressesConfigurationKonghqCom,
@@ -172,6 +266,8 @@ var CreateFuncs = []func(
 	CreateCRDTcpingressesConfigurationKonghqCom,
 	CreateCRDUdpingressesConfigurationKonghqCom,
 	CreateDeploymentNamespaceIngressKong,
+	CreatePodDisruptionBudgetNamespaceIngressKong,
+	CreateHorizontalPodAutoscalerNamespaceIngressKong,
 	CreateIngressClassKong,
 	CreateServiceAccountNamespaceKongServiceaccount,
 	CreateRoleNamespaceKongLeaderElection,
@@ -180,6 +276,18 @@ var CreateFuncs = []func(
 	CreateClusterRoleBindingKongIngress,
 	CreateServiceNamespaceKongProxy,
 	CreateServiceNamespaceKongValidationWebhook,
+	CreateKongClusterPluginGlobalRateLimiting,
+	CreateKongClusterPluginGlobalCors,
+	CreateKongClusterPluginGlobalPrometheus,
+	CreateKongClusterPluginGlobalRequestId,
+	CreatePodMonitorNamespaceKong,
+	CreatePrometheusRuleNamespaceKong,
+	CreateCRDGatewayclassesGatewayNetworkingK8sIo,
+	CreateCRDGatewaysGatewayNetworkingK8sIo,
+	CreateCRDHttproutesGatewayNetworkingK8sIo,
+	CreateCRDReferencepoliciesGatewayNetworkingK8sIo,
+	CreateGatewayClassKong,
+	CreateGatewayNamespaceDefault,
 }
 
 // InitFuncs is an array of functions that are called prior to starting the controller manager.  This is
@@ -17,12 +17,19 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"strings"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 )
 
 var ErrUnableToConvertIngressComponent = errors.New("unable to convert to IngressComponent")
@@ -59,8 +66,225 @@ type IngressComponentSpec struct {
 	// +kubebuilder:validation:Required
 	DomainName string `json:"domainName,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	//	Settings of the default-server certificate for the domain name.
+	Certificate IngressComponentSpecCertificate `json:"certificate,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Additional domain names which are served by the cluster.  The default-server certificate
+	//	and the domain filters of external-dns cover these domains as well as the domain name.
+	Domains []IngressComponentSpecDomain `json:"domains,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	Kong IngressComponentSpecKong `json:"kong,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Gateway API support, which allows routing traffic with HTTPRoutes through a default
+	//	gateway for the domain name.
+	GatewayAPI IngressComponentSpecGatewayAPI `json:"gatewayAPI,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Service mesh integration, which joins the ingress controllers to the mesh of the service
+	//	mesh component so that they reach the services of the mesh over mTLS.
+	ServiceMesh IngressComponentSpecServiceMesh `json:"serviceMesh,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Monitoring resources for nginx, kong and external-dns.  Requires the prometheus-operator custom resource
+	//	definitions, e.g. from the monitoring component.
+	Monitoring setupv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
+}
+
+type IngressComponentSpecDomain struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	//	Name of the domain.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	//	Domain filter of external-dns which covers the domain, e.g. the zone of the domain.
+	//	Defaults to the name of the domain.
+	DomainFilter string `json:"domainFilter,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to request a dedicated certificate for the domain, in addition to the
+	//	default-server certificate.  The certificate is stored in the <name>-<hash>-tls secret,
+	//	with the dots of the name replaced by dashes and <hash> the first 8 characters of the
+	//	sha256 sum of the name.
+	Certificate bool `json:"certificate,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Issuer of the dedicated certificate of the domain.  Defaults to the cluster issuer of
+	//	the tier of the collection.
+	Issuer IngressComponentSpecDomainIssuer `json:"issuer,omitempty"`
+}
+
+type IngressComponentSpecDomainIssuer struct {
+	// +kubebuilder:validation:Optional
+	//	Name of the issuer.
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:default="ClusterIssuer"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ClusterIssuer;Issuer
+	// (Default: "ClusterIssuer")
+	//
+	//	Kind of the issuer.  Issuers are looked up in the namespace of the component.
+	Kind string `json:"kind,omitempty"`
+}
+
+// SecretName returns the name of the Certificate, and of its secret, of the dedicated certificate
+// of the domain.  Replacing the dots of the name with dashes is ambiguous (a-b.example.com and
+// a.b-example.com), so the name ends with a short hash of the full name of the domain.
+func (domain IngressComponentSpecDomain) SecretName() string {
+	sum := sha256.Sum256([]byte(domain.Name))
+	suffix := "-" + hex.EncodeToString(sum[:])[:8] + "-tls"
+
+	name := strings.ReplaceAll(domain.Name, ".", "-")
+	if len(name) > validation.DNS1123SubdomainMaxLength-len(suffix) {
+		name = name[:validation.DNS1123SubdomainMaxLength-len(suffix)]
+	}
+
+	return name + suffix
+}
+
+// DomainNames returns the names of all domains served by the cluster, starting with the domain
+// name of the component.
+func (spec IngressComponentSpec) DomainNames() []string {
+	names := []string{spec.DomainName}
+
+	for _, domain := range spec.Domains {
+		names = appendUnique(names, domain.Name)
+	}
+
+	return names
+}
+
+// DomainFilters returns the domain filters of external-dns which cover all domains served by the
+// cluster.
+func (spec IngressComponentSpec) DomainFilters() []string {
+	filters := []string{spec.DomainName}
+
+	for _, domain := range spec.Domains {
+		filter := domain.DomainFilter
+		if filter == "" {
+			filter = domain.Name
+		}
+
+		filters = appendUnique(filters, filter)
+	}
+
+	return filters
+}
+
+// appendUnique appends a value to a list of values, unless the list already contains the value.
+func appendUnique(values []string, value string) []string {
+	for i := range values {
+		if values[i] == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
+
+type IngressComponentSpecCertificate struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to request a wildcard certificate for all subdomains of the domain name in
+	//	addition to the domain name itself.  Wildcard certificates are issued through DNS-01
+	//	challenges which are solved with the credentials of the external-dns provider, so they
+	//	require the route53 or google provider.
+	Wildcard bool `json:"wildcard,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Contact e-mail address for receiving updates about the wildcard certificate from
+	//	LetsEncrypt.
+	ContactEmail string `json:"contactEmail,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of DNS-01 challenges with the route53 provider.
+	Route53 IngressComponentSpecCertificateRoute53 `json:"route53,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of DNS-01 challenges with the google provider.
+	Google IngressComponentSpecCertificateGoogle `json:"google,omitempty"`
+}
+
+type IngressComponentSpecCertificateRoute53 struct {
+	// +kubebuilder:default="us-east-1"
+	// +kubebuilder:validation:Optional
+	// (Default: "us-east-1")
+	//
+	//	AWS region used to access route53.
+	Region string `json:"region,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	ID of the hosted zone of the domain name.  Defaults to the hosted zone which is found
+	//	for the domain name.
+	HostedZoneID string `json:"hostedZoneID,omitempty"`
+}
+
+type IngressComponentSpecCertificateGoogle struct {
+	// +kubebuilder:validation:Optional
+	//	Google cloud project of the managed zone of the domain name.  Required when the
+	//	external-dns provider is google.
+	Project string `json:"project,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of the secret, in the namespace of the component, holding the key.json of the
+	//	service account used to access cloud dns.  When not set, ambient credentials are used,
+	//	which requires cert-manager to be run with --issuer-ambient-credentials.
+	ServiceAccountSecretName string `json:"serviceAccountSecretName,omitempty"`
+}
+
+type IngressComponentSpecGatewayAPI struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to create a gateway class and a default gateway for the domain name.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install the Gateway API CRDs.  Disable this when the CRDs are managed
+	//	outside of the operator.
+	InstallCRDs bool `json:"installCRDs,omitempty"`
+
+	// +kubebuilder:default="kong"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=kong
+	// (Default: "kong")
+	//
+	//	Ingress controller which implements the gateway.  The nginx ingress controller does not
+	//	implement the Gateway API, so only kong is supported.
+	Controller string `json:"controller,omitempty"`
+
+	// +kubebuilder:default="default"
+	// +kubebuilder:validation:Optional
+	// (Default: "default")
+	//
+	//	Name of the default gateway, which is created in the namespace of the component.
+	GatewayName string `json:"gatewayName,omitempty"`
+
+	// +kubebuilder:default="All"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=All;Same
+	// (Default: "All")
+	//
+	//	Namespaces from which routes may attach to the default gateway.  One of: All | Same.
+	AllowedRoutes string `json:"allowedRoutes,omitempty"`
+}
+
+type IngressComponentSpecServiceMesh struct {
+	// +kubebuilder:default="none"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=none;linkerd;istio
+	// (Default: "none")
+	//
+	//	Service mesh which the ingress controllers join.  One of: none | linkerd | istio.  With
+	//	linkerd, a proxy is injected into the ingress controller pods which skips the inbound
+	//	traffic of the listeners.  With istio, the namespace of the component is added to the
+	//	ambient mesh.
+	Type string `json:"type,omitempty"`
 }
 
 type IngressComponentCollectionSpec struct {
@@ -91,6 +315,12 @@ type IngressComponentSpecNginx struct {
 	//	Image repo and name to use for nginx.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the nginx image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="2.3.0"
 	// +kubebuilder:validation:Optional
 	// (Default: "2.3.0")
@@ -98,12 +328,244 @@ type IngressComponentSpecNginx struct {
 	//	Version of nginx to use.
 	Version string `json:"version,omitempty"`
 
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the nginx ingress controller deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the nginx ingress controller deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.  When autoscaling is enabled, this is the default
+	//	minimum number of replicas instead.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the nginx ingress controller deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Horizontal autoscaling of the nginx ingress controller deployment.
+	Autoscaling IngressComponentSpecAutoscaling `json:"autoscaling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Configuration of the nginx ingress controller, which is rendered into its nginx-config config
+	//	map.  Settings which are not set keep the defaults of the controller.
+	Config IngressComponentSpecNginxConfig `json:"config,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the nginx ingress controller pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the nginx ingress controller container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Additional instances of the nginx ingress controller, e.g. for internal ingresses, which
+	//	run alongside the nginx instance.  Each instance serves its own ingress class through its
+	//	own load balancer service and otherwise shares the settings of the nginx instance.
+	Instances []IngressComponentSpecNginxInstance `json:"instances,omitempty"`
+}
+
+type IngressComponentSpecNginxInstance struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//	Name of the instance.  The resources of the instance are named nginx-ingress-<name>.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	//	Name of the ingress class served by the instance.
+	IngressClass string `json:"ingressClass"`
+
+	// +kubebuilder:validation:Optional
+	//	Annotations of the load balancer service of the instance, e.g. to request an internal
+	//	load balancer.
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas of the instance.  Defaults to the number of replicas of the tier of the
+	//	collection.  Ignored for the daemonset install type.
 	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of the leader election lock of the instance.  Defaults to
+	//	nginx-ingress-<name>-leader-election.
+	ElectionID string `json:"electionID,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether external-dns manages the records of the ingresses of the ingress class of the
+	//	instance.  Defaults to true.  Disabling it limits external-dns to the ingress classes
+	//	of the component, so that the records of ingresses of other classes are no longer
+	//	managed either.
+	ExternalDNS *bool `json:"externalDNS,omitempty"`
+}
+
+// WorkloadName returns the name of the workload and the other resources of the instance.
+func (instance IngressComponentSpecNginxInstance) WorkloadName() string {
+	return "nginx-ingress-" + instance.Name
+}
+
+// LeaderElectionLockName returns the name of the leader election lock of the instance.
+func (instance IngressComponentSpecNginxInstance) LeaderElectionLockName() string {
+	if instance.ElectionID != "" {
+		return instance.ElectionID
+	}
+
+	return instance.WorkloadName() + "-leader-election"
+}
+
+type IngressComponentSpecNginxConfig struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(ms|s|m|h)?$`
+	//	Timeout for establishing a connection with an upstream server (e.g. 60s).
+	ProxyConnectTimeout string `json:"proxyConnectTimeout,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(ms|s|m|h)?$`
+	//	Timeout for reading a response from an upstream server (e.g. 60s).
+	ProxyReadTimeout string `json:"proxyReadTimeout,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(ms|s|m|h)?$`
+	//	Timeout for transmitting a request to an upstream server (e.g. 60s).
+	ProxySendTimeout string `json:"proxySendTimeout,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+[kKmMgG]?$`
+	//	Maximum allowed size of a client request body (e.g. 1m).  A size of 0 disables the check.
+	ClientMaxBodySize string `json:"clientMaxBodySize,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	HTTP Strict Transport Security settings.
+	HSTS IngressComponentSpecNginxConfigHSTS `json:"hsts,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings for determining the real ip address of clients behind a load balancer or proxy.
+	RealIP IngressComponentSpecNginxConfigRealIP `json:"realIP,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to accept the proxy protocol on all listeners, e.g. behind a load balancer which
+	//	passes client addresses with it.
+	ProxyProtocol bool `json:"proxyProtocol,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Format of the access log, in nginx log_format syntax.
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=default;json;none
+	//	Character escaping of variables in the access log.  One of: default | json | none.
+	LogFormatEscaping string `json:"logFormatEscaping,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	SSL protocols to enable (e.g. TLSv1.2, TLSv1.3).
+	SSLProtocols []IngressComponentSpecNginxConfigSSLProtocol `json:"sslProtocols,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	SSL ciphers to enable, in OpenSSL cipher list format.
+	SSLCiphers string `json:"sslCiphers,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to prefer the server ciphers over the client ciphers.
+	SSLPreferServerCiphers bool `json:"sslPreferServerCiphers,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(auto|[0-9]+)$`
+	//	Number of nginx worker processes, or auto for one per cpu core.
+	WorkerProcesses string `json:"workerProcesses,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Maximum number of simultaneous connections of each worker process.
+	WorkerConnections int `json:"workerConnections,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Maximum number of open files of each worker process.
+	WorkerRlimitNofile int `json:"workerRlimitNofile,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=TLSv1;TLSv1.1;TLSv1.2;TLSv1.3
+type IngressComponentSpecNginxConfigSSLProtocol string
+
+type IngressComponentSpecNginxConfigHSTS struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to send the Strict-Transport-Security header.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	//	Time in seconds for which browsers only access the domain over https.
+	MaxAge int `json:"maxAge,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether the header also applies to all subdomains.
+	IncludeSubdomains bool `json:"includeSubdomains,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to decide if the header is sent based on the X-Forwarded-Proto header, when ssl is
+	//	terminated by a load balancer in front of nginx.
+	BehindProxy bool `json:"behindProxy,omitempty"`
+}
+
+type IngressComponentSpecNginxConfigRealIP struct {
+	// +kubebuilder:validation:Optional
+	//	Request header which holds the real ip address of the client (e.g. X-Forwarded-For, or
+	//	proxy_protocol when the proxy protocol is accepted).
+	Header string `json:"header,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Addresses or cidr ranges of the trusted load balancers or proxies which set the header.
+	TrustedAddresses []string `json:"trustedAddresses,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to skip trusted addresses when searching the header for the client address.
+	Recursive bool `json:"recursive,omitempty"`
+}
+
+type IngressComponentSpecAutoscaling struct {
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Whether to scale the deployment with a horizontal pod autoscaler.  When enabled, the
+	//	replicas of the deployment are left to the autoscaler.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Minimum number of replicas.  Defaults to the number of replicas of the deployment.
+	MinReplicas int `json:"minReplicas,omitempty"`
+
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// (Default: 10)
+	//
+	//	Maximum number of replicas.
+	MaxReplicas int `json:"maxReplicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Target average cpu utilization, as a percentage of the cpu requests of the pods.  When
+	//	neither target is set, the autoscaler targets 80% cpu utilization.
+	TargetCPUUtilizationPercentage int `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Target average memory utilization, as a percentage of the memory requests of the pods.
+	TargetMemoryUtilizationPercentage int `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// ReplicasFor returns the minimum number of replicas if autoscaling is enabled and sets its own
+// minimum, or the given number of replicas otherwise.
+func (autoscaling IngressComponentSpecAutoscaling) ReplicasFor(replicas int) int {
+	if autoscaling.Enabled && autoscaling.MinReplicas > 0 {
+		return autoscaling.MinReplicas
+	}
+
+	return replicas
 }
 
 type IngressComponentSpecExternalDNS struct {
@@ -117,27 +579,247 @@ type IngressComponentSpecExternalDNS struct {
 	//	Image repo and name to use for external-dns.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the external-dns image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="v0.12.2"
 	// +kubebuilder:validation:Optional
 	// (Default: "v0.12.2")
 	//
 	//	Version of external-dns to use.
 	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the external-dns pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the external-dns container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type IngressComponentSpecKong struct {
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the kong ingress deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the kong ingress deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.  When autoscaling is enabled, this is the default
+	//	minimum number of replicas instead.
 	Replicas int `json:"replicas,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the kong ingress deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Horizontal autoscaling of the kong ingress deployment.
+	Autoscaling IngressComponentSpecAutoscaling `json:"autoscaling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=debug;info;warn;error
+	//	Log level of the kong gateway and ingress controller.  Defaults to the log level of the
+	//	tier of the collection.
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of the proxy and admin listeners of the kong gateway.
+	Listeners IngressComponentSpecKongListeners `json:"listeners,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Secret holding the kong enterprise license.
+	License IngressComponentSpecKongLicense `json:"license,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Plugins which are applied globally to all services proxied by kong.
+	Plugins IngressComponentSpecKongPlugins `json:"plugins,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	Gateway IngressComponentSpecKongGateway `json:"gateway,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	IngressController IngressComponentSpecKongIngressController `json:"ingressController,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the kong ingress pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+}
+
+type IngressComponentSpecKongListeners struct {
+	// +kubebuilder:validation:Optional
+	//	Settings of the proxy listeners of the kong gateway.
+	Proxy IngressComponentSpecKongProxyListener `json:"proxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of the admin listener of the kong gateway.
+	Admin IngressComponentSpecKongAdminListener `json:"admin,omitempty"`
+}
+
+type IngressComponentSpecKongProxyListener struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to accept HTTP/2 connections on the https proxy listener.  Defaults to true.
+	HTTP2 *bool `json:"http2,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether the proxy listeners expect the PROXY protocol, e.g. when the kong-proxy service
+	//	is fronted by a load balancer which uses it.
+	ProxyProtocol bool `json:"proxyProtocol,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Maximum length of the queue of pending connections of the proxy listeners.  Defaults
+	//	to the default of the operating system.
+	Backlog int `json:"backlog,omitempty"`
+}
+
+type IngressComponentSpecKongAdminListener struct {
+	// +kubebuilder:default=8444
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// (Default: 8444)
+	//
+	//	Port of the admin listener of the kong gateway.  The admin listener only listens on
+	//	the loopback interface and is used by the ingress controller.
+	Port int `json:"port,omitempty"`
+}
+
+type IngressComponentSpecKongLicense struct {
+	// +kubebuilder:validation:Optional
+	//	Name of the secret, in the namespace of the component, holding the kong enterprise
+	//	license.  When not set, the license is taken from the kong-enterprise-license secret if
+	//	it exists.
+	SecretName string `json:"secretName,omitempty"`
+
+	// +kubebuilder:default="license"
+	// +kubebuilder:validation:Optional
+	// (Default: "license")
+	//
+	//	Key of the license in the secret.
+	Key string `json:"key,omitempty"`
+}
+
+type IngressComponentSpecKongPlugins struct {
+	// +kubebuilder:validation:Optional
+	//	Settings of the global rate-limiting plugin.
+	RateLimiting IngressComponentSpecKongRateLimiting `json:"rateLimiting,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of the global cors plugin.
+	CORS IngressComponentSpecKongCORS `json:"cors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of the global prometheus plugin.
+	Prometheus IngressComponentSpecKongPrometheus `json:"prometheus,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings of the global request-id plugin.
+	RequestID IngressComponentSpecKongRequestID `json:"requestID,omitempty"`
+}
+
+type IngressComponentSpecKongRateLimiting struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to rate limit all requests proxied by kong.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of requests allowed per second.
+	Second int `json:"second,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of requests allowed per minute.
+	Minute int `json:"minute,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of requests allowed per hour.
+	Hour int `json:"hour,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of requests allowed per day.
+	Day int `json:"day,omitempty"`
+
+	// +kubebuilder:default="ip"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=consumer;credential;ip;service
+	// (Default: "ip")
+	//
+	//	Entity which the limits are applied to.
+	LimitBy string `json:"limitBy,omitempty"`
+}
+
+type IngressComponentSpecKongCORS struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to handle cross-origin requests for all services proxied by kong.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Allowed origins.  Defaults to all origins.
+	Origins []string `json:"origins,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Allowed methods.  Defaults to the common http methods.
+	Methods []string `json:"methods,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Allowed request headers.  Defaults to the headers requested by the client.
+	Headers []string `json:"headers,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Response headers which are exposed to the client.
+	ExposedHeaders []string `json:"exposedHeaders,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to allow credentials in cross-origin requests.
+	Credentials bool `json:"credentials,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of seconds which the results of a preflight request may be cached.
+	MaxAge int `json:"maxAge,omitempty"`
+}
+
+type IngressComponentSpecKongPrometheus struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to expose prometheus metrics for all services proxied by kong.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to expose metrics per consumer.
+	PerConsumer bool `json:"perConsumer,omitempty"`
+}
+
+type IngressComponentSpecKongRequestID struct {
+	// +kubebuilder:validation:Optional
+	//	Whether to add a request id to all requests proxied by kong.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="Kong-Request-ID"
+	// +kubebuilder:validation:Optional
+	// (Default: "Kong-Request-ID")
+	//
+	//	Name of the header holding the request id.
+	HeaderName string `json:"headerName,omitempty"`
+
+	// +kubebuilder:default="uuid#counter"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=uuid;uuid#counter;tracker
+	// (Default: "uuid#counter")
+	//
+	//	Generator of the request id.
+	Generator string `json:"generator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to echo the request id in the response to the client.
+	EchoDownstream bool `json:"echoDownstream,omitempty"`
 }
 
 type IngressComponentSpecKongGateway struct {
@@ -148,12 +830,23 @@ type IngressComponentSpecKongGateway struct {
 	//	Image repo and name to use for kong gateway.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the kong gateway image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="2.8"
 	// +kubebuilder:validation:Optional
 	// (Default: "2.8")
 	//
 	//	Version of kong gateway to use.
 	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the kong gateway container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type IngressComponentSpecKongIngressController struct {
@@ -164,12 +857,23 @@ type IngressComponentSpecKongIngressController struct {
 	//	Image repo and name to use for kong ingress controller.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the kong ingress controller image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="2.5.0"
 	// +kubebuilder:validation:Optional
 	// (Default: "2.5.0")
 	//
 	//	Version of kong ingress controller to use.
 	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the kong ingress controller container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // IngressComponentStatus defines the observed state of IngressComponent.
@@ -181,6 +885,9 @@ type IngressComponentStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -266,6 +973,19 @@ func (component *IngressComponent) SetChildResourceCondition(resource *status.Ch
 	component.Status.Resources = append(component.Status.Resources, resource)
 }
 
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *IngressComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
 // GetDependencies returns the dependencies for a component.
 func (*IngressComponent) GetDependencies() []workload.Workload {
 	return []workload.Workload{
@@ -278,6 +998,91 @@ func (*IngressComponent) GetWorkloadGVK() schema.GroupVersionKind {
 	return GroupVersion.WithKind("IngressComponent")
 }
 
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *IngressComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *IngressComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *IngressComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	kong, nginx := component.Spec.Kong, component.Spec.Nginx
+
+	// autoscaled deployments report their minimum number of replicas
+	replicas := map[string]int{
+		"ingress-kong": profile.ReplicasFor(kong.Autoscaling.ReplicasFor(kong.Replicas)),
+	}
+
+	// the daemonset install type runs one replica per node
+	if nginx.InstallType == "deployment" {
+		replicas["nginx-ingress"] = profile.ReplicasFor(nginx.Autoscaling.ReplicasFor(nginx.Replicas))
+
+		for _, instance := range nginx.Instances {
+			replicas[instance.WorkloadName()] = profile.ReplicasFor(instance.Replicas)
+		}
+	}
+
+	return replicas
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *IngressComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"ingress-kong": component.Spec.Kong.PodDisruptionBudget,
+	}
+
+	if component.Spec.Nginx.InstallType == "deployment" {
+		budgets["nginx-ingress"] = component.Spec.Nginx.PodDisruptionBudget
+	}
+
+	return budgets
+}
+
+// IngressClasses returns the names of the ingress classes whose ingresses are watched by
+// external-dns.  External-dns watches the ingresses of all classes, so no classes are returned
+// unless an instance opts out of external-dns, in which case external-dns is limited to the other
+// ingress classes served by the component.
+func (component *IngressComponent) IngressClasses() []string {
+	classes := []string{"nginx", "kong"}
+	limited := false
+
+	for _, instance := range component.Spec.Nginx.Instances {
+		if instance.ExternalDNS != nil && !*instance.ExternalDNS {
+			limited = true
+
+			continue
+		}
+
+		classes = appendUnique(classes, instance.IngressClass)
+	}
+
+	if !limited {
+		return nil
+	}
+
+	return classes
+}
+
+// Autoscales returns whether the named deployment of the component is scaled by a horizontal pod
+// autoscaler, in which case its replicas are left to the autoscaler.
+func (component *IngressComponent) Autoscales(name string) bool {
+	switch name {
+	case "nginx-ingress":
+		return component.Spec.Nginx.InstallType == "deployment" && component.Spec.Nginx.Autoscaling.Enabled
+	case "ingress-kong":
+		return component.Spec.Kong.Autoscaling.Enabled
+	}
+
+	return false
+}
+
 func init() {
 	SchemeBuilder.Register(&IngressComponent{}, &IngressComponentList{})
 }
@@ -0,0 +1,182 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/loggingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceVectorAggregator creates the Deployment resource with name vector-aggregator.
+func CreateDeploymentNamespaceVectorAggregator(
+	parent *platformv1alpha1.LoggingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Aggregator.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=aggregator.enabled,value=true,include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "vector",
+					"app.kubernetes.io/component":  "aggregator",
+					"platform.nukleros.io/group":   "logging",
+					"platform.nukleros.io/project": "vector",
+				},
+				"name":      "vector-aggregator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "vector",
+						"app.kubernetes.io/component": "aggregator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "vector",
+							"app.kubernetes.io/component":  "aggregator",
+							"platform.nukleros.io/group":   "logging",
+							"platform.nukleros.io/project": "vector",
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "vector",
+								// controlled by field: aggregator.image
+								// controlled by field: aggregator.version
+								//  Image repo and name to use for the vector aggregator.
+								//  Version of the vector aggregator to use.
+								"image":           "" + parent.Spec.Aggregator.Image + ":" + parent.Spec.Aggregator.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--config-dir",
+									"/etc/vector/",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "VECTOR_LOG",
+										"value": "info",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "vector",
+										"containerPort": 6000,
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"name":          "fluent",
+										"containerPort": 24224,
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"name":          "api",
+										"containerPort": 8686,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/health",
+										"port": "api",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/health",
+										"port": "api",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "500m",
+										"memory": "512Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "config",
+										"mountPath": "/etc/vector/",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "data",
+										"mountPath": "/vector-data-dir",
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"securityContext": map[string]interface{}{
+							"runAsNonRoot": true,
+							"runAsUser":    65534,
+							"fsGroup":      65534,
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "config",
+								"configMap": map[string]interface{}{
+									"name": "vector-aggregator",
+								},
+							},
+							map[string]interface{}{
+								"name":     "data",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceVectorAggregator(resourceObj, parent, collection, reconciler, req)
+}
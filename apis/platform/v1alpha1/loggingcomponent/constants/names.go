@@ -0,0 +1,38 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                           = "parent.Spec.Namespace"
+	ConfigMapNamespaceFluentBit                  = "fluent-bit"
+	DaemonSetNamespaceFluentBit                  = "fluent-bit"
+	ServiceAccountNamespaceFluentBit             = "fluent-bit"
+	ClusterRoleFluentBit                         = "fluent-bit"
+	ClusterRoleBindingFluentBit                  = "fluent-bit"
+	ConfigMapNamespaceVector                     = "vector"
+	DaemonSetNamespaceVector                     = "vector"
+	ServiceAccountNamespaceVector                = "vector"
+	ClusterRoleVector                            = "vector"
+	ClusterRoleBindingVector                     = "vector"
+	ConfigMapNamespaceVectorAggregator           = "vector-aggregator"
+	DeploymentNamespaceVectorAggregator          = "vector-aggregator"
+	ServiceNamespaceVectorAggregator             = "vector-aggregator"
+	PodDisruptionBudgetNamespaceVectorAggregator = "vector-aggregator"
+)
@@ -0,0 +1,92 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/loggingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConfigMapNamespaceFluentBit creates the ConfigMap resource with name fluent-bit.
+func CreateConfigMapNamespaceFluentBit(
+	parent *platformv1alpha1.LoggingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Collector.Type != "fluent-bit" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=collector.type,value="fluent-bit",include
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "fluent-bit",
+					"platform.nukleros.io/group":   "logging",
+					"platform.nukleros.io/project": "fluent-bit",
+				},
+				"name":      "fluent-bit",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"data": map[string]interface{}{
+				"fluent-bit.conf": `[SERVICE]
+    Flush         1
+    Log_Level     info
+    HTTP_Server   On
+    HTTP_Listen   0.0.0.0
+    HTTP_Port     2020
+    Health_Check  On
+
+[INPUT]
+    Name              tail
+    Path              /var/log/containers/*.log
+    multiline.parser  docker, cri
+    Tag               kube.*
+    DB                /var/fluent-bit/state/flb_kube.db
+    Mem_Buf_Limit     5MB
+    Skip_Long_Lines   On
+
+[FILTER]
+    Name                 kubernetes
+    Match                kube.*
+    Merge_Log            On
+    Keep_Log             Off
+    K8S-Logging.Parser   On
+    K8S-Logging.Exclude  On
+
+[OUTPUT]
+    Name    stdout
+    Match   *
+    Format  json_lines
+`,
+			},
+		},
+	}
+
+	return mutate.MutateConfigMapNamespaceFluentBit(resourceObj, parent, collection, reconciler, req)
+}
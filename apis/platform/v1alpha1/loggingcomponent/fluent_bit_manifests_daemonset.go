@@ -0,0 +1,193 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/loggingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceFluentBit creates the DaemonSet resource with name fluent-bit.
+func CreateDaemonSetNamespaceFluentBit(
+	parent *platformv1alpha1.LoggingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Collector.Type != "fluent-bit" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=collector.type,value="fluent-bit",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "fluent-bit",
+					"app.kubernetes.io/component":  "collector",
+					"platform.nukleros.io/group":   "logging",
+					"platform.nukleros.io/project": "fluent-bit",
+				},
+				"name":      "fluent-bit",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "fluent-bit",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "10%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "fluent-bit",
+							"app.kubernetes.io/component":  "collector",
+							"platform.nukleros.io/group":   "logging",
+							"platform.nukleros.io/project": "fluent-bit",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "fluent-bit",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "fluent-bit",
+								// controlled by field: collector.fluentBit.image
+								// controlled by field: collector.fluentBit.version
+								//  Image repo and name to use for fluent-bit.
+								//  Version of fluent-bit to use.
+								"image":           "" + parent.Spec.Collector.FluentBit.Image + ":" + parent.Spec.Collector.FluentBit.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "http",
+										"containerPort": 2020,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/",
+										"port": "http",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/api/v1/health",
+										"port": "http",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "50m",
+										"memory": "64Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "config",
+										"mountPath": "/fluent-bit/etc/",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "varlog",
+										"mountPath": "/var/log",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "varlibdockercontainers",
+										"mountPath": "/var/lib/docker/containers",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "state",
+										"mountPath": "/var/fluent-bit/state",
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"priorityClassName": "system-node-critical",
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"operator": "Exists",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "config",
+								"configMap": map[string]interface{}{
+									"name": "fluent-bit",
+								},
+							},
+							map[string]interface{}{
+								"name": "varlog",
+								"hostPath": map[string]interface{}{
+									"path": "/var/log",
+								},
+							},
+							map[string]interface{}{
+								"name": "varlibdockercontainers",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/docker/containers",
+								},
+							},
+							map[string]interface{}{
+								"name": "state",
+								"hostPath": map[string]interface{}{
+									"path": "/var/fluent-bit/state",
+									"type": "DirectoryOrCreate",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceFluentBit(resourceObj, parent, collection, reconciler, req)
+}
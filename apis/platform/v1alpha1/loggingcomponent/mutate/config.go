@@ -0,0 +1,585 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+const (
+	fluentBit        = "fluent-bit"
+	vector           = "vector"
+	vectorAggregator = "vector-aggregator"
+
+	// vectorPort and fluentPort are the ports of the aggregator which receive the logs of the
+	// vector and fluent-bit collectors.
+	vectorPort = 6000
+	fluentPort = 24224
+)
+
+var (
+	ErrMissingOutputEndpoint  = errors.New("endpoint is required for output")
+	ErrMissingOutputBucket    = errors.New("bucket and region are required for output")
+	ErrMultipleS3Credentials  = errors.New("fluent-bit supports the credentials of a single s3 output")
+	ErrUnsupportedOutputType  = errors.New("unsupported output type")
+	ErrUnknownLoggingWorkload = errors.New("unknown logging workload")
+)
+
+// credentialKeys are the keys of the credentials secret of an output, keyed by the output type.
+var credentialKeys = map[string][]string{
+	"loki":          {"username", "password"},
+	"elasticsearch": {"username", "password"},
+	"opensearch":    {"username", "password"},
+	"s3":            {"accessKeyID", "secretAccessKey"},
+}
+
+// credentialSuffixes are the suffixes of the environment variables which hold the keys of the
+// credentials secret of an output.
+var credentialSuffixes = map[string]string{
+	"username":        "USERNAME",
+	"password":        "PASSWORD",
+	"accessKeyID":     "ACCESS_KEY_ID",
+	"secretAccessKey": "SECRET_ACCESS_KEY",
+}
+
+// outputCredential is an environment variable of the workload which sends the logs to the
+// outputs, which holds a key of the credentials secret of an output.
+type outputCredential struct {
+	variable string
+	secret   string
+	key      string
+}
+
+// credentialVariable returns the environment variable which holds a key of the credentials secret
+// of an output.  The s3 output of fluent-bit only reads the credentials from the standard AWS
+// environment variables.
+func credentialVariable(writer string, output *platformv1alpha1.LoggingComponentSpecOutput, key string) string {
+	if writer == fluentBit && output.Type == "s3" {
+		return "AWS_" + credentialSuffixes[key]
+	}
+
+	name := strings.ToUpper(strings.ReplaceAll(output.Name, "-", "_"))
+
+	return fmt.Sprintf("OUTPUT_%s_%s", name, credentialSuffixes[key])
+}
+
+// outputCredentials returns the environment variables which hold the credentials of the outputs
+// of the component for the given writer, which is either fluent-bit or vector.
+func outputCredentials(writer string, outputs []platformv1alpha1.LoggingComponentSpecOutput) ([]outputCredential, error) {
+	credentials := []outputCredential{}
+
+	var s3Credentials int
+
+	for i := range outputs {
+		output := &outputs[i]
+
+		if output.CredentialsSecret == "" {
+			continue
+		}
+
+		if output.Type == "s3" {
+			s3Credentials++
+		}
+
+		for _, key := range credentialKeys[output.Type] {
+			credentials = append(credentials, outputCredential{
+				variable: credentialVariable(writer, output, key),
+				secret:   output.CredentialsSecret,
+				key:      key,
+			})
+		}
+	}
+
+	if writer == fluentBit && s3Credentials > 1 {
+		return nil, ErrMultipleS3Credentials
+	}
+
+	return credentials, nil
+}
+
+// writesOutputs returns whether the named workload of the component sends the logs to the
+// outputs.  The collector forwards the logs to the aggregator instead when it is enabled.
+func writesOutputs(parent *platformv1alpha1.LoggingComponent, name string) bool {
+	if name == vectorAggregator {
+		return true
+	}
+
+	return !parent.UsesAggregator()
+}
+
+// configKeys are the keys of the config maps of the workloads of the component.
+var configKeys = map[string]string{
+	fluentBit:        "fluent-bit.conf",
+	vector:           "vector.yaml",
+	vectorAggregator: "vector.yaml",
+}
+
+// configData returns the data of the config map of the named workload of the component, which
+// is rendered from the outputs of the component.
+func configData(
+	parent *platformv1alpha1.LoggingComponent, collection *setupv1alpha1.SupportServices,
+	name string,
+) (map[string]string, error) {
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	var config string
+
+	switch name {
+	case fluentBit:
+		config, err = fluentBitConfig(parent, profile.LogLevel)
+	case vector, vectorAggregator:
+		config, err = vectorConfig(parent, name)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLoggingWorkload, name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{configKeys[name]: config}, nil
+}
+
+// setConfig replaces the data of the config map of a workload of the component with the
+// configuration which is rendered from the outputs of the component.
+func setConfig(
+	original client.Object,
+	parent *platformv1alpha1.LoggingComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, err := configData(parent, collection, original.GetName())
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{}
+	for key, value := range data {
+		values[key] = value
+	}
+
+	configMap.Object["data"] = values
+
+	return nil
+}
+
+// fluentBitConfig returns the fluent-bit configuration for the outputs of the component.
+func fluentBitConfig(parent *platformv1alpha1.LoggingComponent, logLevel string) (string, error) {
+	var config strings.Builder
+
+	writeSection := func(name string, settings [][2]string) {
+		fmt.Fprintf(&config, "[%s]\n", name)
+
+		for _, setting := range settings {
+			if setting[1] != "" {
+				fmt.Fprintf(&config, "    %-24s %s\n", setting[0], setting[1])
+			}
+		}
+
+		config.WriteString("\n")
+	}
+
+	writeSection("SERVICE", [][2]string{
+		{"Flush", "1"},
+		{"Log_Level", logLevel},
+		{"HTTP_Server", "On"},
+		{"HTTP_Listen", "0.0.0.0"},
+		{"HTTP_Port", "2020"},
+		{"Health_Check", "On"},
+	})
+
+	writeSection("INPUT", [][2]string{
+		{"Name", "tail"},
+		{"Path", "/var/log/containers/*.log"},
+		{"multiline.parser", "docker, cri"},
+		{"Tag", "kube.*"},
+		{"DB", "/var/fluent-bit/state/flb_kube.db"},
+		{"Mem_Buf_Limit", "5MB"},
+		{"Skip_Long_Lines", "On"},
+	})
+
+	writeSection("FILTER", [][2]string{
+		{"Name", "kubernetes"},
+		{"Match", "kube.*"},
+		{"Merge_Log", "On"},
+		{"Keep_Log", "Off"},
+		{"K8S-Logging.Parser", "On"},
+		{"K8S-Logging.Exclude", "On"},
+	})
+
+	if !writesOutputs(parent, fluentBit) {
+		writeSection("OUTPUT", [][2]string{
+			{"Name", "forward"},
+			{"Match", "*"},
+			{"Host", fmt.Sprintf("%s.%s.svc", vectorAggregator, parent.Spec.Namespace)},
+			{"Port", fmt.Sprint(fluentPort)},
+		})
+
+		return strings.TrimSuffix(config.String(), "\n"), nil
+	}
+
+	if _, err := outputCredentials(fluentBit, parent.Spec.Outputs); err != nil {
+		return "", err
+	}
+
+	if len(parent.Spec.Outputs) == 0 {
+		writeSection("OUTPUT", [][2]string{{"Name", "stdout"}, {"Match", "*"}, {"Format", "json_lines"}})
+	}
+
+	for i := range parent.Spec.Outputs {
+		settings, err := fluentBitOutput(&parent.Spec.Outputs[i])
+		if err != nil {
+			return "", err
+		}
+
+		writeSection("OUTPUT", settings)
+	}
+
+	return strings.TrimSuffix(config.String(), "\n"), nil
+}
+
+// fluentBitOutput returns the settings of the fluent-bit output plugin for an output.
+func fluentBitOutput(output *platformv1alpha1.LoggingComponentSpecOutput) ([][2]string, error) {
+	credential := func(key string) string {
+		if output.CredentialsSecret == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("${%s}", credentialVariable(fluentBit, output, key))
+	}
+
+	switch output.Type {
+	case "stdout":
+		return [][2]string{{"Name", "stdout"}, {"Alias", output.Name}, {"Match", "*"}, {"Format", "json_lines"}}, nil
+	case "loki":
+		endpoint, err := parseEndpoint(output)
+		if err != nil {
+			return nil, err
+		}
+
+		return [][2]string{
+			{"Name", "loki"},
+			{"Alias", output.Name},
+			{"Match", "*"},
+			{"Host", endpoint.host},
+			{"Port", endpoint.port},
+			{"Uri", endpoint.path},
+			{"Tls", endpoint.tls},
+			{"Tenant_ID", output.TenantID},
+			{"Http_User", credential("username")},
+			{"Http_Passwd", credential("password")},
+			{"Labels", "job=fluent-bit"},
+			{"Auto_Kubernetes_Labels", "On"},
+			{"Line_Format", "json"},
+		}, nil
+	case "elasticsearch", "opensearch":
+		endpoint, err := parseEndpoint(output)
+		if err != nil {
+			return nil, err
+		}
+
+		name := "es"
+		if output.Type == "opensearch" {
+			name = "opensearch"
+		}
+
+		return [][2]string{
+			{"Name", name},
+			{"Alias", output.Name},
+			{"Match", "*"},
+			{"Host", endpoint.host},
+			{"Port", endpoint.port},
+			{"Path", endpoint.path},
+			{"Tls", endpoint.tls},
+			{"Index", outputIndex(output)},
+			{"Suppress_Type_Name", "On"},
+			{"Replace_Dots", "On"},
+			{"HTTP_User", credential("username")},
+			{"HTTP_Passwd", credential("password")},
+		}, nil
+	case "s3":
+		if output.Bucket == "" || output.Region == "" {
+			return nil, fmt.Errorf("%w %s", ErrMissingOutputBucket, output.Name)
+		}
+
+		return [][2]string{
+			{"Name", "s3"},
+			{"Alias", output.Name},
+			{"Match", "*"},
+			{"Bucket", output.Bucket},
+			{"Region", output.Region},
+			{"Endpoint", output.Endpoint},
+			{"Total_File_Size", "50M"},
+			{"Upload_Timeout", "10m"},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w %s for output %s", ErrUnsupportedOutputType, output.Type, output.Name)
+}
+
+// vectorConfig returns the vector configuration of the named vector workload for the outputs of
+// the component.  The logs are normalized so that the namespace, pod and container of the logs
+// from either collector are available to the outputs under the same fields.
+func vectorConfig(parent *platformv1alpha1.LoggingComponent, name string) (string, error) {
+	config := map[string]interface{}{
+		"data_dir": "/vector-data-dir",
+		"api": map[string]interface{}{
+			"enabled": true,
+			"address": "0.0.0.0:8686",
+		},
+	}
+
+	sources := map[string]interface{}{}
+	config["sources"] = sources
+
+	if name == vectorAggregator {
+		sources["vector"] = map[string]interface{}{
+			"type":    "vector",
+			"address": fmt.Sprintf("0.0.0.0:%d", vectorPort),
+			"version": "2",
+		}
+		sources["fluent"] = map[string]interface{}{
+			"type":    "fluent",
+			"address": fmt.Sprintf("0.0.0.0:%d", fluentPort),
+		}
+	} else {
+		sources["kubernetes_logs"] = map[string]interface{}{
+			"type": "kubernetes_logs",
+		}
+	}
+
+	if !writesOutputs(parent, name) {
+		config["sinks"] = map[string]interface{}{
+			"aggregator": map[string]interface{}{
+				"type":    "vector",
+				"inputs":  []string{"kubernetes_logs"},
+				"address": fmt.Sprintf("%s.%s.svc:%d", vectorAggregator, parent.Spec.Namespace, vectorPort),
+				"version": "2",
+			},
+		}
+
+		return marshalVectorConfig(config)
+	}
+
+	inputs := make([]string, 0, len(sources))
+	for source := range sources {
+		inputs = append(inputs, source)
+	}
+
+	sort.Strings(inputs)
+
+	config["transforms"] = map[string]interface{}{
+		"normalize": map[string]interface{}{
+			"type":   "remap",
+			"inputs": inputs,
+			"source": strings.Join([]string{
+				".namespace = .kubernetes.pod_namespace || .kubernetes.namespace_name",
+				".pod = .kubernetes.pod_name",
+				".container = .kubernetes.container_name",
+			}, "\n"),
+		},
+	}
+
+	sinks := map[string]interface{}{}
+	config["sinks"] = sinks
+
+	if len(parent.Spec.Outputs) == 0 {
+		sinks["stdout"] = map[string]interface{}{
+			"type":     "console",
+			"inputs":   []string{"normalize"},
+			"encoding": map[string]interface{}{"codec": "json"},
+		}
+	}
+
+	for i := range parent.Spec.Outputs {
+		sink, err := vectorSink(&parent.Spec.Outputs[i])
+		if err != nil {
+			return "", err
+		}
+
+		sink["inputs"] = []string{"normalize"}
+		sinks[parent.Spec.Outputs[i].Name] = sink
+	}
+
+	return marshalVectorConfig(config)
+}
+
+// vectorSink returns the settings of the vector sink for an output.
+func vectorSink(output *platformv1alpha1.LoggingComponentSpecOutput) (map[string]interface{}, error) {
+	credential := func(key string) string {
+		return fmt.Sprintf("${%s}", credentialVariable(vector, output, key))
+	}
+
+	basicAuth := func(sink map[string]interface{}) map[string]interface{} {
+		if output.CredentialsSecret != "" {
+			sink["auth"] = map[string]interface{}{
+				"strategy": "basic",
+				"user":     credential("username"),
+				"password": credential("password"),
+			}
+		}
+
+		return sink
+	}
+
+	switch output.Type {
+	case "stdout":
+		return map[string]interface{}{
+			"type":     "console",
+			"encoding": map[string]interface{}{"codec": "json"},
+		}, nil
+	case "loki":
+		if output.Endpoint == "" {
+			return nil, fmt.Errorf("%w %s", ErrMissingOutputEndpoint, output.Name)
+		}
+
+		sink := map[string]interface{}{
+			"type":     "loki",
+			"endpoint": output.Endpoint,
+			"encoding": map[string]interface{}{"codec": "json"},
+			"labels": map[string]interface{}{
+				"namespace": "{{ namespace }}",
+				"pod":       "{{ pod }}",
+				"container": "{{ container }}",
+			},
+		}
+
+		if output.TenantID != "" {
+			sink["tenant_id"] = output.TenantID
+		}
+
+		return basicAuth(sink), nil
+	case "elasticsearch", "opensearch":
+		if output.Endpoint == "" {
+			return nil, fmt.Errorf("%w %s", ErrMissingOutputEndpoint, output.Name)
+		}
+
+		return basicAuth(map[string]interface{}{
+			"type":               "elasticsearch",
+			"endpoint":           output.Endpoint,
+			"bulk":               map[string]interface{}{"index": outputIndex(output)},
+			"suppress_type_name": true,
+		}), nil
+	case "s3":
+		if output.Bucket == "" || output.Region == "" {
+			return nil, fmt.Errorf("%w %s", ErrMissingOutputBucket, output.Name)
+		}
+
+		sink := map[string]interface{}{
+			"type":        "aws_s3",
+			"bucket":      output.Bucket,
+			"region":      output.Region,
+			"compression": "gzip",
+			"encoding":    map[string]interface{}{"codec": "json"},
+		}
+
+		if output.Endpoint != "" {
+			sink["endpoint"] = output.Endpoint
+		}
+
+		if output.CredentialsSecret != "" {
+			sink["auth"] = map[string]interface{}{
+				"access_key_id":     credential("accessKeyID"),
+				"secret_access_key": credential("secretAccessKey"),
+			}
+		}
+
+		return sink, nil
+	}
+
+	return nil, fmt.Errorf("%w %s for output %s", ErrUnsupportedOutputType, output.Type, output.Name)
+}
+
+// marshalVectorConfig returns the vector configuration as yaml.  The keys are sorted when
+// marshaling, so that the configuration, and so its hash, is stable.
+func marshalVectorConfig(config map[string]interface{}) (string, error) {
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("unable to render vector configuration, %w", err)
+	}
+
+	return string(out), nil
+}
+
+// outputIndex returns the index of an elasticsearch or opensearch output.
+func outputIndex(output *platformv1alpha1.LoggingComponentSpecOutput) string {
+	if output.Index == "" {
+		return "logs"
+	}
+
+	return output.Index
+}
+
+// outputEndpoint is the endpoint of an output, as set in the fluent-bit output plugins.
+type outputEndpoint struct {
+	host string
+	port string
+	path string
+	tls  string
+}
+
+// parseEndpoint returns the endpoint of an output for the fluent-bit output plugins, which set
+// the host, port and tls of the server rather than its URL.
+func parseEndpoint(output *platformv1alpha1.LoggingComponentSpecOutput) (*outputEndpoint, error) {
+	if output.Endpoint == "" {
+		return nil, fmt.Errorf("%w %s", ErrMissingOutputEndpoint, output.Name)
+	}
+
+	endpoint, err := url.Parse(output.Endpoint)
+	if err != nil || endpoint.Hostname() == "" {
+		return nil, fmt.Errorf("unable to parse endpoint %s for output %s", output.Endpoint, output.Name)
+	}
+
+	parsed := &outputEndpoint{
+		host: endpoint.Hostname(),
+		port: endpoint.Port(),
+		path: strings.TrimSuffix(endpoint.Path, "/"),
+		tls:  "Off",
+	}
+
+	if endpoint.Scheme == "https" {
+		parsed.tls = "On"
+	}
+
+	if parsed.port == "" {
+		parsed.port = "80"
+
+		if endpoint.Scheme == "https" {
+			parsed.port = "443"
+		}
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// MutateDaemonSetNamespaceVector mutates the DaemonSet resource with name vector.
+func MutateDaemonSetNamespaceVector(
+	original client.Object,
+	parent *platformv1alpha1.LoggingComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	// resolve the outputs which write the logs to the object storage of an object storage
+	// component.
+	parent, err := objectStorageOutputs(parent, reconciler, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "vector", parent.Spec.Collector.Vector.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.  the collector runs
+	// on every node, so it is not subject to any scheduling settings.
+	if err := mutateWorkload(original, parent, collection, nil); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "vector", parent.Spec.Collector.Resources); err != nil {
+		return nil, err
+	}
+
+	// roll out the pods when the configuration changes.
+	if err := setConfigHash(original, parent, collection); err != nil {
+		return nil, err
+	}
+
+	// set the credentials of the outputs, if the workload sends the logs to the outputs.
+	if err := setOutputCredentials(original, parent, "vector"); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/objectstorage"
+)
+
+// objectStorageOutputs returns the component with the s3 outputs which reference an
+// ObjectStorageComponent set to the endpoint, the region and the bucket credentials of the
+// referenced component.  The component must declare the bucket of the output and publish its
+// credentials in the namespace of the logging component.  The references are only resolved when
+// reconciling, so the component is returned unchanged when generating manifests from the CLI.
+func objectStorageOutputs(
+	parent *platformv1alpha1.LoggingComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) (*platformv1alpha1.LoggingComponent, error) {
+	if reconciler == nil || req == nil {
+		return parent, nil
+	}
+
+	resolved := parent
+
+	for i := range parent.Spec.Outputs {
+		output := &parent.Spec.Outputs[i]
+
+		if output.Type != "s3" || output.ObjectStorage == "" {
+			continue
+		}
+
+		component, err := objectstorage.Get(reconciler, req, output.ObjectStorage, output.Bucket, parent.Spec.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		// copy the component before resolving the first reference, so that the spec of the
+		// reconciled component is left untouched.
+		if resolved == parent {
+			resolved = parent.DeepCopy()
+		}
+
+		resolved.Spec.Outputs[i].Endpoint = component.Endpoint()
+		resolved.Spec.Outputs[i].Region = platformv1alpha1.ObjectStorageRegion
+		resolved.Spec.Outputs[i].CredentialsSecret = platformv1alpha1.BucketCredentialsSecret(output.Bucket)
+	}
+
+	return resolved, nil
+}
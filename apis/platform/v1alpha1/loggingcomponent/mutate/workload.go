@@ -0,0 +1,128 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// mutateWorkload applies the settings which are common to all workloads of the component.
+// Workloads without scheduling settings keep the scheduling of their manifest.
+func mutateWorkload(
+	original client.Object,
+	parent *platformv1alpha1.LoggingComponent, collection *setupv1alpha1.SupportServices,
+	scheduling *setupv1alpha1.SchedulingSpec,
+) error {
+	if err := podtemplate.SetImageRegistry(original, collection); err != nil {
+		return err
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	if err := podtemplate.ScaleResources(original, profile.ResourcePercent); err != nil {
+		return err
+	}
+
+	if replicas, ok := parent.EffectiveReplicas(profile.TierProfileSpec)[original.GetName()]; ok {
+		if err := podtemplate.SetReplicas(original, replicas); err != nil {
+			return err
+		}
+	}
+
+	if err := setLogLevel(original, profile.LogLevel); err != nil {
+		return err
+	}
+
+	if scheduling == nil {
+		return nil
+	}
+
+	return podtemplate.SetScheduling(original, collection.Spec.Scheduling.Override(*scheduling))
+}
+
+// setLogLevel sets the log level of a workload of the component.  vector reads the log level
+// from its environment, while fluent-bit reads it from its configuration.
+func setLogLevel(original client.Object, level string) error {
+	switch original.GetName() {
+	case vector, vectorAggregator:
+		return podtemplate.SetEnv(original, vector, "VECTOR_LOG", level)
+	}
+
+	return nil
+}
+
+// setConfigHash annotates the pods of a workload of the component with the hash of its
+// configuration, so that the pods are rolled out when the outputs of the component change.
+func setConfigHash(
+	original client.Object,
+	parent *platformv1alpha1.LoggingComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	data, err := configData(parent, collection, original.GetName())
+	if err != nil {
+		return err
+	}
+
+	return podtemplate.SetConfigHash(original, data)
+}
+
+// setOutputCredentials sets the credentials of the outputs of the component in the environment of
+// the named container of a workload, if the workload sends the logs to the outputs.
+func setOutputCredentials(original client.Object, parent *platformv1alpha1.LoggingComponent, container string) error {
+	if !writesOutputs(parent, original.GetName()) {
+		return nil
+	}
+
+	credentials, err := outputCredentials(container, parent.Spec.Outputs)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		if err := podtemplate.SetEnvFromSecret(
+			original, container, credential.variable, credential.secret, credential.key, false,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileWorkload applies the settings which are common to all workloads of the component when
+// reconciling.
+func reconcileWorkload(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	if err := podtemplate.PruneNodeSelector(req.Context, reconciler, original); err != nil {
+		return err
+	}
+
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/loggingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodDisruptionBudgetNamespaceVectorAggregator creates the PodDisruptionBudget resource with name vector-aggregator.
+func CreatePodDisruptionBudgetNamespaceVectorAggregator(
+	parent *platformv1alpha1.LoggingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Aggregator.Enabled != true {
+		return []client.Object{}, nil
+	}
+
+	// only protect the workload when it runs more than one replica
+	protected, err := tier.ProtectsWorkload(collection, parent, "vector-aggregator")
+	if err != nil {
+		return nil, err
+	}
+
+	if !protected {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "policy/v1",
+			"kind":       "PodDisruptionBudget",
+			"metadata": map[string]interface{}{
+				"name":      "vector-aggregator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "logging",
+					"platform.nukleros.io/project": "vector",
+					"app.kubernetes.io/name":       "vector",
+					"app.kubernetes.io/component":  "aggregator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"maxUnavailable": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "vector",
+						"app.kubernetes.io/component": "aggregator",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodDisruptionBudgetNamespaceVectorAggregator(resourceObj, parent, collection, reconciler, req)
+}
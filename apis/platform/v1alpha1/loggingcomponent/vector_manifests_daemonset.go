@@ -0,0 +1,228 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loggingcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/loggingcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceVector creates the DaemonSet resource with name vector.
+func CreateDaemonSetNamespaceVector(
+	parent *platformv1alpha1.LoggingComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Collector.Type != "vector" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=collector.type,value="vector",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "vector",
+					"app.kubernetes.io/component":  "collector",
+					"platform.nukleros.io/group":   "logging",
+					"platform.nukleros.io/project": "vector",
+				},
+				"name":      "vector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "vector",
+						"app.kubernetes.io/component": "collector",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "10%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "vector",
+							"app.kubernetes.io/component":  "collector",
+							"platform.nukleros.io/group":   "logging",
+							"platform.nukleros.io/project": "vector",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "vector",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "vector",
+								// controlled by field: collector.vector.image
+								// controlled by field: collector.vector.version
+								//  Image repo and name to use for the vector collector.
+								//  Version of the vector collector to use.
+								"image":           "" + parent.Spec.Collector.Vector.Image + ":" + parent.Spec.Collector.Vector.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--config-dir",
+									"/etc/vector/",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "VECTOR_SELF_NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "VECTOR_SELF_POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "VECTOR_SELF_POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "VECTOR_LOG",
+										"value": "info",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "api",
+										"containerPort": 8686,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/health",
+										"port": "api",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/health",
+										"port": "api",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "50m",
+										"memory": "64Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "config",
+										"mountPath": "/etc/vector/",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "data",
+										"mountPath": "/vector-data-dir",
+									},
+									map[string]interface{}{
+										"name":      "var-log",
+										"mountPath": "/var/log/",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "var-lib",
+										"mountPath": "/var/lib/",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"priorityClassName": "system-node-critical",
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"operator": "Exists",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "config",
+								"configMap": map[string]interface{}{
+									"name": "vector",
+								},
+							},
+							map[string]interface{}{
+								"name": "data",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/vector",
+									"type": "DirectoryOrCreate",
+								},
+							},
+							map[string]interface{}{
+								"name": "var-log",
+								"hostPath": map[string]interface{}{
+									"path": "/var/log/",
+								},
+							},
+							map[string]interface{}{
+								"name": "var-lib",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceVector(resourceObj, parent, collection, reconciler, req)
+}
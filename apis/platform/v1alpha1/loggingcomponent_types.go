@@ -0,0 +1,410 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertLoggingComponent = errors.New("unable to convert to LoggingComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// LoggingComponentSpec defines the desired state of LoggingComponent.
+type LoggingComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection LoggingComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-logging-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-logging-system")
+	//
+	//	Namespace to use for logging support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Collector LoggingComponentSpecCollector `json:"collector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Aggregator LoggingComponentSpecAggregator `json:"aggregator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Outputs to send the logs to.  Logs are written to the standard output of the collector, or
+	//	of the aggregator if it is enabled, when no outputs are set.
+	Outputs []LoggingComponentSpecOutput `json:"outputs,omitempty"`
+}
+
+type LoggingComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type LoggingComponentSpecCollector struct {
+	// +kubebuilder:default="fluent-bit"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=fluent-bit;vector
+	// (Default: "fluent-bit")
+	//
+	//	Log collector which runs on every node.  One of: fluent-bit | vector.
+	Type string `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	FluentBit LoggingComponentSpecCollectorFluentBit `json:"fluentBit,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Vector LoggingComponentSpecCollectorVector `json:"vector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the collector container.  Requests and limits which are
+	//	not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type LoggingComponentSpecCollectorFluentBit struct {
+	// +kubebuilder:default="cr.fluentbit.io/fluent/fluent-bit"
+	// +kubebuilder:validation:Optional
+	// (Default: "cr.fluentbit.io/fluent/fluent-bit")
+	//
+	//	Image repo and name to use for fluent-bit.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the fluent-bit image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="1.9.7"
+	// +kubebuilder:validation:Optional
+	// (Default: "1.9.7")
+	//
+	//	Version of fluent-bit to use.
+	Version string `json:"version,omitempty"`
+}
+
+type LoggingComponentSpecCollectorVector struct {
+	// +kubebuilder:default="timberio/vector"
+	// +kubebuilder:validation:Optional
+	// (Default: "timberio/vector")
+	//
+	//	Image repo and name to use for the vector collector.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the vector collector image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="0.23.3-distroless-libc"
+	// +kubebuilder:validation:Optional
+	// (Default: "0.23.3-distroless-libc")
+	//
+	//	Version of the vector collector to use.
+	Version string `json:"version,omitempty"`
+}
+
+type LoggingComponentSpecAggregator struct {
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Whether to install a vector aggregator.  When enabled, the collectors forward the logs to
+	//	the aggregator, which sends them to the outputs, so that the credentials of the outputs
+	//	are only available to the aggregator.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="timberio/vector"
+	// +kubebuilder:validation:Optional
+	// (Default: "timberio/vector")
+	//
+	//	Image repo and name to use for the vector aggregator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the vector aggregator image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="0.23.3-distroless-libc"
+	// +kubebuilder:validation:Optional
+	// (Default: "0.23.3-distroless-libc")
+	//
+	//	Version of the vector aggregator to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the vector aggregator deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the vector aggregator deployment.  Settings which are set here
+	//	take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the vector aggregator pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the vector aggregator container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type LoggingComponentSpecOutput struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//	Name of the output, which must be unique among the outputs of the component.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=loki;elasticsearch;opensearch;s3;stdout
+	//	Type of the output.  One of: loki | elasticsearch | opensearch | s3 | stdout.
+	Type string `json:"type"`
+
+	// +kubebuilder:validation:Optional
+	//	URL of the loki, elasticsearch or opensearch server (e.g. https://loki.example.com:3100).
+	//	For s3 outputs, the URL of an s3 compatible service such as MinIO, which defaults to AWS S3.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Tenant to send the logs to, for loki servers with multi-tenancy enabled.
+	TenantID string `json:"tenantID,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Index to write the logs to, for elasticsearch and opensearch outputs.  Defaults to logs.
+	Index string `json:"index,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Bucket to write the logs to, for s3 outputs.
+	Bucket string `json:"bucket,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Region of the bucket, for s3 outputs.
+	Region string `json:"region,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of a secret in the namespace of the component which holds the credentials of the
+	//	output.  The secret holds the username and password keys for loki, elasticsearch and
+	//	opensearch outputs, and the accessKeyID and secretAccessKey keys for s3 outputs.
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of an ObjectStorageComponent whose object storage to write the logs to, for s3
+	//	outputs, in place of the endpoint, region and credentials secret.  The component must
+	//	declare the bucket and publish its credentials in the namespace of this component.  The
+	//	reference is resolved when reconciling, so manifests generated from the CLI use the
+	//	endpoint settings instead.
+	ObjectStorage string `json:"objectStorage,omitempty"`
+}
+
+type LoggingComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// LoggingComponent is the Schema for the loggingcomponents API.
+type LoggingComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LoggingComponentSpec   `json:"spec,omitempty"`
+	Status            LoggingComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoggingComponentList contains a list of LoggingComponent.
+type LoggingComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoggingComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *LoggingComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *LoggingComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *LoggingComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *LoggingComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *LoggingComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *LoggingComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *LoggingComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *LoggingComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *LoggingComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.  Logs which are written to the object
+// storage of an ObjectStorageComponent depend on the component.
+func (component *LoggingComponent) GetDependencies() []workload.Workload {
+	for i := range component.Spec.Outputs {
+		if component.Spec.Outputs[i].Type == "s3" && component.Spec.Outputs[i].ObjectStorage != "" {
+			return []workload.Workload{&ObjectStorageComponent{}}
+		}
+	}
+
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*LoggingComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("LoggingComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *LoggingComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *LoggingComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name, after defaulting from the given tier profile.  The collector runs on every
+// node, so only the aggregator is replicated.
+func (component *LoggingComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return map[string]int{
+		"vector-aggregator": profile.ReplicasFor(component.Spec.Aggregator.Replicas),
+	}
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *LoggingComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	if !component.Spec.Aggregator.Enabled {
+		return nil
+	}
+
+	return map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"vector-aggregator": component.Spec.Aggregator.PodDisruptionBudget,
+	}
+}
+
+// UsesAggregator returns whether the collector forwards logs to the aggregator, which then sends
+// them to the outputs, rather than sending them to the outputs itself.
+func (component *LoggingComponent) UsesAggregator() bool {
+	return component.Spec.Aggregator.Enabled
+}
+
+func init() {
+	SchemeBuilder.Register(&LoggingComponent{}, &LoggingComponentList{})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateAlertmanagerNamespaceAlertmanager creates the Alertmanager resource with name alertmanager.
+func CreateAlertmanagerNamespaceAlertmanager(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Alertmanager.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=alertmanager.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "Alertmanager",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "alertmanager",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "alertmanager",
+				},
+				"name":      "alertmanager",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: alertmanager.version
+				//  Version of alertmanager to use.
+				"version": parent.Spec.Alertmanager.Version,
+				// controlled by field: alertmanager.replicas
+				"replicas": parent.Spec.Alertmanager.Replicas,
+				// controlled by field: alertmanager.retention
+				//  How long alertmanager retains data, such as silences and notifications.
+				"retention": parent.Spec.Alertmanager.Retention,
+				"podMetadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"platform.nukleros.io/group":   "monitoring",
+						"platform.nukleros.io/project": "alertmanager",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "alertmanager",
+						// controlled by field: alertmanager.image
+						// controlled by field: alertmanager.version
+						//  Image repo and name to use for alertmanager.
+						//  Version of alertmanager to use.
+						"image": "" + parent.Spec.Alertmanager.Image + ":" + parent.Spec.Alertmanager.Version + "",
+						"resources": map[string]interface{}{
+							"limits": map[string]interface{}{
+								"cpu":    "100m",
+								"memory": "100Mi",
+							},
+							"requests": map[string]interface{}{
+								"cpu":    "10m",
+								"memory": "50Mi",
+							},
+						},
+					},
+				},
+				"serviceAccountName":                  "alertmanager",
+				"alertmanagerConfigSelector":          map[string]interface{}{},
+				"alertmanagerConfigNamespaceSelector": map[string]interface{}{},
+				"storage": map[string]interface{}{
+					"volumeClaimTemplate": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"accessModes": []interface{}{
+								"ReadWriteOnce",
+							},
+							"resources": map[string]interface{}{
+								"requests": map[string]interface{}{
+									// controlled by field: alertmanager.storage.size
+									//  Size of the persistent volume of each alertmanager replica.
+									"storage": parent.Spec.Alertmanager.Storage.Size,
+								},
+							},
+						},
+					},
+				},
+				"securityContext": map[string]interface{}{
+					"fsGroup":      2000,
+					"runAsNonRoot": true,
+					"runAsUser":    1000,
+				},
+				"nodeSelector": map[string]interface{}{
+					"kubernetes.io/os": "linux",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateAlertmanagerNamespaceAlertmanager(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                        = "parent.Spec.Namespace"
+	CRDAlertmanagerconfigsMonitoringCoreosCom = "alertmanagerconfigs.monitoring.coreos.com"
+	CRDAlertmanagersMonitoringCoreosCom       = "alertmanagers.monitoring.coreos.com"
+	CRDPodmonitorsMonitoringCoreosCom         = "podmonitors.monitoring.coreos.com"
+	CRDProbesMonitoringCoreosCom              = "probes.monitoring.coreos.com"
+	CRDPrometheusesMonitoringCoreosCom        = "prometheuses.monitoring.coreos.com"
+	CRDPrometheusrulesMonitoringCoreosCom     = "prometheusrules.monitoring.coreos.com"
+	CRDServicemonitorsMonitoringCoreosCom     = "servicemonitors.monitoring.coreos.com"
+	CRDThanosrulersMonitoringCoreosCom        = "thanosrulers.monitoring.coreos.com"
+	DeploymentNamespacePrometheusOperator     = "prometheus-operator"
+	ServiceAccountNamespacePrometheusOperator = "prometheus-operator"
+	ClusterRolePrometheusOperator             = "prometheus-operator"
+	ClusterRoleBindingPrometheusOperator      = "prometheus-operator"
+	ServiceNamespacePrometheusOperator        = "prometheus-operator"
+	PrometheusNamespacePrometheus             = "prometheus"
+	ServiceAccountNamespacePrometheus         = "prometheus"
+	ClusterRolePrometheus                     = "prometheus"
+	ClusterRoleBindingPrometheus              = "prometheus"
+	ServiceNamespacePrometheus                = "prometheus"
+	AlertmanagerNamespaceAlertmanager         = "alertmanager"
+	ServiceAccountNamespaceAlertmanager       = "alertmanager"
+	ServiceNamespaceAlertmanager              = "alertmanager"
+	DeploymentNamespaceKubeStateMetrics       = "kube-state-metrics"
+	ServiceAccountNamespaceKubeStateMetrics   = "kube-state-metrics"
+	ClusterRoleKubeStateMetrics               = "kube-state-metrics"
+	ClusterRoleBindingKubeStateMetrics        = "kube-state-metrics"
+	ServiceNamespaceKubeStateMetrics          = "kube-state-metrics"
+	ServiceMonitorNamespaceKubeStateMetrics   = "kube-state-metrics"
+	DaemonSetNamespaceNodeExporter            = "node-exporter"
+	ServiceAccountNamespaceNodeExporter       = "node-exporter"
+	ServiceNamespaceNodeExporter              = "node-exporter"
+	ServiceMonitorNamespaceNodeExporter       = "node-exporter"
+	PodDisruptionBudgetNamespacePrometheus    = "prometheus"
+	PodDisruptionBudgetNamespaceAlertmanager  = "alertmanager"
+)
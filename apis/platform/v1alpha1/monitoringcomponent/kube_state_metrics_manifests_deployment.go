@@ -0,0 +1,142 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceKubeStateMetrics creates the Deployment resource with name kube-state-metrics.
+func CreateDeploymentNamespaceKubeStateMetrics(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.KubeStateMetrics.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=kubeStateMetrics.enabled,value=true,include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kube-state-metrics",
+					"app.kubernetes.io/component":  "exporter",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "kube-state-metrics",
+				},
+				"name":      "kube-state-metrics",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "kube-state-metrics",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "kube-state-metrics",
+							"app.kubernetes.io/component":  "exporter",
+							"platform.nukleros.io/group":   "monitoring",
+							"platform.nukleros.io/project": "kube-state-metrics",
+						},
+					},
+					"spec": map[string]interface{}{
+						"automountServiceAccountToken": true,
+						"containers": []interface{}{
+							map[string]interface{}{
+								// controlled by field: kubeStateMetrics.image
+								// controlled by field: kubeStateMetrics.version
+								//  Image repo and name to use for kube-state-metrics.
+								//  Version of kube-state-metrics to use.
+								"image": "" + parent.Spec.KubeStateMetrics.Image + ":" + parent.Spec.KubeStateMetrics.Version + "",
+								"name":  "kube-state-metrics",
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": 8080,
+									},
+									"initialDelaySeconds": 5,
+									"timeoutSeconds":      5,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/",
+										"port": 8081,
+									},
+									"initialDelaySeconds": 5,
+									"timeoutSeconds":      5,
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 8080,
+										"name":          "http-metrics",
+									},
+									map[string]interface{}{
+										"containerPort": 8081,
+										"name":          "telemetry",
+									},
+								},
+								"resources": map[string]interface{}{
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "250Mi",
+									},
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "190Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"readOnlyRootFilesystem": true,
+									"runAsUser":              65534,
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"serviceAccountName": "kube-state-metrics",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceKubeStateMetrics(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceMonitorNamespaceKubeStateMetrics creates the ServiceMonitor resource with name kube-state-metrics.
+func CreateServiceMonitorNamespaceKubeStateMetrics(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.KubeStateMetrics.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=kubeStateMetrics.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "ServiceMonitor",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kube-state-metrics",
+					"app.kubernetes.io/component":  "exporter",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "kube-state-metrics",
+				},
+				"name":      "kube-state-metrics",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"jobLabel": "app.kubernetes.io/name",
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "kube-state-metrics",
+					},
+				},
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"port":          "http-metrics",
+						"interval":      "30s",
+						"scrapeTimeout": "30s",
+						"honorLabels":   true,
+					},
+					map[string]interface{}{
+						"port":     "telemetry",
+						"interval": "30s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceMonitorNamespaceKubeStateMetrics(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceKubeStateMetrics creates the Service resource with name kube-state-metrics.
+func CreateServiceNamespaceKubeStateMetrics(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.KubeStateMetrics.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=kubeStateMetrics.enabled,value=true,include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kube-state-metrics",
+					"app.kubernetes.io/component":  "exporter",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "kube-state-metrics",
+				},
+				"name":      "kube-state-metrics",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"clusterIP": "None",
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "http-metrics",
+						"port":       8080,
+						"targetPort": "http-metrics",
+					},
+					map[string]interface{}{
+						"name":       "telemetry",
+						"port":       8081,
+						"targetPort": "telemetry",
+					},
+				},
+				"selector": map[string]interface{}{
+					"app.kubernetes.io/name": "kube-state-metrics",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceKubeStateMetrics(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// MutatePrometheusNamespacePrometheus mutates the Prometheus resource with name prometheus.
+func MutatePrometheusNamespacePrometheus(
+	original client.Object,
+	parent *platformv1alpha1.MonitoringComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	prometheus := parent.Spec.Prometheus
+
+	// prometheus settings are derived solely from the parent spec, so they are applied
+	// regardless of whether we are reconciling or generating manifests from the CLI.
+	if err := setPrometheusSettings(original, parent); err != nil {
+		return nil, err
+	}
+
+	if err := setStorage(original, prometheus.Storage.Enabled, prometheus.Storage.StorageClassName); err != nil {
+		return nil, err
+	}
+
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "prometheus", prometheus.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, &prometheus.Scheduling); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "prometheus", prometheus.Resources); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	// mutation logic goes here
+
+	return []client.Object{original}, nil
+}
+
+// setPrometheusSettings sets the optional retention size of prometheus and removes the
+// alerting settings when alertmanager is not installed.
+func setPrometheusSettings(original client.Object, parent *platformv1alpha1.MonitoringComponent) error {
+	prometheus, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, ok := prometheus.Object["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing spec for prometheus %s", original.GetName())
+	}
+
+	if parent.Spec.Prometheus.RetentionSize != "" {
+		spec["retentionSize"] = parent.Spec.Prometheus.RetentionSize
+	}
+
+	if !parent.Spec.Alertmanager.Enabled {
+		delete(spec, "alerting")
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// setStorage sets the storage class of the volume claim template of a prometheus or alertmanager
+// custom resource, or removes the template when persistent storage is disabled, in which case
+// prometheus-operator falls back to an empty dir volume.
+func setStorage(original client.Object, enabled bool, storageClassName string) error {
+	server, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, ok := server.Object["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing spec for %s %s", server.GetKind(), original.GetName())
+	}
+
+	if !enabled {
+		delete(spec, "storage")
+
+		return nil
+	}
+
+	if storageClassName == "" {
+		return nil
+	}
+
+	claimSpec, found, err := unstructured.NestedFieldNoCopy(spec, "storage", "volumeClaimTemplate", "spec")
+	if err != nil {
+		return fmt.Errorf("unable to get volume claim template for %s %s, %w", server.GetKind(), original.GetName(), err)
+	}
+
+	claim, ok := claimSpec.(map[string]interface{})
+	if !found || !ok {
+		return fmt.Errorf("missing volume claim template for %s %s", server.GetKind(), original.GetName())
+	}
+
+	claim["storageClassName"] = storageClassName
+
+	return nil
+}
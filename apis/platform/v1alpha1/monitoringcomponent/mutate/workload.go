@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// mutateWorkload applies the settings which are common to all workloads of the component.  The
+// prometheus and alertmanager custom resources are treated as workloads, as prometheus-operator
+// runs their pods from the pod settings in their spec.  Workloads without scheduling settings
+// keep the scheduling of their manifest.
+func mutateWorkload(
+	original client.Object,
+	parent *platformv1alpha1.MonitoringComponent, collection *setupv1alpha1.SupportServices,
+	scheduling *setupv1alpha1.SchedulingSpec,
+) error {
+	if err := podtemplate.SetImageRegistry(original, collection); err != nil {
+		return err
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	if err := podtemplate.ScaleResources(original, profile.ResourcePercent); err != nil {
+		return err
+	}
+
+	if replicas, ok := parent.EffectiveReplicas(profile.TierProfileSpec)[original.GetName()]; ok {
+		if err := podtemplate.SetReplicas(original, replicas); err != nil {
+			return err
+		}
+	}
+
+	if err := setLogLevel(original, profile.LogLevel); err != nil {
+		return err
+	}
+
+	if scheduling == nil {
+		return nil
+	}
+
+	return podtemplate.SetScheduling(original, collection.Spec.Scheduling.Override(*scheduling))
+}
+
+// logLevelFlags are the containers and flags which set the log level of the workloads of the
+// component, keyed by the workload name.
+var logLevelFlags = map[string]struct {
+	container string
+	flag      string
+}{
+	"prometheus-operator": {container: "prometheus-operator", flag: "--log-level"},
+	"node-exporter":       {container: "node-exporter", flag: "--log.level"},
+}
+
+// setLogLevel sets the log level of a workload of the component.  The custom resources of
+// prometheus-operator set the log level in their spec, whose values match those of the tier.
+func setLogLevel(original client.Object, level string) error {
+	switch original.GetObjectKind().GroupVersionKind().Kind {
+	case "Prometheus", "Alertmanager":
+		server, ok := original.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+		}
+
+		return unstructured.SetNestedField(server.Object, level, "spec", "logLevel")
+	}
+
+	logLevel, ok := logLevelFlags[original.GetName()]
+	if !ok {
+		return nil
+	}
+
+	return podtemplate.SetArg(original, logLevel.container, logLevel.flag, level)
+}
+
+// reconcileWorkload applies the settings which are common to all workloads of the component when
+// reconciling.
+func reconcileWorkload(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	if err := podtemplate.PruneNodeSelector(req.Context, reconciler, original); err != nil {
+		return err
+	}
+
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceNodeExporter creates the DaemonSet resource with name node-exporter.
+func CreateDaemonSetNamespaceNodeExporter(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.NodeExporter.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=nodeExporter.enabled,value=true,include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "node-exporter",
+					"app.kubernetes.io/component":  "exporter",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "node-exporter",
+				},
+				"name":      "node-exporter",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "node-exporter",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "10%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"kubectl.kubernetes.io/default-container": "node-exporter",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "node-exporter",
+							"app.kubernetes.io/component":  "exporter",
+							"platform.nukleros.io/group":   "monitoring",
+							"platform.nukleros.io/project": "node-exporter",
+						},
+					},
+					"spec": map[string]interface{}{
+						"automountServiceAccountToken": false,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"args": []interface{}{
+									"--web.listen-address=:9100",
+									"--path.sysfs=/host/sys",
+									"--path.rootfs=/host/root",
+									"--no-collector.wifi",
+									"--no-collector.hwmon",
+									"--collector.filesystem.mount-points-exclude=^/(dev|proc|sys|run/k3s/containerd/.+|var/lib/docker/.+|var/lib/kubelet/pods/.+)($|/)",
+									"--collector.netclass.ignored-devices=^(veth.*|[a-f0-9]{15})$",
+									"--collector.netdev.device-exclude=^(veth.*|[a-f0-9]{15})$",
+								},
+								// controlled by field: nodeExporter.image
+								// controlled by field: nodeExporter.version
+								//  Image repo and name to use for node-exporter.
+								//  Version of node-exporter to use.
+								"image": "" + parent.Spec.NodeExporter.Image + ":" + parent.Spec.NodeExporter.Version + "",
+								"name":  "node-exporter",
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 9100,
+										"hostPort":      9100,
+										"name":          "metrics",
+									},
+								},
+								"resources": map[string]interface{}{
+									"limits": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "180Mi",
+									},
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "100Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"add": []interface{}{
+											"SYS_TIME",
+										},
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"readOnlyRootFilesystem": true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath":        "/host/sys",
+										"mountPropagation": "HostToContainer",
+										"name":             "sys",
+										"readOnly":         true,
+									},
+									map[string]interface{}{
+										"mountPath":        "/host/root",
+										"mountPropagation": "HostToContainer",
+										"name":             "root",
+										"readOnly":         true,
+									},
+								},
+							},
+						},
+						"hostNetwork": true,
+						"hostPID":     true,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"priorityClassName": "system-node-critical",
+						"securityContext": map[string]interface{}{
+							"runAsNonRoot": true,
+							"runAsUser":    65534,
+						},
+						"serviceAccountName": "node-exporter",
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"operator": "Exists",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"hostPath": map[string]interface{}{
+									"path": "/sys",
+								},
+								"name": "sys",
+							},
+							map[string]interface{}{
+								"hostPath": map[string]interface{}{
+									"path": "/",
+								},
+								"name": "root",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceNodeExporter(resourceObj, parent, collection, reconciler, req)
+}
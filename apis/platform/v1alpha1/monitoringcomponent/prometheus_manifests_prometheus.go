@@ -0,0 +1,135 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheuses,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusNamespacePrometheus creates the Prometheus resource with name prometheus.
+func CreatePrometheusNamespacePrometheus(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "Prometheus",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "prometheus",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus",
+				},
+				"name":      "prometheus",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: prometheus.version
+				//  Version of prometheus to use.
+				"version": parent.Spec.Prometheus.Version,
+				// controlled by field: prometheus.replicas
+				"replicas": parent.Spec.Prometheus.Replicas,
+				// controlled by field: prometheus.retention
+				//  How long prometheus retains metrics.
+				"retention": parent.Spec.Prometheus.Retention,
+				"podMetadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"platform.nukleros.io/group":   "monitoring",
+						"platform.nukleros.io/project": "prometheus",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "prometheus",
+						// controlled by field: prometheus.image
+						// controlled by field: prometheus.version
+						//  Image repo and name to use for prometheus.
+						//  Version of prometheus to use.
+						"image": "" + parent.Spec.Prometheus.Image + ":" + parent.Spec.Prometheus.Version + "",
+						"resources": map[string]interface{}{
+							"limits": map[string]interface{}{
+								"cpu":    "1",
+								"memory": "2Gi",
+							},
+							"requests": map[string]interface{}{
+								"cpu":    "200m",
+								"memory": "1Gi",
+							},
+						},
+					},
+				},
+				"serviceAccountName":              "prometheus",
+				"serviceMonitorSelector":          map[string]interface{}{},
+				"serviceMonitorNamespaceSelector": map[string]interface{}{},
+				"podMonitorSelector":              map[string]interface{}{},
+				"podMonitorNamespaceSelector":     map[string]interface{}{},
+				"probeSelector":                   map[string]interface{}{},
+				"probeNamespaceSelector":          map[string]interface{}{},
+				"ruleSelector":                    map[string]interface{}{},
+				"ruleNamespaceSelector":           map[string]interface{}{},
+				"alerting": map[string]interface{}{
+					"alertmanagers": []interface{}{
+						map[string]interface{}{
+							"apiVersion": "v2",
+							"name":       "alertmanager",
+							"namespace":  parent.Spec.Namespace, //  controlled by field: namespace
+							"port":       "web",
+						},
+					},
+				},
+				"storage": map[string]interface{}{
+					"volumeClaimTemplate": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"accessModes": []interface{}{
+								"ReadWriteOnce",
+							},
+							"resources": map[string]interface{}{
+								"requests": map[string]interface{}{
+									// controlled by field: prometheus.storage.size
+									//  Size of the persistent volume of each prometheus replica.
+									"storage": parent.Spec.Prometheus.Storage.Size,
+								},
+							},
+						},
+					},
+				},
+				"securityContext": map[string]interface{}{
+					"fsGroup":      2000,
+					"runAsNonRoot": true,
+					"runAsUser":    1000,
+				},
+				"nodeSelector": map[string]interface{}{
+					"kubernetes.io/os": "linux",
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusNamespacePrometheus(resourceObj, parent, collection, reconciler, req)
+}
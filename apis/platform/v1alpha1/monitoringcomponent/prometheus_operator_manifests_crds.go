@@ -0,0 +1,652 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// The schemas of the prometheus-operator CRDs are structural only, leaving the validation of the
+// specs to prometheus-operator.
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDAlertmanagerconfigsMonitoringCoreosCom creates the CustomResourceDefinition resource with name alertmanagerconfigs.monitoring.coreos.com.
+func CreateCRDAlertmanagerconfigsMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "alertmanagerconfigs.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "AlertmanagerConfig",
+					"listKind": "AlertmanagerConfigList",
+					"plural":   "alertmanagerconfigs",
+					"shortNames": []interface{}{
+						"amcfg",
+					},
+					"singular": "alertmanagerconfig",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDAlertmanagerconfigsMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDAlertmanagersMonitoringCoreosCom creates the CustomResourceDefinition resource with name alertmanagers.monitoring.coreos.com.
+func CreateCRDAlertmanagersMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "alertmanagers.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "Alertmanager",
+					"listKind": "AlertmanagerList",
+					"plural":   "alertmanagers",
+					"shortNames": []interface{}{
+						"am",
+					},
+					"singular": "alertmanager",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDAlertmanagersMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPodmonitorsMonitoringCoreosCom creates the CustomResourceDefinition resource with name podmonitors.monitoring.coreos.com.
+func CreateCRDPodmonitorsMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "podmonitors.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "PodMonitor",
+					"listKind": "PodMonitorList",
+					"plural":   "podmonitors",
+					"shortNames": []interface{}{
+						"pmon",
+					},
+					"singular": "podmonitor",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPodmonitorsMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDProbesMonitoringCoreosCom creates the CustomResourceDefinition resource with name probes.monitoring.coreos.com.
+func CreateCRDProbesMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "probes.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "Probe",
+					"listKind": "ProbeList",
+					"plural":   "probes",
+					"shortNames": []interface{}{
+						"prb",
+					},
+					"singular": "probe",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDProbesMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPrometheusesMonitoringCoreosCom creates the CustomResourceDefinition resource with name prometheuses.monitoring.coreos.com.
+func CreateCRDPrometheusesMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "prometheuses.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "Prometheus",
+					"listKind": "PrometheusList",
+					"plural":   "prometheuses",
+					"shortNames": []interface{}{
+						"prom",
+					},
+					"singular": "prometheus",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPrometheusesMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPrometheusrulesMonitoringCoreosCom creates the CustomResourceDefinition resource with name prometheusrules.monitoring.coreos.com.
+func CreateCRDPrometheusrulesMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "prometheusrules.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "PrometheusRule",
+					"listKind": "PrometheusRuleList",
+					"plural":   "prometheusrules",
+					"shortNames": []interface{}{
+						"promrule",
+					},
+					"singular": "prometheusrule",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPrometheusrulesMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDServicemonitorsMonitoringCoreosCom creates the CustomResourceDefinition resource with name servicemonitors.monitoring.coreos.com.
+func CreateCRDServicemonitorsMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "servicemonitors.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "ServiceMonitor",
+					"listKind": "ServiceMonitorList",
+					"plural":   "servicemonitors",
+					"shortNames": []interface{}{
+						"smon",
+					},
+					"singular": "servicemonitor",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDServicemonitorsMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDThanosrulersMonitoringCoreosCom creates the CustomResourceDefinition resource with name thanosrulers.monitoring.coreos.com.
+func CreateCRDThanosrulersMonitoringCoreosCom(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"operator.prometheus.io/version": "0.58.0",
+				},
+				"name": "thanosrulers.monitoring.coreos.com",
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+			},
+			"spec": map[string]interface{}{
+				"group": "monitoring.coreos.com",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"prometheus-operator",
+					},
+					"kind":     "ThanosRuler",
+					"listKind": "ThanosRulerList",
+					"plural":   "thanosrulers",
+					"shortNames": []interface{}{
+						"ruler",
+					},
+					"singular": "thanosruler",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDThanosrulersMonitoringCoreosCom(resourceObj, parent, collection, reconciler, req)
+}
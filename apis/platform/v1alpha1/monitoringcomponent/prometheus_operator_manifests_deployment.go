@@ -0,0 +1,130 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespacePrometheusOperator creates the Deployment resource with name prometheus-operator.
+func CreateDeploymentNamespacePrometheusOperator(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "prometheus-operator",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+				"name":      "prometheus-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "prometheus-operator",
+						"app.kubernetes.io/component": "controller",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"kubectl.kubernetes.io/default-container": "prometheus-operator",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "prometheus-operator",
+							"app.kubernetes.io/component":  "controller",
+							"platform.nukleros.io/group":   "monitoring",
+							"platform.nukleros.io/project": "prometheus-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"automountServiceAccountToken": true,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"args": []interface{}{
+									"--kubelet-service=kube-system/kubelet",
+									// controlled by field: prometheusOperator.version
+									"--prometheus-config-reloader=quay.io/prometheus-operator/prometheus-config-reloader:" + parent.Spec.PrometheusOperator.Version + "",
+								},
+								// controlled by field: prometheusOperator.image
+								// controlled by field: prometheusOperator.version
+								//  Image repo and name to use for prometheus-operator.
+								//  Version of prometheus-operator to use.
+								"image": "" + parent.Spec.PrometheusOperator.Image + ":" + parent.Spec.PrometheusOperator.Version + "",
+								"name":  "prometheus-operator",
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 8080,
+										"name":          "http",
+									},
+								},
+								"resources": map[string]interface{}{
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "200Mi",
+									},
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "100Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"readOnlyRootFilesystem": true,
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"securityContext": map[string]interface{}{
+							"runAsNonRoot": true,
+							"runAsUser":    65534,
+						},
+						"serviceAccountName": "prometheus-operator",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespacePrometheusOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoringcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/monitoringcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespacePrometheusOperator creates the ServiceAccount resource with name prometheus-operator.
+func CreateServiceAccountNamespacePrometheusOperator(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "prometheus-operator",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+				"name":      "prometheus-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespacePrometheusOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagers,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagers/finalizers,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagerconfigs,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheuses,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheuses/finalizers,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=thanosrulers,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=thanosrulers/finalizers,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=probes,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=*
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=*
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=*
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=*
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=list;delete
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=services/finalizers,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+
+// CreateClusterRolePrometheusOperator creates the ClusterRole resource with name prometheus-operator.
+func CreateClusterRolePrometheusOperator(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "prometheus-operator",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+				"name": "prometheus-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"monitoring.coreos.com",
+					},
+					"resources": []interface{}{
+						"alertmanagers",
+						"alertmanagers/finalizers",
+						"alertmanagerconfigs",
+						"prometheuses",
+						"prometheuses/finalizers",
+						"thanosrulers",
+						"thanosrulers/finalizers",
+						"servicemonitors",
+						"podmonitors",
+						"probes",
+						"prometheusrules",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"statefulsets",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"configmaps",
+						"secrets",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+					},
+					"verbs": []interface{}{
+						"list",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"services",
+						"services/finalizers",
+						"endpoints",
+					},
+					"verbs": []interface{}{
+						"get",
+						"create",
+						"update",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"namespaces",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"networking.k8s.io",
+					},
+					"resources": []interface{}{
+						"ingresses",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRolePrometheusOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingPrometheusOperator creates the ClusterRoleBinding resource with name prometheus-operator.
+func CreateClusterRoleBindingPrometheusOperator(
+	parent *platformv1alpha1.MonitoringComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "prometheus-operator",
+					"platform.nukleros.io/group":   "monitoring",
+					"platform.nukleros.io/project": "prometheus-operator",
+				},
+				"name": "prometheus-operator",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "prometheus-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "prometheus-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingPrometheusOperator(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,517 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertMonitoringComponent = errors.New("unable to convert to MonitoringComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// MonitoringComponentSpec defines the desired state of MonitoringComponent.
+type MonitoringComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection MonitoringComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-monitoring-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-monitoring-system")
+	//
+	//	Namespace to use for monitoring support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PrometheusOperator MonitoringComponentSpecPrometheusOperator `json:"prometheusOperator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Prometheus MonitoringComponentSpecPrometheus `json:"prometheus,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Alertmanager MonitoringComponentSpecAlertmanager `json:"alertmanager,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	KubeStateMetrics MonitoringComponentSpecKubeStateMetrics `json:"kubeStateMetrics,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NodeExporter MonitoringComponentSpecNodeExporter `json:"nodeExporter,omitempty"`
+}
+
+type MonitoringComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type MonitoringComponentSpecPrometheusOperator struct {
+	// +kubebuilder:default="quay.io/prometheus-operator/prometheus-operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/prometheus-operator/prometheus-operator")
+	//
+	//	Image repo and name to use for prometheus-operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the prometheus-operator image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v0.58.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v0.58.0")
+	//
+	//	Version of prometheus-operator to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the prometheus-operator pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the prometheus-operator container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type MonitoringComponentSpecPrometheus struct {
+	// +kubebuilder:default="quay.io/prometheus/prometheus"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/prometheus/prometheus")
+	//
+	//	Image repo and name to use for prometheus.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the prometheus image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v2.37.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v2.37.0")
+	//
+	//	Version of prometheus to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of prometheus replicas.  Defaults to the number of replicas of the tier of the
+	//	collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:default="10d"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	// (Default: "10d")
+	//
+	//	How long prometheus retains metrics.
+	Retention string `json:"retention,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(0|([0-9]*[.])?[0-9]+((K|M|G|T|E|P)i?)?B)$`
+	//	Maximum number of bytes of metrics which prometheus retains (e.g. 15GB), in addition to
+	//	the retention period.  Unlimited when unset.
+	RetentionSize string `json:"retentionSize,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Storage MonitoringComponentSpecPrometheusStorage `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the prometheus pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the prometheus pods.  Settings which are set here take precedence
+	//	over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the prometheus container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type MonitoringComponentSpecPrometheusStorage struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to store metrics on persistent volumes.  When disabled, metrics are stored on
+	//	empty dir volumes and are lost when the prometheus pods are restarted.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="20Gi"
+	// +kubebuilder:validation:Optional
+	// (Default: "20Gi")
+	//
+	//	Size of the persistent volume of each prometheus replica.
+	Size string `json:"size,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Storage class of the persistent volumes.  Uses the default storage class of the cluster
+	//	when unset.
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+type MonitoringComponentSpecAlertmanager struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install alertmanager.  When disabled, prometheus evaluates alerting rules
+	//	without sending the resulting alerts anywhere.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="quay.io/prometheus/alertmanager"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/prometheus/alertmanager")
+	//
+	//	Image repo and name to use for alertmanager.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the alertmanager image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v0.24.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v0.24.0")
+	//
+	//	Version of alertmanager to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of alertmanager replicas.  Defaults to the number of replicas of the tier of the
+	//	collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:default="120h"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`
+	// (Default: "120h")
+	//
+	//	How long alertmanager retains data, such as silences and notifications.
+	Retention string `json:"retention,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Storage MonitoringComponentSpecAlertmanagerStorage `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the alertmanager pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the alertmanager pods.  Settings which are set here take precedence
+	//	over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the alertmanager container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type MonitoringComponentSpecAlertmanagerStorage struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to store alertmanager data on persistent volumes.  When disabled, silences are
+	//	lost when the alertmanager pods are restarted.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="2Gi"
+	// +kubebuilder:validation:Optional
+	// (Default: "2Gi")
+	//
+	//	Size of the persistent volume of each alertmanager replica.
+	Size string `json:"size,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Storage class of the persistent volumes.  Uses the default storage class of the cluster
+	//	when unset.
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+type MonitoringComponentSpecKubeStateMetrics struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install kube-state-metrics.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="registry.k8s.io/kube-state-metrics/kube-state-metrics"
+	// +kubebuilder:validation:Optional
+	// (Default: "registry.k8s.io/kube-state-metrics/kube-state-metrics")
+	//
+	//	Image repo and name to use for kube-state-metrics.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the kube-state-metrics image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v2.5.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v2.5.0")
+	//
+	//	Version of kube-state-metrics to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the kube-state-metrics pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the kube-state-metrics container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type MonitoringComponentSpecNodeExporter struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install node-exporter on every node of the cluster.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="quay.io/prometheus/node-exporter"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/prometheus/node-exporter")
+	//
+	//	Image repo and name to use for node-exporter.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the node-exporter image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.3.1"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.3.1")
+	//
+	//	Version of node-exporter to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the node-exporter container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// MonitoringComponentStatus defines the observed state of MonitoringComponent.
+type MonitoringComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// MonitoringComponent is the Schema for the secretscomponents API.
+type MonitoringComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MonitoringComponentSpec   `json:"spec,omitempty"`
+	Status            MonitoringComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MonitoringComponentList contains a list of MonitoringComponent.
+type MonitoringComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MonitoringComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *MonitoringComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *MonitoringComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *MonitoringComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *MonitoringComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *MonitoringComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *MonitoringComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *MonitoringComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *MonitoringComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *MonitoringComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*MonitoringComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*MonitoringComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("MonitoringComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *MonitoringComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *MonitoringComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name, after defaulting from the given tier profile.  Prometheus and alertmanager
+// are keyed by the name of their custom resource, whose replicas are run by prometheus-operator.
+func (component *MonitoringComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return map[string]int{
+		"prometheus":   profile.ReplicasFor(component.Spec.Prometheus.Replicas),
+		"alertmanager": profile.ReplicasFor(component.Spec.Alertmanager.Replicas),
+
+		// prometheus-operator does not support leader election and kube-state-metrics would
+		// duplicate its metrics, so both run as a single replica
+		"prometheus-operator": 1,
+		"kube-state-metrics":  1,
+	}
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *MonitoringComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"prometheus": component.Spec.Prometheus.PodDisruptionBudget,
+	}
+
+	if component.Spec.Alertmanager.Enabled {
+		budgets["alertmanager"] = component.Spec.Alertmanager.PodDisruptionBudget
+	}
+
+	return budgets
+}
+
+func init() {
+	SchemeBuilder.Register(&MonitoringComponent{}, &MonitoringComponentList{})
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/constants"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateSecretNamespaceBucketCredentials creates the Secret resources which hold the credentials
+// of the user of each bucket of the component.
+func CreateSecretNamespaceBucketCredentials(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	resourceObjs := []client.Object{}
+
+	for i := range parent.Spec.Buckets {
+		bucket := &parent.Spec.Buckets[i]
+
+		var resourceObj = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"platform.nukleros.io/group":   "object-storage",
+						"platform.nukleros.io/project": "minio",
+					},
+					"annotations": map[string]interface{}{
+						"reloader.stakater.com/match": "true",
+					},
+					"name":      platformv1alpha1.BucketCredentialsSecret(bucket.Name),
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+				"type": "Opaque",
+			},
+		}
+
+		mutated, err := mutate.MutateSecretNamespaceBucketCredentials(resourceObj, bucket, parent, collection, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, mutated...)
+	}
+
+	return resourceObjs, nil
+}
+
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// CreateJobNamespaceMinioBuckets creates the Job resource which creates the buckets of the
+// component and their users, once minio is up.
+func CreateJobNamespaceMinioBuckets(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if len(parent.Spec.Buckets) == 0 {
+		return []client.Object{}, nil
+	}
+
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio",
+					"app.kubernetes.io/name":       "minio-buckets",
+				},
+				"name":      "minio-buckets",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"backoffLimit": 10,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"platform.nukleros.io/group":   "object-storage",
+							"platform.nukleros.io/project": "minio",
+							"app.kubernetes.io/name":       "minio-buckets",
+						},
+					},
+					"spec": map[string]interface{}{
+						"restartPolicy": "OnFailure",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "mc",
+								// controlled by field: minioClient.image
+								// controlled by field: minioClient.version
+								//  Image repo and name to use for the minio client, which creates the buckets and their users.
+								//  Version of the minio client to use.
+								"image":           "" + parent.Spec.MinIOClient.Image + ":" + parent.Spec.MinIOClient.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/bin/sh",
+									"-c",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "MC_CONFIG_DIR",
+										"value": "/tmp/.mc",
+									},
+									map[string]interface{}{
+										"name":  "MINIO_ENDPOINT",
+										"value": parent.Endpoint(),
+									},
+									map[string]interface{}{
+										"name": "MINIO_ROOT_USER",
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": constants.SecretNamespaceMinioRootCredentials,
+												"key":  "MINIO_ROOT_USER",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "MINIO_ROOT_PASSWORD",
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": constants.SecretNamespaceMinioRootCredentials,
+												"key":  "MINIO_ROOT_PASSWORD",
+											},
+										},
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "50m",
+										"memory": "64Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsNonRoot":             true,
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "tmp",
+										"mountPath": "/tmp",
+									},
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"runAsUser":  1000,
+							"runAsGroup": 1000,
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "tmp",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateJobNamespaceMinioBuckets(resourceObj, parent, collection, reconciler, req)
+}
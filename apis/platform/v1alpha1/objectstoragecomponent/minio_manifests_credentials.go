@@ -0,0 +1,61 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateSecretNamespaceMinioRootCredentials creates the Secret resource with name minio-root-credentials.
+func CreateSecretNamespaceMinioRootCredentials(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio",
+				},
+				"name":      "minio-root-credentials",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"type": "Opaque",
+			"stringData": map[string]interface{}{
+				"MINIO_ROOT_USER":     "",
+				"MINIO_ROOT_PASSWORD": "",
+				"config.env":          "",
+			},
+		},
+	}
+
+	return mutate.MutateSecretNamespaceMinioRootCredentials(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceMinio creates the Service resource with name minio.
+func CreateServiceNamespaceMinio(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "standalone" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="standalone",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio",
+					"app.kubernetes.io/name":       "minio",
+				},
+				"name":      "minio",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "api",
+						"port":       9000,
+						"protocol":   "TCP",
+						"targetPort": "api",
+					},
+					map[string]interface{}{
+						"name":       "console",
+						"port":       9001,
+						"protocol":   "TCP",
+						"targetPort": "console",
+					},
+				},
+				"selector": map[string]interface{}{
+					"app.kubernetes.io/name": "minio",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceMinio(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateStatefulSetNamespaceMinio creates the StatefulSet resource with name minio.
+func CreateStatefulSetNamespaceMinio(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "standalone" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="standalone",include
+			"apiVersion": "apps/v1",
+			"kind":       "StatefulSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio",
+					"app.kubernetes.io/name":       "minio",
+				},
+				"name":      "minio",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas":    1,
+				"serviceName": "minio",
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "minio",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"platform.nukleros.io/group":   "object-storage",
+							"platform.nukleros.io/project": "minio",
+							"app.kubernetes.io/name":       "minio",
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "minio",
+								// controlled by field: minio.image
+								// controlled by field: minio.version
+								//  Image repo and name to use for minio.
+								//  Version of minio to use.
+								"image":           "" + parent.Spec.MinIO.Image + ":" + parent.Spec.MinIO.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"server",
+									"/data",
+									"--address",
+									":9000",
+									"--console-address",
+									":9001",
+									"--certs-dir",
+									"/tmp/certs",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "MINIO_ROOT_USER",
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": "minio-root-credentials",
+												"key":  "MINIO_ROOT_USER",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "MINIO_ROOT_PASSWORD",
+										"valueFrom": map[string]interface{}{
+											"secretKeyRef": map[string]interface{}{
+												"name": "minio-root-credentials",
+												"key":  "MINIO_ROOT_PASSWORD",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "api",
+										"containerPort": 9000,
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"name":          "console",
+										"containerPort": 9001,
+										"protocol":      "TCP",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/minio/health/ready",
+										"port": "api",
+									},
+									"periodSeconds": 10,
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/minio/health/live",
+										"port": "api",
+									},
+									"initialDelaySeconds": 10,
+									"periodSeconds":       30,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "512Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "1",
+										"memory": "2Gi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsNonRoot":             true,
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "data",
+										"mountPath": "/data",
+									},
+									map[string]interface{}{
+										"name":      "tmp",
+										"mountPath": "/tmp",
+									},
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"runAsUser":  1000,
+							"runAsGroup": 1000,
+							"fsGroup":    1000,
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "tmp",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+				"volumeClaimTemplates": []interface{}{
+					map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "data",
+						},
+						"spec": map[string]interface{}{
+							"accessModes": []interface{}{
+								"ReadWriteOnce",
+							},
+							"resources": map[string]interface{}{
+								"requests": map[string]interface{}{
+									// controlled by field: minio.storage.size
+									//  Size of each volume of the minio servers.
+									"storage": parent.Spec.MinIO.Storage.Size,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateStatefulSetNamespaceMinio(resourceObj, parent, collection, reconciler, req)
+}
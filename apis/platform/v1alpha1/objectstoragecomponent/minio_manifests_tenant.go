@@ -0,0 +1,124 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=minio.min.io,resources=tenants,verbs=get;list;watch;create;update;patch;delete
+
+// CreateTenantNamespaceMinio creates the Tenant resource with name minio.
+func CreateTenantNamespaceMinio(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "minio.min.io/v2",
+			"kind":       "Tenant",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio",
+				},
+				"name":      "minio",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: minio.image
+				// controlled by field: minio.version
+				"image":           "" + parent.Spec.MinIO.Image + ":" + parent.Spec.MinIO.Version + "",
+				"imagePullPolicy": "IfNotPresent",
+				"configuration": map[string]interface{}{
+					"name": "minio-root-credentials",
+				},
+				"mountPath":       "/export",
+				"requestAutoCert": false,
+				"pools": []interface{}{
+					map[string]interface{}{
+						"name": "pool-0",
+						// controlled by field: minio.servers
+						//  Number of minio servers of the tenant, when installed by the minio operator.
+						"servers": parent.Spec.MinIO.Servers,
+						// controlled by field: minio.volumesPerServer
+						//  Number of volumes of each minio server of the tenant, when installed by the minio operator.
+						"volumesPerServer": parent.Spec.MinIO.VolumesPerServer,
+						"volumeClaimTemplate": map[string]interface{}{
+							"metadata": map[string]interface{}{
+								"name": "data",
+							},
+							"spec": map[string]interface{}{
+								"accessModes": []interface{}{
+									"ReadWriteOnce",
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"storage": parent.Spec.MinIO.Storage.Size, //  controlled by field: minio.storage.size
+									},
+								},
+							},
+						},
+						"resources": map[string]interface{}{
+							"requests": map[string]interface{}{
+								"cpu":    "250m",
+								"memory": "512Mi",
+							},
+							"limits": map[string]interface{}{
+								"cpu":    "1",
+								"memory": "2Gi",
+							},
+						},
+						"containerSecurityContext": map[string]interface{}{
+							"runAsNonRoot":             true,
+							"allowPrivilegeEscalation": false,
+							"capabilities": map[string]interface{}{
+								"drop": []interface{}{
+									"ALL",
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"runAsUser":    1000,
+							"runAsGroup":   1000,
+							"fsGroup":      1000,
+							"runAsNonRoot": true,
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateTenantNamespaceMinio(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceOperator creates the Service resource with name operator.
+func CreateServiceNamespaceOperator(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio-operator",
+					"app.kubernetes.io/name":       "minio-operator",
+				},
+				"name":      "operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":     "http",
+						"port":     4221,
+						"protocol": "TCP",
+					},
+				},
+				"selector": map[string]interface{}{
+					"app.kubernetes.io/name": "minio-operator",
+					"operator":               "leader",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceMinioOperator creates the Deployment resource with name minio-operator.
+func CreateDeploymentNamespaceMinioOperator(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio-operator",
+					"app.kubernetes.io/name":       "minio-operator",
+				},
+				"name":      "minio-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				// controlled by field: minioOperator.replicas
+				//  Number of replicas to use for the minio operator deployment.
+				"replicas": parent.Spec.MinIOOperator.Replicas,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "minio-operator",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"platform.nukleros.io/group":   "object-storage",
+							"platform.nukleros.io/project": "minio-operator",
+							"app.kubernetes.io/name":       "minio-operator",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "minio-operator",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "minio-operator",
+								// controlled by field: minioOperator.image
+								// controlled by field: minioOperator.version
+								//  Image repo and name to use for the minio operator.
+								//  Version of the minio operator to use.
+								"image":           "" + parent.Spec.MinIOOperator.Image + ":" + parent.Spec.MinIOOperator.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"controller",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CLUSTER_DOMAIN",
+										"value": "cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "WATCHED_NAMESPACE",
+										"value": "",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":               "200m",
+										"memory":            "256Mi",
+										"ephemeral-storage": "500Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "500m",
+										"memory": "512Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsUser":                1000,
+									"runAsGroup":               1000,
+									"runAsNonRoot":             true,
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceMinioOperator(resourceObj, parent, collection, reconciler, req)
+}
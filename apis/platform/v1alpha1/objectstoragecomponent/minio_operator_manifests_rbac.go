@@ -0,0 +1,406 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstoragecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceMinioOperator creates the ServiceAccount resource with name minio-operator.
+func CreateServiceAccountNamespaceMinioOperator(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio-operator",
+				},
+				"name":      "minio-operator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceMinioOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;update
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;update;list
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;watch;list
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;watch;list
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;watch;create;list;delete;deletecollection;update;patch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;watch;create;list;delete;deletecollection;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;watch;create;list;delete;deletecollection;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;watch;create;list;delete;deletecollection;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;watch;create;update;list;delete;deletecollection
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=create;delete;get;list;patch
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=create;delete;get;list;patch;update;deletecollection
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=create;delete;get;list;patch;update;deletecollection
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;create;list;patch;watch;update;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;create;list;patch;watch;update;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments/finalizers,verbs=get;create;list;patch;watch;update;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;list;patch;watch;update;delete
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests,verbs=update;create;get;delete;list
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests/approval,verbs=update;create;get;delete;list
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests/status,verbs=update;create;get;delete;list
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=signers,verbs=approve;sign
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=minio.min.io,resources=*,verbs=*
+// +kubebuilder:rbac:groups=sts.min.io,resources=*,verbs=*
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheuses,verbs=get;update;list
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;update;create
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=create;delete;get;list;patch;update;deletecollection
+
+// CreateClusterRoleMinioOperator creates the ClusterRole resource with name minio-operator.
+func CreateClusterRoleMinioOperator(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio-operator",
+				},
+				"name": "minio-operator",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apiextensions.k8s.io",
+					},
+					"resources": []interface{}{
+						"customresourcedefinitions",
+					},
+					"verbs": []interface{}{
+						"get",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"persistentvolumeclaims",
+					},
+					"verbs": []interface{}{
+						"get",
+						"update",
+						"list",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"namespaces",
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"get",
+						"watch",
+						"list",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+						"services",
+						"events",
+						"configmaps",
+					},
+					"verbs": []interface{}{
+						"get",
+						"watch",
+						"create",
+						"list",
+						"delete",
+						"deletecollection",
+						"update",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"secrets",
+					},
+					"verbs": []interface{}{
+						"get",
+						"watch",
+						"create",
+						"update",
+						"list",
+						"delete",
+						"deletecollection",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"serviceaccounts",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"rbac.authorization.k8s.io",
+					},
+					"resources": []interface{}{
+						"roles",
+						"rolebindings",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"deletecollection",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"statefulsets",
+						"deployments",
+						"deployments/finalizers",
+					},
+					"verbs": []interface{}{
+						"get",
+						"create",
+						"list",
+						"patch",
+						"watch",
+						"update",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"batch",
+					},
+					"resources": []interface{}{
+						"jobs",
+					},
+					"verbs": []interface{}{
+						"get",
+						"create",
+						"list",
+						"patch",
+						"watch",
+						"update",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"certificates.k8s.io",
+					},
+					"resources": []interface{}{
+						"certificatesigningrequests",
+						"certificatesigningrequests/approval",
+						"certificatesigningrequests/status",
+					},
+					"verbs": []interface{}{
+						"update",
+						"create",
+						"get",
+						"delete",
+						"list",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"certificates.k8s.io",
+					},
+					"resourceNames": []interface{}{
+						"kubernetes.io/legacy-unknown",
+						"kubernetes.io/kube-apiserver-client",
+						"kubernetes.io/kubelet-serving",
+						"beta.eks.amazonaws.com/app-serving",
+					},
+					"resources": []interface{}{
+						"signers",
+					},
+					"verbs": []interface{}{
+						"approve",
+						"sign",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"authentication.k8s.io",
+					},
+					"resources": []interface{}{
+						"tokenreviews",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"minio.min.io",
+						"sts.min.io",
+					},
+					"resources": []interface{}{
+						"*",
+					},
+					"verbs": []interface{}{
+						"*",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"monitoring.coreos.com",
+					},
+					"resources": []interface{}{
+						"prometheuses",
+					},
+					"verbs": []interface{}{
+						"get",
+						"update",
+						"list",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"get",
+						"update",
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"policy",
+					},
+					"resources": []interface{}{
+						"poddisruptionbudgets",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"deletecollection",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleMinioOperator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingMinioOperator creates the ClusterRoleBinding resource with name minio-operator.
+func CreateClusterRoleBindingMinioOperator(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mode != "operator" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mode,value="operator",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "object-storage",
+					"platform.nukleros.io/project": "minio-operator",
+				},
+				"name": "minio-operator",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "minio-operator",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "minio-operator",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingMinioOperator(resourceObj, parent, collection, reconciler, req)
+}
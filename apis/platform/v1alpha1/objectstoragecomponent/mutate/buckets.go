@@ -0,0 +1,281 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// bucketsJob is the name of the job which creates the buckets, before it is suffixed with the hash
+// of its pod template.
+const bucketsJob = "minio-buckets"
+
+// MutateSecretNamespaceBucketCredentials mutates a Secret resource generated from a bucket of the
+// component, which holds the credentials of the user of the bucket.  A copy of the secret is
+// returned for each namespace in which the credentials of the bucket are published.
+func MutateSecretNamespaceBucketCredentials(
+	original client.Object,
+	bucket *platformv1alpha1.ObjectStorageComponentBucket,
+	parent *platformv1alpha1.ObjectStorageComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	if bucket.Name == rootUser {
+		return nil, fmt.Errorf("%w %s, which is the access key of the root user", ErrReservedBucket, bucket.Name)
+	}
+
+	// set the credentials of the bucket, keeping the secret key of the existing secret when
+	// reconciling.
+	secretKey, err := bucketSecretKey(parent, bucket.Name, reconciler, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setBucketCredentials(original, parent, bucket, secretKey); err != nil {
+		return nil, err
+	}
+
+	secrets, err := credentialsCopies(original, parent, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return secrets, nil
+	}
+
+	// mutation logic goes here
+
+	return secrets, nil
+}
+
+// credentialsCopies returns a copy of a bucket credentials secret for each namespace in which the
+// credentials of the bucket are published.
+func credentialsCopies(
+	original client.Object,
+	parent *platformv1alpha1.ObjectStorageComponent,
+	bucket *platformv1alpha1.ObjectStorageComponentBucket,
+) ([]client.Object, error) {
+	secret, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	secrets := []client.Object{}
+
+	for _, namespace := range parent.CredentialsNamespaces(bucket) {
+		copied := secret.DeepCopy()
+		copied.SetNamespace(namespace)
+
+		secrets = append(secrets, copied)
+	}
+
+	return secrets, nil
+}
+
+// MutateJobNamespaceMinioBuckets mutates the Job resource which creates the buckets of the
+// component and their users.  The job is named after the hash of its pod template and of the
+// credentials of the root user and of the buckets, so that it is replaced, and so run again,
+// whenever the buckets or their credentials change.
+func MutateJobNamespaceMinioBuckets(
+	original client.Object,
+	parent *platformv1alpha1.ObjectStorageComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) ([]client.Object, error) {
+	credentials, err := jobCredentials(parent, reconciler, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		return []client.Object{}, nil
+	}
+
+	// set the script which creates the buckets and the secret keys of their users.
+	if err := setBucketsScript(original, parent); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, setupv1alpha1.SchedulingSpec{}); err != nil {
+		return nil, err
+	}
+
+	if err := setJobName(original, credentials); err != nil {
+		return nil, err
+	}
+
+	// if either the reconciler or request are found to be nil, return the base object.
+	if reconciler == nil || req == nil {
+		return []client.Object{original}, nil
+	}
+
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
+	// the images may have been pinned to their digests, which changes the pod template.
+	if err := setJobName(original, credentials); err != nil {
+		return nil, err
+	}
+
+	return []client.Object{original}, nil
+}
+
+// jobCredentials returns the password of the root user and the secret keys of the users of the
+// buckets, which the job which creates the buckets reads from their secrets.  When reconciling
+// before all of the secrets have been created, nil is returned and the job is created by the next
+// reconciliation, once the secrets hold the credentials which the job uses.
+func jobCredentials(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) (map[string]string, error) {
+	password, err := rootPassword(parent, reconciler, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if awaitsRootPassword(password, reconciler, req) {
+		return nil, nil
+	}
+
+	credentials := map[string]string{rootUser: password}
+
+	for i := range parent.Spec.Buckets {
+		bucket := parent.Spec.Buckets[i].Name
+
+		secretKey, err := existingSecretKey(parent, bucket, reconciler, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if secretKey == "" && reconciler != nil && req != nil {
+			return nil, nil
+		}
+
+		credentials[bucket] = secretKey
+	}
+
+	return credentials, nil
+}
+
+// setBucketsScript sets the script of the minio client which creates the buckets of the component,
+// along with a user for each bucket which may only access the bucket.  The secret keys of the
+// users are read from the bucket credentials secrets in the namespace of the component.  Buckets
+// and users which already exist are updated, so the script may be run again at any time.
+func setBucketsScript(original client.Object, parent *platformv1alpha1.ObjectStorageComponent) error {
+	script := []string{
+		"set -e",
+		`until mc alias set minio "$MINIO_ENDPOINT" "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD"; do sleep 5; done`,
+	}
+
+	for i := range parent.Spec.Buckets {
+		bucket := parent.Spec.Buckets[i].Name
+		policy := bucket + "-readwrite"
+		variable := fmt.Sprintf("BUCKET_%d_SECRET_KEY", i)
+
+		document, err := bucketPolicy(bucket)
+		if err != nil {
+			return err
+		}
+
+		script = append(script,
+			fmt.Sprintf("mc mb --ignore-existing minio/%s", bucket),
+			fmt.Sprintf("echo '%s' > /tmp/%s.json", document, policy),
+			fmt.Sprintf("mc admin policy create minio %s /tmp/%s.json", policy, policy),
+			fmt.Sprintf(`mc admin user add minio %s "$%s"`, bucket, variable),
+			// attaching a policy which is already attached fails
+			fmt.Sprintf("mc admin policy attach minio %s --user %s || true", policy, bucket),
+		)
+
+		err = podtemplate.SetEnvFromSecret(
+			original, minioClient, variable, platformv1alpha1.BucketCredentialsSecret(bucket), "secretAccessKey", false,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	container, err := podtemplate.Container(original, minioClient)
+	if err != nil {
+		return err
+	}
+
+	container["args"] = []interface{}{strings.Join(script, "\n")}
+
+	return nil
+}
+
+// bucketPolicy returns the policy which grants access to a bucket and to its objects.
+func bucketPolicy(bucket string) (string, error) {
+	document, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:*"},
+				"Resource": []string{"arn:aws:s3:::" + bucket, "arn:aws:s3:::" + bucket + "/*"},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal policy of bucket %s, %w", bucket, err)
+	}
+
+	return string(document), nil
+}
+
+// setJobName names the job which creates the buckets after the hash of its pod template and of the
+// credentials which it uses.
+func setJobName(original client.Object, credentials map[string]string) error {
+	job, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	template, err := nestedMap(job, "spec", "template")
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("unable to marshal pod template of job %s, %w", original.GetName(), err)
+	}
+
+	config := map[string]string{"template": string(data)}
+	for user, secret := range credentials {
+		config["credentials/"+user] = secret
+	}
+
+	hash := podtemplate.HashConfig(config)
+	original.SetName(bucketsJob + "-" + hash[:10])
+
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/constants"
+)
+
+var ErrReservedBucket = errors.New("bucket name is reserved")
+
+const (
+	// rootUser is the access key of the root user of minio.  Buckets may not use it as their name,
+	// as the name of a bucket is the access key of its user.
+	rootUser = "root"
+
+	passwordLength  = 24
+	secretKeyLength = 40
+)
+
+// rootPassword returns the password of the root user of minio from the root credentials secret.
+// An empty password is returned when generating manifests from the CLI, or when reconciling before
+// the secret has been created, in which case a password is generated for the secret.  Deleting
+// the root credentials secret therefore rotates the password of the root user.
+func rootPassword(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) (string, error) {
+	if reconciler == nil || req == nil {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: constants.SecretNamespaceMinioRootCredentials, Namespace: parent.Spec.Namespace}
+
+	if err := reconciler.Get(req.Context, name, secret); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return "", fmt.Errorf("unable to get root credentials secret %s; %w", name, err)
+		}
+	}
+
+	return string(secret.Data["MINIO_ROOT_PASSWORD"]), nil
+}
+
+// awaitsRootPassword returns whether the resources which depend on the password of the root user
+// must wait for the root credentials secret, which is the case when reconciling before the secret
+// has been created.  The resources are then created by the next reconciliation, once the secret
+// holds the password.
+func awaitsRootPassword(password string, reconciler workload.Reconciler, req *workload.Request) bool {
+	return password == "" && reconciler != nil && req != nil
+}
+
+// generatePassword returns a random password.  The password is hex encoded, so that it is safe to
+// use in the environment file of a minio tenant without quoting.
+func generatePassword() (string, error) {
+	password := make([]byte, passwordLength)
+
+	if _, err := rand.Read(password); err != nil {
+		return "", fmt.Errorf("unable to generate password; %w", err)
+	}
+
+	return hex.EncodeToString(password), nil
+}
+
+// setRootCredentials sets the credentials of the root user of minio in the root credentials secret.
+func setRootCredentials(original client.Object, password string) error {
+	secret, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	secret.Object["stringData"] = map[string]interface{}{
+		"MINIO_ROOT_USER":     rootUser,
+		"MINIO_ROOT_PASSWORD": password,
+		"config.env": fmt.Sprintf(
+			"export MINIO_ROOT_USER=%s\nexport MINIO_ROOT_PASSWORD=%s\n", rootUser, password,
+		),
+	}
+
+	return nil
+}
+
+// bucketSecretKey returns the secret key of the user of a bucket from its bucket credentials
+// secret, or a newly generated key if the secret does not yet exist or when generating manifests
+// from the CLI.  The keys of the buckets are independent of each other and of the password of the
+// root user, so deleting a bucket credentials secret rotates the key of that bucket only.
+func bucketSecretKey(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	bucket string,
+	reconciler workload.Reconciler, req *workload.Request,
+) (string, error) {
+	secretKey, err := existingSecretKey(parent, bucket, reconciler, req)
+	if err != nil || secretKey != "" {
+		return secretKey, err
+	}
+
+	return generateSecretKey()
+}
+
+// existingSecretKey returns the secret key of the user of a bucket from the bucket credentials
+// secret in the namespace of the component.  The copies of the secret in other namespaces are
+// generated from the same key.  An empty key is returned when generating manifests from the CLI,
+// or when reconciling before the secret has been created.
+func existingSecretKey(
+	parent *platformv1alpha1.ObjectStorageComponent,
+	bucket string,
+	reconciler workload.Reconciler, req *workload.Request,
+) (string, error) {
+	if reconciler == nil || req == nil {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Name: platformv1alpha1.BucketCredentialsSecret(bucket), Namespace: parent.Spec.Namespace}
+
+	if err := reconciler.Get(req.Context, name, secret); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return "", fmt.Errorf("unable to get bucket credentials secret %s; %w", name, err)
+		}
+	}
+
+	return string(secret.Data["secretAccessKey"]), nil
+}
+
+// generateSecretKey returns a random secret key for the user of a bucket.
+func generateSecretKey() (string, error) {
+	secretKey := make([]byte, secretKeyLength/2)
+
+	if _, err := rand.Read(secretKey); err != nil {
+		return "", fmt.Errorf("unable to generate secret key; %w", err)
+	}
+
+	return hex.EncodeToString(secretKey), nil
+}
+
+// setBucketCredentials sets the credentials of the user of a bucket in a bucket credentials secret.
+// The credentials are published under the keys which the workloads of the support services expect,
+// i.e. the accessKeyID and secretAccessKey keys of the s3 outputs of the logging component, the
+// AWS credentials file under the cloud key for velero and the usual AWS variables, which are also
+// copied to the configuration of the logical backups of the database component.
+func setBucketCredentials(
+	original client.Object,
+	parent *platformv1alpha1.ObjectStorageComponent,
+	bucket *platformv1alpha1.ObjectStorageComponentBucket,
+	secretKey string,
+) error {
+	secret, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	secret.Object["stringData"] = map[string]interface{}{
+		"endpoint":              parent.Endpoint(),
+		"bucket":                bucket.Name,
+		"region":                platformv1alpha1.ObjectStorageRegion,
+		"accessKeyID":           bucket.Name,
+		"secretAccessKey":       secretKey,
+		"AWS_ACCESS_KEY_ID":     bucket.Name,
+		"AWS_SECRET_ACCESS_KEY": secretKey,
+		"cloud": fmt.Sprintf(
+			"[default]\naws_access_key_id=%s\naws_secret_access_key=%s\n", bucket.Name, secretKey,
+		),
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/objectstoragecomponent/constants"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// setReloadAnnotation annotates a workload so that reloader restarts minio when the password of
+// the root user rotates.
+func setReloadAnnotation(original client.Object) {
+	annotations := original.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations["secret.reloader.stakater.com/reload"] = constants.SecretNamespaceMinioRootCredentials
+	original.SetAnnotations(annotations)
+}
+
+// setStorageClass sets the storage class of the volume claim templates of the minio statefulset.
+func setStorageClass(original client.Object, storageClass string) error {
+	if storageClass == "" {
+		return nil
+	}
+
+	statefulSet, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	spec, err := nestedMap(statefulSet, "spec")
+	if err != nil {
+		return err
+	}
+
+	templates, _ := spec["volumeClaimTemplates"].([]interface{})
+
+	for _, template := range templates {
+		claim, ok := template.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if claimSpec, ok := claim["spec"].(map[string]interface{}); ok {
+			claimSpec["storageClassName"] = storageClass
+		}
+	}
+
+	return nil
+}
+
+// mutateTenant applies the settings of the minio servers to a minio tenant.  The tenant holds
+// the image, resources and scheduling settings of its servers outside of a pod template, so the
+// settings which are otherwise applied to the pod template of a workload are applied to the
+// tenant and its pools here.
+func mutateTenant(
+	original client.Object,
+	parent *platformv1alpha1.ObjectStorageComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	tenant, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	// generated objects contain values which are not deep copyable (e.g. int), so we must
+	// modify the tenant in place rather than using the unstructured setters.
+	spec, err := nestedMap(tenant, "spec")
+	if err != nil {
+		return err
+	}
+
+	image, _ := spec["image"].(string)
+	if parent.Spec.MinIO.Digest != "" {
+		image += "@" + parent.Spec.MinIO.Digest
+	}
+
+	spec["image"] = podtemplate.MirrorImage(image, collection.Spec.RegistryMirrors)
+
+	// a tenant accepts a single image pull secret
+	if len(collection.Spec.ImagePullSecrets) > 0 {
+		spec["imagePullSecret"] = map[string]interface{}{"name": collection.Spec.ImagePullSecrets[0].Name}
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	scheduling := collection.Spec.Scheduling.Override(parent.Spec.MinIO.Scheduling)
+
+	pools, _ := spec["pools"].([]interface{})
+
+	for _, item := range pools {
+		pool, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := mutatePool(pool, parent, profile.ResourcePercent, scheduling); err != nil {
+			return fmt.Errorf("unable to set pool %v of tenant %s, %w", pool["name"], tenant.GetName(), err)
+		}
+	}
+
+	// the priority class is set for the whole tenant rather than for each pool
+	if scheduling.PriorityClassName != "" {
+		spec["priorityClassName"] = scheduling.PriorityClassName
+	}
+
+	return nil
+}
+
+// mutatePool applies the resources, scheduling and storage settings of the minio servers to a pool
+// of a minio tenant.
+func mutatePool(
+	pool map[string]interface{},
+	parent *platformv1alpha1.ObjectStorageComponent,
+	resourcePercent int,
+	scheduling setupv1alpha1.SchedulingSpec,
+) error {
+	if resources, ok := pool["resources"].(map[string]interface{}); ok {
+		if err := podtemplate.ScaleRequirements(resources, resourcePercent); err != nil {
+			return err
+		}
+
+		if err := podtemplate.SetRequirements(resources, parent.Spec.MinIO.Resources); err != nil {
+			return err
+		}
+	}
+
+	if err := podtemplate.SetSchedulingFields(pool, scheduling); err != nil {
+		return err
+	}
+
+	delete(pool, "priorityClassName")
+
+	if parent.Spec.MinIO.Storage.StorageClassName == "" {
+		return nil
+	}
+
+	if template, ok := pool["volumeClaimTemplate"].(map[string]interface{}); ok {
+		if claimSpec, ok := template["spec"].(map[string]interface{}); ok {
+			claimSpec["storageClassName"] = parent.Spec.MinIO.Storage.StorageClassName
+		}
+	}
+
+	return nil
+}
+
+// nestedMap returns the map at the given path of a generated object.
+func nestedMap(object *unstructured.Unstructured, fields ...string) (map[string]interface{}, error) {
+	value, found, err := unstructured.NestedFieldNoCopy(object.Object, fields...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %v of %s, %w", fields, object.GetName(), err)
+	}
+
+	nested, ok := value.(map[string]interface{})
+	if !ok || !found {
+		return nil, fmt.Errorf("unable to find %v of %s", fields, object.GetName())
+	}
+
+	return nested, nil
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertObjectStorageComponent = errors.New("unable to convert to ObjectStorageComponent")
+
+// ObjectStorageRegion is the region of the object storage of an ObjectStorageComponent, which is
+// the default region of minio.
+const ObjectStorageRegion = "us-east-1"
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ObjectStorageComponentSpec defines the desired state of ObjectStorageComponent.
+type ObjectStorageComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection ObjectStorageComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-object-storage-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-object-storage-system")
+	//
+	//	Namespace to use for object storage support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:default="standalone"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=standalone;operator
+	// (Default: "standalone")
+	//
+	//	How to install minio.  One of: standalone | operator.  standalone runs a single minio
+	//	server, while operator installs the minio operator and a minio tenant, which may be
+	//	distributed across several servers.
+	Mode string `json:"mode,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	MinIO ObjectStorageComponentSpecMinIO `json:"minio,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	MinIOOperator ObjectStorageComponentSpecMinIOOperator `json:"minioOperator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	MinIOClient ObjectStorageComponentSpecMinIOClient `json:"minioClient,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Buckets to create.  A user which may only access the bucket is created for each bucket and
+	//	its credentials are published in the <bucket>-bucket-credentials secret.
+	Buckets []ObjectStorageComponentBucket `json:"buckets,omitempty"`
+}
+
+type ObjectStorageComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type ObjectStorageComponentSpecMinIO struct {
+	// +kubebuilder:default="quay.io/minio/minio"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/minio/minio")
+	//
+	//	Image repo and name to use for minio.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the minio image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="RELEASE.2023-05-04T21-44-30Z"
+	// +kubebuilder:validation:Optional
+	// (Default: "RELEASE.2023-05-04T21-44-30Z")
+	//
+	//	Version of minio to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// (Default: 1)
+	//
+	//	Number of minio servers of the tenant, when installed by the minio operator.
+	Servers int `json:"servers,omitempty"`
+
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// (Default: 4)
+	//
+	//	Number of volumes of each minio server of the tenant, when installed by the minio operator.
+	//	minio erasure codes objects across the volumes of the tenant, which requires at least four
+	//	volumes in total.
+	VolumesPerServer int `json:"volumesPerServer,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Storage ObjectStorageComponentSpecMinIOStorage `json:"storage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the minio pods.  Settings which are set here take precedence over
+	//	the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the minio container.  Requests and limits which are not
+	//	set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type ObjectStorageComponentSpecMinIOStorage struct {
+	// +kubebuilder:default="10Gi"
+	// +kubebuilder:validation:Optional
+	// (Default: "10Gi")
+	//
+	//	Size of each volume of the minio servers.
+	Size string `json:"size,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Storage class of the volumes of the minio servers.  Defaults to the default storage class
+	//	of the cluster.
+	StorageClassName string `json:"storageClassName,omitempty"`
+}
+
+type ObjectStorageComponentSpecMinIOOperator struct {
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	// (Default: 1)
+	//
+	//	Number of replicas to use for the minio operator deployment.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:default="quay.io/minio/operator"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/minio/operator")
+	//
+	//	Image repo and name to use for the minio operator.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the minio operator image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v5.0.4"
+	// +kubebuilder:validation:Optional
+	// (Default: "v5.0.4")
+	//
+	//	Version of the minio operator to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the minio operator pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the minio operator container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type ObjectStorageComponentSpecMinIOClient struct {
+	// +kubebuilder:default="quay.io/minio/mc"
+	// +kubebuilder:validation:Optional
+	// (Default: "quay.io/minio/mc")
+	//
+	//	Image repo and name to use for the minio client, which creates the buckets and their users.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:default="RELEASE.2023-05-04T18-10-16Z"
+	// +kubebuilder:validation:Optional
+	// (Default: "RELEASE.2023-05-04T18-10-16Z")
+	//
+	//	Version of the minio client to use.
+	Version string `json:"version,omitempty"`
+}
+
+type ObjectStorageComponentBucket struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//	Name of the bucket, which is also the access key of the user of the bucket.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespaces in which to publish the credentials of the bucket, in addition to the namespace
+	//	of the component, so that workloads in those namespaces are able to use the bucket.  The
+	//	namespaces must exist.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+type ObjectStorageComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// ObjectStorageComponent is the Schema for the objectstoragecomponents API.
+type ObjectStorageComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ObjectStorageComponentSpec   `json:"spec,omitempty"`
+	Status            ObjectStorageComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectStorageComponentList contains a list of ObjectStorageComponent.
+type ObjectStorageComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectStorageComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *ObjectStorageComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *ObjectStorageComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *ObjectStorageComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *ObjectStorageComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *ObjectStorageComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *ObjectStorageComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *ObjectStorageComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *ObjectStorageComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *ObjectStorageComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*ObjectStorageComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*ObjectStorageComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("ObjectStorageComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *ObjectStorageComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *ObjectStorageComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *ObjectStorageComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	// the minio operator elects a leader, so it is not replicated by the tier, and the servers of
+	// minio are set by the mode of the component
+	return map[string]int{
+		"minio-operator": component.Spec.MinIOOperator.Replicas,
+	}
+}
+
+// Endpoint returns the URL of the S3-compatible object storage of the component within the
+// cluster.  The minio service of a tenant serves plain HTTP on port 80, as the tenant does not
+// request certificates from the minio operator.
+func (component *ObjectStorageComponent) Endpoint() string {
+	if component.Spec.Mode == "operator" {
+		return fmt.Sprintf("http://minio.%s.svc", component.Spec.Namespace)
+	}
+
+	return fmt.Sprintf("http://minio.%s.svc:9000", component.Spec.Namespace)
+}
+
+// Bucket returns the bucket of the component with the given name, or nil if the component does
+// not declare the bucket.
+func (component *ObjectStorageComponent) Bucket(name string) *ObjectStorageComponentBucket {
+	for i := range component.Spec.Buckets {
+		if component.Spec.Buckets[i].Name == name {
+			return &component.Spec.Buckets[i]
+		}
+	}
+
+	return nil
+}
+
+// CredentialsNamespaces returns the namespaces in which the credentials of a bucket are published,
+// starting with the namespace of the component.
+func (component *ObjectStorageComponent) CredentialsNamespaces(bucket *ObjectStorageComponentBucket) []string {
+	namespaces := []string{component.Spec.Namespace}
+
+	for _, namespace := range bucket.Namespaces {
+		if namespace != component.Spec.Namespace {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces
+}
+
+// BucketCredentialsSecret returns the name of the secret which holds the credentials of a bucket.
+func BucketCredentialsSecret(bucket string) string {
+	return bucket + "-bucket-credentials"
+}
+
+func init() {
+	SchemeBuilder.Register(&ObjectStorageComponent{}, &ObjectStorageComponentList{})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                                        = "parent.Spec.Namespace"
+	CRDClusterpoliciesKyvernoIo                               = "clusterpolicies.kyverno.io"
+	CRDPoliciesKyvernoIo                                      = "policies.kyverno.io"
+	CRDUpdaterequestsKyvernoIo                                = "updaterequests.kyverno.io"
+	CRDAdmissionreportsKyvernoIo                              = "admissionreports.kyverno.io"
+	CRDClusteradmissionreportsKyvernoIo                       = "clusteradmissionreports.kyverno.io"
+	CRDBackgroundscanreportsKyvernoIo                         = "backgroundscanreports.kyverno.io"
+	CRDClusterbackgroundscanreportsKyvernoIo                  = "clusterbackgroundscanreports.kyverno.io"
+	CRDPolicyreportsWgpolicyk8sIo                             = "policyreports.wgpolicyk8s.io"
+	CRDClusterpolicyreportsWgpolicyk8sIo                      = "clusterpolicyreports.wgpolicyk8s.io"
+	ConfigMapNamespaceKyverno                                 = "kyverno"
+	ConfigMapNamespaceKyvernoMetrics                          = "kyverno-metrics"
+	DeploymentNamespaceKyverno                                = "kyverno"
+	ServiceAccountNamespaceKyverno                            = "kyverno"
+	ClusterRoleKyverno                                        = "kyverno"
+	ClusterRoleBindingKyverno                                 = "kyverno"
+	RoleNamespaceKyvernoLeaderElection                        = "kyverno-leader-election"
+	RoleBindingNamespaceKyvernoLeaderElection                 = "kyverno-leader-election"
+	ServiceNamespaceKyvernoSvc                                = "kyverno-svc"
+	ServiceNamespaceKyvernoSvcMetrics                         = "kyverno-svc-metrics"
+	ClusterPolicyRequireResourceLimits                        = "require-resource-limits"
+	ClusterPolicyDisallowLatestTag                            = "disallow-latest-tag"
+	ClusterPolicyRestrictHostPaths                            = "restrict-host-paths"
+	ClusterPolicyRequirePlatformLabels                        = "require-platform-labels"
+	CRDConstrainttemplatesTemplatesGatekeeperSh               = "constrainttemplates.templates.gatekeeper.sh"
+	CRDConfigsConfigGatekeeperSh                              = "configs.config.gatekeeper.sh"
+	CRDConstraintpodstatusesStatusGatekeeperSh                = "constraintpodstatuses.status.gatekeeper.sh"
+	CRDConstrainttemplatepodstatusesStatusGatekeeperSh        = "constrainttemplatepodstatuses.status.gatekeeper.sh"
+	CRDProvidersExternaldataGatekeeperSh                      = "providers.externaldata.gatekeeper.sh"
+	DeploymentNamespaceGatekeeperControllerManager            = "gatekeeper-controller-manager"
+	DeploymentNamespaceGatekeeperAudit                        = "gatekeeper-audit"
+	ServiceAccountNamespaceGatekeeperAdmin                    = "gatekeeper-admin"
+	ClusterRoleGatekeeperManagerRole                          = "gatekeeper-manager-role"
+	ClusterRoleBindingGatekeeperManagerRolebinding            = "gatekeeper-manager-rolebinding"
+	RoleNamespaceGatekeeperManagerRole                        = "gatekeeper-manager-role"
+	RoleBindingNamespaceGatekeeperManagerRolebinding          = "gatekeeper-manager-rolebinding"
+	ServiceNamespaceGatekeeperWebhookService                  = "gatekeeper-webhook-service"
+	SecretNamespaceGatekeeperWebhookServerCert                = "gatekeeper-webhook-server-cert"
+	ValidatingWebhookGatekeeperValidatingWebhookConfiguration = "gatekeeper-validating-webhook-configuration"
+	ConstraintTemplateRequireresourcelimits                   = "requireresourcelimits"
+	ConstraintTemplateDisallowlatesttag                       = "disallowlatesttag"
+	ConstraintTemplateRestricthostpaths                       = "restricthostpaths"
+	ConstraintTemplateRequireplatformlabels                   = "requireplatformlabels"
+	RequireResourceLimitsRequireResourceLimits                = "require-resource-limits"
+	DisallowLatestTagDisallowLatestTag                        = "disallow-latest-tag"
+	RestrictHostPathsRestrictHostPaths                        = "restrict-host-paths"
+	RequirePlatformLabelsRequirePlatformLabels                = "require-platform-labels"
+)
@@ -0,0 +1,239 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=requireresourcelimitses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRequireResourceLimitsRequireResourceLimits creates the RequireResourceLimits resource with name require-resource-limits.
+func CreateRequireResourceLimitsRequireResourceLimits(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RequireResourceLimits.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.requireResourceLimits.enabled,value=true,include
+			"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+			"kind":       "RequireResourceLimits",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "require-resource-limits",
+			},
+			"spec": map[string]interface{}{
+				"enforcementAction": "dryrun",
+				"match": map[string]interface{}{
+					"kinds": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"",
+							},
+							"kinds": []interface{}{
+								"Pod",
+							},
+						},
+					},
+					"excludedNamespaces": []interface{}{
+						"kube-system",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRequireResourceLimitsRequireResourceLimits(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=disallowlatesttags,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDisallowLatestTagDisallowLatestTag creates the DisallowLatestTag resource with name disallow-latest-tag.
+func CreateDisallowLatestTagDisallowLatestTag(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.DisallowLatestTag.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.disallowLatestTag.enabled,value=true,include
+			"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+			"kind":       "DisallowLatestTag",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "disallow-latest-tag",
+			},
+			"spec": map[string]interface{}{
+				"enforcementAction": "dryrun",
+				"match": map[string]interface{}{
+					"kinds": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"",
+							},
+							"kinds": []interface{}{
+								"Pod",
+							},
+						},
+					},
+					"excludedNamespaces": []interface{}{
+						"kube-system",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDisallowLatestTagDisallowLatestTag(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=restricthostpathses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRestrictHostPathsRestrictHostPaths creates the RestrictHostPaths resource with name restrict-host-paths.
+func CreateRestrictHostPathsRestrictHostPaths(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RestrictHostPaths.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.restrictHostPaths.enabled,value=true,include
+			"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+			"kind":       "RestrictHostPaths",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "restrict-host-paths",
+			},
+			"spec": map[string]interface{}{
+				"enforcementAction": "dryrun",
+				"match": map[string]interface{}{
+					"kinds": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"",
+							},
+							"kinds": []interface{}{
+								"Pod",
+							},
+						},
+					},
+					"excludedNamespaces": []interface{}{
+						"kube-system",
+					},
+				},
+				"parameters": map[string]interface{}{
+					"allowedPaths": []interface{}{},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRestrictHostPathsRestrictHostPaths(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=requireplatformlabelses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRequirePlatformLabelsRequirePlatformLabels creates the RequirePlatformLabels resource with name require-platform-labels.
+func CreateRequirePlatformLabelsRequirePlatformLabels(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RequirePlatformLabels.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.requirePlatformLabels.enabled,value=true,include
+			"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+			"kind":       "RequirePlatformLabels",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "require-platform-labels",
+			},
+			"spec": map[string]interface{}{
+				"enforcementAction": "dryrun",
+				"match": map[string]interface{}{
+					"kinds": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"apps",
+							},
+							"kinds": []interface{}{
+								"Deployment",
+								"StatefulSet",
+								"DaemonSet",
+							},
+						},
+					},
+					"excludedNamespaces": []interface{}{
+						"kube-system",
+					},
+				},
+				"parameters": map[string]interface{}{
+					"labels": []interface{}{
+						"platform.nukleros.io/group",
+						"platform.nukleros.io/project",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRequirePlatformLabelsRequirePlatformLabels(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDConstrainttemplatesTemplatesGatekeeperSh creates the CustomResourceDefinition resource with name constrainttemplates.templates.gatekeeper.sh.
+func CreateCRDConstrainttemplatesTemplatesGatekeeperSh(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "constrainttemplates.templates.gatekeeper.sh",
+			},
+			"spec": map[string]interface{}{
+				"group": "templates.gatekeeper.sh",
+				"names": map[string]interface{}{
+					"kind":     "ConstraintTemplate",
+					"listKind": "ConstraintTemplateList",
+					"plural":   "constrainttemplates",
+					"shortNames": []interface{}{
+						"constraints",
+					},
+					"singular": "constrainttemplate",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDConstrainttemplatesTemplatesGatekeeperSh(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDConfigsConfigGatekeeperSh creates the CustomResourceDefinition resource with name configs.config.gatekeeper.sh.
+func CreateCRDConfigsConfigGatekeeperSh(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "configs.config.gatekeeper.sh",
+			},
+			"spec": map[string]interface{}{
+				"group": "config.gatekeeper.sh",
+				"names": map[string]interface{}{
+					"kind":     "Config",
+					"listKind": "ConfigList",
+					"plural":   "configs",
+					"singular": "config",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDConfigsConfigGatekeeperSh(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDConstraintpodstatusesStatusGatekeeperSh creates the CustomResourceDefinition resource with name constraintpodstatuses.status.gatekeeper.sh.
+func CreateCRDConstraintpodstatusesStatusGatekeeperSh(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "constraintpodstatuses.status.gatekeeper.sh",
+			},
+			"spec": map[string]interface{}{
+				"group": "status.gatekeeper.sh",
+				"names": map[string]interface{}{
+					"kind":     "ConstraintPodStatus",
+					"listKind": "ConstraintPodStatusList",
+					"plural":   "constraintpodstatuses",
+					"singular": "constraintpodstatus",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDConstraintpodstatusesStatusGatekeeperSh(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDConstrainttemplatepodstatusesStatusGatekeeperSh creates the CustomResourceDefinition resource with name constrainttemplatepodstatuses.status.gatekeeper.sh.
+func CreateCRDConstrainttemplatepodstatusesStatusGatekeeperSh(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "constrainttemplatepodstatuses.status.gatekeeper.sh",
+			},
+			"spec": map[string]interface{}{
+				"group": "status.gatekeeper.sh",
+				"names": map[string]interface{}{
+					"kind":     "ConstraintTemplatePodStatus",
+					"listKind": "ConstraintTemplatePodStatusList",
+					"plural":   "constrainttemplatepodstatuses",
+					"singular": "constrainttemplatepodstatus",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDConstrainttemplatepodstatusesStatusGatekeeperSh(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDProvidersExternaldataGatekeeperSh creates the CustomResourceDefinition resource with name providers.externaldata.gatekeeper.sh.
+func CreateCRDProvidersExternaldataGatekeeperSh(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "providers.externaldata.gatekeeper.sh",
+			},
+			"spec": map[string]interface{}{
+				"group": "externaldata.gatekeeper.sh",
+				"names": map[string]interface{}{
+					"kind":     "Provider",
+					"listKind": "ProviderList",
+					"plural":   "providers",
+					"singular": "provider",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDProvidersExternaldataGatekeeperSh(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,410 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceGatekeeperControllerManager creates the Deployment resource with name gatekeeper-controller-manager.
+func CreateDeploymentNamespaceGatekeeperControllerManager(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-controller-manager",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-controller-manager",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "gatekeeper-controller-manager",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "gatekeeper-controller-manager",
+							"platform.nukleros.io/group":   "policy",
+							"platform.nukleros.io/project": "gatekeeper",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName":           "gatekeeper-admin",
+						"automountServiceAccountToken": true,
+						"affinity": map[string]interface{}{
+							"podAntiAffinity": map[string]interface{}{
+								"preferredDuringSchedulingIgnoredDuringExecution": []interface{}{
+									map[string]interface{}{
+										"weight": 100,
+										"podAffinityTerm": map[string]interface{}{
+											"labelSelector": map[string]interface{}{
+												"matchExpressions": []interface{}{
+													map[string]interface{}{
+														"key":      "app.kubernetes.io/name",
+														"operator": "In",
+														"values": []interface{}{
+															"gatekeeper-controller-manager",
+														},
+													},
+												},
+											},
+											"topologyKey": "kubernetes.io/hostname",
+										},
+									},
+								},
+							},
+						},
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"priorityClassName":             "system-cluster-critical",
+						"terminationGracePeriodSeconds": 60,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "manager",
+								// controlled by field: engine.gatekeeper.image
+								// controlled by field: engine.gatekeeper.version
+								//  Image repo and name to use for gatekeeper.
+								//  Version of gatekeeper to use.
+								"image":           "" + parent.Spec.Engine.Gatekeeper.Image + ":" + parent.Spec.Engine.Gatekeeper.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/manager",
+								},
+								"args": []interface{}{
+									"--port=8443",
+									"--logtostderr",
+									"--log-denies=false",
+									"--emit-admission-events=false",
+									"--log-level=INFO",
+									"--exempt-namespace=" + parent.Spec.Namespace + "",
+									"--operation=webhook",
+									"--health-addr=:9090",
+									"--prometheus-port=8888",
+									"--disable-opa-builtin={http.send}",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"apiVersion": "v1",
+												"fieldPath":  "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"apiVersion": "v1",
+												"fieldPath":  "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "CONTAINER_NAME",
+										"value": "manager",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 8443,
+										"name":          "webhook-server",
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 8888,
+										"name":          "metrics",
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 9090,
+										"name":          "healthz",
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": 9090,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/readyz",
+										"port": 9090,
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"readOnlyRootFilesystem": true,
+									"runAsGroup":             999,
+									"runAsNonRoot":           true,
+									"runAsUser":              1000,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "256Mi",
+									},
+									"limits": map[string]interface{}{
+										"memory": "512Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath": "/certs",
+										"name":      "cert",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "cert",
+								"secret": map[string]interface{}{
+									"defaultMode": 420,
+									"secretName":  "gatekeeper-webhook-server-cert",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceGatekeeperControllerManager(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceGatekeeperAudit creates the Deployment resource with name gatekeeper-audit.
+func CreateDeploymentNamespaceGatekeeperAudit(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-audit",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-audit",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "gatekeeper-audit",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "gatekeeper-audit",
+							"platform.nukleros.io/group":   "policy",
+							"platform.nukleros.io/project": "gatekeeper",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName":           "gatekeeper-admin",
+						"automountServiceAccountToken": true,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"priorityClassName":             "system-cluster-critical",
+						"terminationGracePeriodSeconds": 60,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "manager",
+								// controlled by field: engine.gatekeeper.image
+								// controlled by field: engine.gatekeeper.version
+								//  Image repo and name to use for gatekeeper.
+								//  Version of gatekeeper to use.
+								"image":           "" + parent.Spec.Engine.Gatekeeper.Image + ":" + parent.Spec.Engine.Gatekeeper.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"/manager",
+								},
+								"args": []interface{}{
+									"--audit-interval=60",
+									"--log-level=INFO",
+									"--constraint-violations-limit=20",
+									"--audit-from-cache=false",
+									"--audit-chunk-size=500",
+									"--audit-match-kind-only=false",
+									"--emit-audit-events=false",
+									"--operation=audit",
+									"--operation=status",
+									"--logtostderr",
+									"--health-addr=:9090",
+									"--prometheus-port=8888",
+									"--disable-cert-rotation",
+									"--disable-opa-builtin={http.send}",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"apiVersion": "v1",
+												"fieldPath":  "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"apiVersion": "v1",
+												"fieldPath":  "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "CONTAINER_NAME",
+										"value": "manager",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 8888,
+										"name":          "metrics",
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 9090,
+										"name":          "healthz",
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": 9090,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/readyz",
+										"port": 9090,
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"readOnlyRootFilesystem": true,
+									"runAsGroup":             999,
+									"runAsNonRoot":           true,
+									"runAsUser":              1000,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "256Mi",
+									},
+									"limits": map[string]interface{}{
+										"memory": "512Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath": "/tmp/audit",
+										"name":      "tmp-volume",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "tmp-volume",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceGatekeeperAudit(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceGatekeeperAdmin creates the ServiceAccount resource with name gatekeeper-admin.
+func CreateServiceAccountNamespaceGatekeeperAdmin(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-admin",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-admin",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceGatekeeperAdmin(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=*,resources=*,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;patch;update;watch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=config.gatekeeper.sh,resources=*,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=*,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=externaldata.gatekeeper.sh,resources=*,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=status.gatekeeper.sh,resources=*,verbs=create;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=*,verbs=create;delete;get;list;patch;update;watch
+
+// CreateClusterRoleGatekeeperManagerRole creates the ClusterRole resource with name gatekeeper-manager-role.
+func CreateClusterRoleGatekeeperManagerRole(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-admin",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "gatekeeper-manager-role",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"*",
+					},
+					"resources": []interface{}{
+						"*",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"admissionregistration.k8s.io",
+					},
+					"resources": []interface{}{
+						"validatingwebhookconfigurations",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apiextensions.k8s.io",
+					},
+					"resources": []interface{}{
+						"customresourcedefinitions",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"config.gatekeeper.sh",
+						"constraints.gatekeeper.sh",
+						"externaldata.gatekeeper.sh",
+						"status.gatekeeper.sh",
+						"templates.gatekeeper.sh",
+					},
+					"resources": []interface{}{
+						"*",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleGatekeeperManagerRole(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingGatekeeperManagerRolebinding creates the ClusterRoleBinding resource with name gatekeeper-manager-rolebinding.
+func CreateClusterRoleBindingGatekeeperManagerRolebinding(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-admin",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "gatekeeper-manager-rolebinding",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "gatekeeper-manager-role",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "gatekeeper-admin",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingGatekeeperManagerRolebinding(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=create;delete;get;list;patch;update;watch
+
+// CreateRoleNamespaceGatekeeperManagerRole creates the Role resource with name gatekeeper-manager-role.
+func CreateRoleNamespaceGatekeeperManagerRole(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "Role",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-admin",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-manager-role",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"secrets",
+					},
+					"verbs": []interface{}{
+						"create",
+						"delete",
+						"get",
+						"list",
+						"patch",
+						"update",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleNamespaceGatekeeperManagerRole(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRoleBindingNamespaceGatekeeperManagerRolebinding creates the RoleBinding resource with name gatekeeper-manager-rolebinding.
+func CreateRoleBindingNamespaceGatekeeperManagerRolebinding(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-admin",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-manager-rolebinding",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "Role",
+				"name":     "gatekeeper-manager-role",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "gatekeeper-admin",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleBindingNamespaceGatekeeperManagerRolebinding(resourceObj, parent, collection, reconciler, req)
+}
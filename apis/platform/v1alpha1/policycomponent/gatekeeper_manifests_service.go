@@ -0,0 +1,104 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceGatekeeperWebhookService creates the Service resource with name gatekeeper-webhook-service.
+func CreateServiceNamespaceGatekeeperWebhookService(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-controller-manager",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-webhook-service",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "https-webhook-server",
+						"port":       443,
+						"targetPort": "webhook-server",
+					},
+				},
+				"selector": map[string]interface{}{
+					"app.kubernetes.io/name": "gatekeeper-controller-manager",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceGatekeeperWebhookService(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateSecretNamespaceGatekeeperWebhookServerCert creates the Secret resource with name gatekeeper-webhook-server-cert.
+func CreateSecretNamespaceGatekeeperWebhookServerCert(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-controller-manager",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name":      "gatekeeper-webhook-server-cert",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateSecretNamespaceGatekeeperWebhookServerCert(resourceObj, parent, collection, reconciler, req)
+}
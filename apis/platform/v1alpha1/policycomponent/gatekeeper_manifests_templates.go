@@ -0,0 +1,292 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=constrainttemplates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConstraintTemplateRequireresourcelimits creates the ConstraintTemplate resource with name requireresourcelimits.
+func CreateConstraintTemplateRequireresourcelimits(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RequireResourceLimits.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.requireResourceLimits.enabled,value=true,include
+			"apiVersion": "templates.gatekeeper.sh/v1",
+			"kind":       "ConstraintTemplate",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"description": "Requires every container to set memory and CPU limits.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "requireresourcelimits",
+			},
+			"spec": map[string]interface{}{
+				"crd": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"names": map[string]interface{}{
+							"kind": "RequireResourceLimits",
+						},
+					},
+				},
+				"targets": []interface{}{
+					map[string]interface{}{
+						"target": "admission.k8s.gatekeeper.sh",
+						"rego": `package requireresourcelimits
+
+containers[container] {
+  container := input.review.object.spec.containers[_]
+}
+
+containers[container] {
+  container := input.review.object.spec.initContainers[_]
+}
+
+violation[{"msg": msg}] {
+  container := containers[_]
+  not container.resources.limits.cpu
+  msg := sprintf("container <%v> has no cpu limit", [container.name])
+}
+
+violation[{"msg": msg}] {
+  container := containers[_]
+  not container.resources.limits.memory
+  msg := sprintf("container <%v> has no memory limit", [container.name])
+}`,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateConstraintTemplateRequireresourcelimits(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=constrainttemplates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConstraintTemplateDisallowlatesttag creates the ConstraintTemplate resource with name disallowlatesttag.
+func CreateConstraintTemplateDisallowlatesttag(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.DisallowLatestTag.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.disallowLatestTag.enabled,value=true,include
+			"apiVersion": "templates.gatekeeper.sh/v1",
+			"kind":       "ConstraintTemplate",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"description": "Requires every image to set a tag or digest other than latest.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "disallowlatesttag",
+			},
+			"spec": map[string]interface{}{
+				"crd": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"names": map[string]interface{}{
+							"kind": "DisallowLatestTag",
+						},
+					},
+				},
+				"targets": []interface{}{
+					map[string]interface{}{
+						"target": "admission.k8s.gatekeeper.sh",
+						"rego":   "package disallowlatesttag\n\ncontainers[container] {\n  container := input.review.object.spec.containers[_]\n}\n\ncontainers[container] {\n  container := input.review.object.spec.initContainers[_]\n}\n\nviolation[{\"msg\": msg}] {\n  container := containers[_]\n  not regex.match(`:[^/]+$`, container.image)\n  msg := sprintf(\"container <%v> has no image tag or digest\", [container.name])\n}\n\nviolation[{\"msg\": msg}] {\n  container := containers[_]\n  endswith(container.image, \":latest\")\n  msg := sprintf(\"container <%v> uses the mutable latest tag\", [container.name])\n}",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateConstraintTemplateDisallowlatesttag(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=constrainttemplates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConstraintTemplateRestricthostpaths creates the ConstraintTemplate resource with name restricthostpaths.
+func CreateConstraintTemplateRestricthostpaths(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RestrictHostPaths.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.restrictHostPaths.enabled,value=true,include
+			"apiVersion": "templates.gatekeeper.sh/v1",
+			"kind":       "ConstraintTemplate",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"description": "Only allows hostPath volumes which mount one of the allowed paths.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "restricthostpaths",
+			},
+			"spec": map[string]interface{}{
+				"crd": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"names": map[string]interface{}{
+							"kind": "RestrictHostPaths",
+						},
+						"validation": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"allowedPaths": map[string]interface{}{
+										"type": "array",
+										"items": map[string]interface{}{
+											"type": "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"targets": []interface{}{
+					map[string]interface{}{
+						"target": "admission.k8s.gatekeeper.sh",
+						"rego": `package restricthostpaths
+
+allowed(path) {
+  input.parameters.allowedPaths[_] == path
+}
+
+violation[{"msg": msg}] {
+  volume := input.review.object.spec.volumes[_]
+  volume.hostPath
+  not allowed(volume.hostPath.path)
+  msg := sprintf("volume <%v> mounts host path <%v> which is not allowed", [volume.name, volume.hostPath.path])
+}`,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateConstraintTemplateRestricthostpaths(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=constrainttemplates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConstraintTemplateRequireplatformlabels creates the ConstraintTemplate resource with name requireplatformlabels.
+func CreateConstraintTemplateRequireplatformlabels(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" || parent.Spec.Policies.RequirePlatformLabels.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			// +operator-builder:resource:field=policies.requirePlatformLabels.enabled,value=true,include
+			"apiVersion": "templates.gatekeeper.sh/v1",
+			"kind":       "ConstraintTemplate",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"description": "Requires every workload to set the platform labels.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "requireplatformlabels",
+			},
+			"spec": map[string]interface{}{
+				"crd": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"names": map[string]interface{}{
+							"kind": "RequirePlatformLabels",
+						},
+						"validation": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"labels": map[string]interface{}{
+										"type": "array",
+										"items": map[string]interface{}{
+											"type": "string",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"targets": []interface{}{
+					map[string]interface{}{
+						"target": "admission.k8s.gatekeeper.sh",
+						"rego": `package requireplatformlabels
+
+violation[{"msg": msg}] {
+  label := input.parameters.labels[_]
+  not input.review.object.metadata.labels[label]
+  msg := sprintf("the <%v> label is required", [label])
+}
+`,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateConstraintTemplateRequireplatformlabels(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateValidatingWebhookGatekeeperValidatingWebhookConfiguration creates the ValidatingWebhookConfiguration resource with name gatekeeper-validating-webhook-configuration.
+func CreateValidatingWebhookGatekeeperValidatingWebhookConfiguration(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="gatekeeper",include
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "gatekeeper-controller-manager",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "gatekeeper",
+				},
+				"name": "gatekeeper-validating-webhook-configuration",
+			},
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name": "validation.gatekeeper.sh",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "gatekeeper-webhook-service",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/v1/admit",
+						},
+					},
+					"failurePolicy": "Ignore",
+					"matchPolicy":   "Exact",
+					"namespaceSelector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "admission.gatekeeper.sh/ignore",
+								"operator": "DoesNotExist",
+							},
+						},
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"*",
+							},
+							"apiVersions": []interface{}{
+								"*",
+							},
+							"operations": []interface{}{
+								"CREATE",
+								"UPDATE",
+							},
+							"resources": []interface{}{
+								"*",
+								"pods/ephemeralcontainers",
+								"pods/exec",
+								"pods/log",
+								"pods/eviction",
+								"pods/portforward",
+								"pods/proxy",
+								"pods/attach",
+								"pods/binding",
+								"deployments/scale",
+								"replicasets/scale",
+								"statefulsets/scale",
+								"replicationcontrollers/scale",
+								"services/proxy",
+								"nodes/proxy",
+								"services/status",
+							},
+						},
+					},
+					"sideEffects":    "None",
+					"timeoutSeconds": 3,
+				},
+				map[string]interface{}{
+					"name": "check-ignore-label.gatekeeper.sh",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "gatekeeper-webhook-service",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/v1/admitlabel",
+						},
+					},
+					"failurePolicy": "Fail",
+					"matchPolicy":   "Exact",
+					"rules": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{
+								"",
+							},
+							"apiVersions": []interface{}{
+								"*",
+							},
+							"operations": []interface{}{
+								"CREATE",
+								"UPDATE",
+							},
+							"resources": []interface{}{
+								"namespaces",
+							},
+						},
+					},
+					"sideEffects":    "None",
+					"timeoutSeconds": 3,
+				},
+			},
+		},
+	}
+
+	return mutate.MutateValidatingWebhookGatekeeperValidatingWebhookConfiguration(resourceObj, parent, collection, reconciler, req)
+}
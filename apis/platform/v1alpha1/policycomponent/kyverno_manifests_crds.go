@@ -0,0 +1,616 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDClusterpoliciesKyvernoIo creates the CustomResourceDefinition resource with name clusterpolicies.kyverno.io.
+func CreateCRDClusterpoliciesKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "clusterpolicies.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "ClusterPolicy",
+					"listKind": "ClusterPolicyList",
+					"plural":   "clusterpolicies",
+					"shortNames": []interface{}{
+						"cpol",
+					},
+					"singular": "clusterpolicy",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDClusterpoliciesKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPoliciesKyvernoIo creates the CustomResourceDefinition resource with name policies.kyverno.io.
+func CreateCRDPoliciesKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "policies.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "Policy",
+					"listKind": "PolicyList",
+					"plural":   "policies",
+					"shortNames": []interface{}{
+						"pol",
+					},
+					"singular": "policy",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPoliciesKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDUpdaterequestsKyvernoIo creates the CustomResourceDefinition resource with name updaterequests.kyverno.io.
+func CreateCRDUpdaterequestsKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "updaterequests.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "UpdateRequest",
+					"listKind": "UpdateRequestList",
+					"plural":   "updaterequests",
+					"shortNames": []interface{}{
+						"ur",
+					},
+					"singular": "updaterequest",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1beta1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDUpdaterequestsKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDAdmissionreportsKyvernoIo creates the CustomResourceDefinition resource with name admissionreports.kyverno.io.
+func CreateCRDAdmissionreportsKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "admissionreports.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "AdmissionReport",
+					"listKind": "AdmissionReportList",
+					"plural":   "admissionreports",
+					"shortNames": []interface{}{
+						"admr",
+					},
+					"singular": "admissionreport",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDAdmissionreportsKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDClusteradmissionreportsKyvernoIo creates the CustomResourceDefinition resource with name clusteradmissionreports.kyverno.io.
+func CreateCRDClusteradmissionreportsKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "clusteradmissionreports.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "ClusterAdmissionReport",
+					"listKind": "ClusterAdmissionReportList",
+					"plural":   "clusteradmissionreports",
+					"shortNames": []interface{}{
+						"cadmr",
+					},
+					"singular": "clusteradmissionreport",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDClusteradmissionreportsKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDBackgroundscanreportsKyvernoIo creates the CustomResourceDefinition resource with name backgroundscanreports.kyverno.io.
+func CreateCRDBackgroundscanreportsKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "backgroundscanreports.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "BackgroundScanReport",
+					"listKind": "BackgroundScanReportList",
+					"plural":   "backgroundscanreports",
+					"shortNames": []interface{}{
+						"bgscanr",
+					},
+					"singular": "backgroundscanreport",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDBackgroundscanreportsKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDClusterbackgroundscanreportsKyvernoIo creates the CustomResourceDefinition resource with name clusterbackgroundscanreports.kyverno.io.
+func CreateCRDClusterbackgroundscanreportsKyvernoIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "clusterbackgroundscanreports.kyverno.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "kyverno.io",
+				"names": map[string]interface{}{
+					"categories": []interface{}{
+						"kyverno",
+					},
+					"kind":     "ClusterBackgroundScanReport",
+					"listKind": "ClusterBackgroundScanReportList",
+					"plural":   "clusterbackgroundscanreports",
+					"shortNames": []interface{}{
+						"cbgscanr",
+					},
+					"singular": "clusterbackgroundscanreport",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDClusterbackgroundscanreportsKyvernoIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDPolicyreportsWgpolicyk8sIo creates the CustomResourceDefinition resource with name policyreports.wgpolicyk8s.io.
+func CreateCRDPolicyreportsWgpolicyk8sIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "policyreports.wgpolicyk8s.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "wgpolicyk8s.io",
+				"names": map[string]interface{}{
+					"kind":     "PolicyReport",
+					"listKind": "PolicyReportList",
+					"plural":   "policyreports",
+					"shortNames": []interface{}{
+						"polr",
+					},
+					"singular": "policyreport",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDPolicyreportsWgpolicyk8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDClusterpolicyreportsWgpolicyk8sIo creates the CustomResourceDefinition resource with name clusterpolicyreports.wgpolicyk8s.io.
+func CreateCRDClusterpolicyreportsWgpolicyk8sIo(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "clusterpolicyreports.wgpolicyk8s.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "wgpolicyk8s.io",
+				"names": map[string]interface{}{
+					"kind":     "ClusterPolicyReport",
+					"listKind": "ClusterPolicyReportList",
+					"plural":   "clusterpolicyreports",
+					"shortNames": []interface{}{
+						"cpolr",
+					},
+					"singular": "clusterpolicyreport",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1alpha2",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type":                                 "object",
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDClusterpolicyreportsWgpolicyk8sIo(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceKyverno creates the Deployment resource with name kyverno.
+func CreateDeploymentNamespaceKyverno(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kyverno",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name":      "kyverno",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "kyverno",
+					},
+				},
+				"strategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxSurge":       1,
+						"maxUnavailable": "40%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "kyverno",
+							"platform.nukleros.io/group":   "policy",
+							"platform.nukleros.io/project": "kyverno",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "kyverno",
+						"affinity": map[string]interface{}{
+							"podAntiAffinity": map[string]interface{}{
+								"preferredDuringSchedulingIgnoredDuringExecution": []interface{}{
+									map[string]interface{}{
+										"weight": 1,
+										"podAffinityTerm": map[string]interface{}{
+											"labelSelector": map[string]interface{}{
+												"matchExpressions": []interface{}{
+													map[string]interface{}{
+														"key":      "app.kubernetes.io/name",
+														"operator": "In",
+														"values": []interface{}{
+															"kyverno",
+														},
+													},
+												},
+											},
+											"topologyKey": "kubernetes.io/hostname",
+										},
+									},
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "kyverno",
+								// controlled by field: engine.kyverno.image
+								// controlled by field: engine.kyverno.version
+								//  Image repo and name to use for kyverno.
+								//  Version of kyverno to use.
+								"image":           "" + parent.Spec.Engine.Kyverno.Image + ":" + parent.Spec.Engine.Kyverno.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--autogenInternals=true",
+									"--loggingFormat=text",
+									"-v=2",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "INIT_CONFIG",
+										"value": "kyverno",
+									},
+									map[string]interface{}{
+										"name":  "METRICS_CONFIG",
+										"value": "kyverno-metrics",
+									},
+									map[string]interface{}{
+										"name": "KYVERNO_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "KYVERNO_POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "KYVERNO_SERVICEACCOUNT_NAME",
+										"value": "kyverno",
+									},
+									map[string]interface{}{
+										"name":  "KYVERNO_SVC",
+										"value": "kyverno-svc",
+									},
+									map[string]interface{}{
+										"name":  "KYVERNO_DEPLOYMENT",
+										"value": "kyverno",
+									},
+									map[string]interface{}{
+										"name":  "TUF_ROOT",
+										"value": "/.sigstore",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 9443,
+										"name":          "https",
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 8000,
+										"name":          "metrics-port",
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path":   "/health/liveness",
+										"port":   9443,
+										"scheme": "HTTPS",
+									},
+									"initialDelaySeconds": 15,
+									"periodSeconds":       30,
+									"timeoutSeconds":      5,
+									"failureThreshold":    2,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path":   "/health/readiness",
+										"port":   9443,
+										"scheme": "HTTPS",
+									},
+									"initialDelaySeconds": 5,
+									"periodSeconds":       10,
+									"timeoutSeconds":      5,
+									"failureThreshold":    6,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+									"limits": map[string]interface{}{
+										"memory": "384Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+									"privileged":             false,
+									"readOnlyRootFilesystem": true,
+									"runAsNonRoot":           true,
+									"seccompProfile": map[string]interface{}{
+										"type": "RuntimeDefault",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath": "/.sigstore",
+										"name":      "sigstore",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "sigstore",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceKyverno(resourceObj, parent, collection, reconciler, req)
+}
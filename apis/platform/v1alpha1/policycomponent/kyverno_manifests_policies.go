@@ -0,0 +1,435 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policycomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=kyverno.io,resources=clusterpolicies,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterPolicyRequireResourceLimits creates the ClusterPolicy resource with name require-resource-limits.
+func CreateClusterPolicyRequireResourceLimits(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" || parent.Spec.Policies.RequireResourceLimits.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			// +operator-builder:resource:field=policies.requireResourceLimits.enabled,value=true,include
+			"apiVersion": "kyverno.io/v1",
+			"kind":       "ClusterPolicy",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"policies.kyverno.io/title":       "Require Resource Limits",
+					"policies.kyverno.io/category":    "Best Practices",
+					"policies.kyverno.io/severity":    "medium",
+					"policies.kyverno.io/subject":     "Pod",
+					"policies.kyverno.io/description": "Containers without memory and CPU limits are able to starve the other workloads of their node.  This policy requires every container to set both limits.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kyverno",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "require-resource-limits",
+			},
+			"spec": map[string]interface{}{
+				"validationFailureAction": "audit",
+				"background":              true,
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name": "require-limits",
+						"match": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"kinds": []interface{}{
+											"Pod",
+										},
+									},
+								},
+							},
+						},
+						"exclude": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"namespaces": []interface{}{
+											"kube-system",
+										},
+									},
+								},
+							},
+						},
+						"validate": map[string]interface{}{
+							"message": "CPU and memory limits are required for all containers.",
+							"pattern": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"=(initContainers)": []interface{}{
+										map[string]interface{}{
+											"resources": map[string]interface{}{
+												"limits": map[string]interface{}{
+													"cpu":    "?*",
+													"memory": "?*",
+												},
+											},
+										},
+									},
+									"containers": []interface{}{
+										map[string]interface{}{
+											"resources": map[string]interface{}{
+												"limits": map[string]interface{}{
+													"cpu":    "?*",
+													"memory": "?*",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterPolicyRequireResourceLimits(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=kyverno.io,resources=clusterpolicies,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterPolicyDisallowLatestTag creates the ClusterPolicy resource with name disallow-latest-tag.
+func CreateClusterPolicyDisallowLatestTag(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" || parent.Spec.Policies.DisallowLatestTag.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			// +operator-builder:resource:field=policies.disallowLatestTag.enabled,value=true,include
+			"apiVersion": "kyverno.io/v1",
+			"kind":       "ClusterPolicy",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"policies.kyverno.io/title":       "Disallow Latest Tag",
+					"policies.kyverno.io/category":    "Best Practices",
+					"policies.kyverno.io/severity":    "medium",
+					"policies.kyverno.io/subject":     "Pod",
+					"policies.kyverno.io/description": "The latest tag is mutable and may pull a different image whenever a pod is started.  This policy requires every image to set a tag or digest other than latest.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kyverno",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "disallow-latest-tag",
+			},
+			"spec": map[string]interface{}{
+				"validationFailureAction": "audit",
+				"background":              true,
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name": "require-image-tag",
+						"match": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"kinds": []interface{}{
+											"Pod",
+										},
+									},
+								},
+							},
+						},
+						"exclude": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"namespaces": []interface{}{
+											"kube-system",
+										},
+									},
+								},
+							},
+						},
+						"validate": map[string]interface{}{
+							"message": "An image tag or digest is required for all containers.",
+							"pattern": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"=(initContainers)": []interface{}{
+										map[string]interface{}{
+											"image": "*:*",
+										},
+									},
+									"containers": []interface{}{
+										map[string]interface{}{
+											"image": "*:*",
+										},
+									},
+								},
+							},
+						},
+					},
+					map[string]interface{}{
+						"name": "validate-image-tag",
+						"match": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"kinds": []interface{}{
+											"Pod",
+										},
+									},
+								},
+							},
+						},
+						"exclude": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"namespaces": []interface{}{
+											"kube-system",
+										},
+									},
+								},
+							},
+						},
+						"validate": map[string]interface{}{
+							"message": "Using a mutable image tag such as latest is not allowed.",
+							"pattern": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"=(initContainers)": []interface{}{
+										map[string]interface{}{
+											"image": "!*:latest",
+										},
+									},
+									"containers": []interface{}{
+										map[string]interface{}{
+											"image": "!*:latest",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterPolicyDisallowLatestTag(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=kyverno.io,resources=clusterpolicies,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterPolicyRestrictHostPaths creates the ClusterPolicy resource with name restrict-host-paths.
+func CreateClusterPolicyRestrictHostPaths(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" || parent.Spec.Policies.RestrictHostPaths.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			// +operator-builder:resource:field=policies.restrictHostPaths.enabled,value=true,include
+			"apiVersion": "kyverno.io/v1",
+			"kind":       "ClusterPolicy",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"policies.kyverno.io/title":       "Restrict Host Paths",
+					"policies.kyverno.io/category":    "Pod Security",
+					"policies.kyverno.io/severity":    "high",
+					"policies.kyverno.io/subject":     "Pod,Volume",
+					"policies.kyverno.io/description": "HostPath volumes expose the filesystem of the node to the pod.  This policy only allows hostPath volumes which mount one of the allowed paths.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kyverno",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "restrict-host-paths",
+			},
+			"spec": map[string]interface{}{
+				"validationFailureAction": "audit",
+				"background":              true,
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name": "allowed-host-paths",
+						"match": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"kinds": []interface{}{
+											"Pod",
+										},
+									},
+								},
+							},
+						},
+						"exclude": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"namespaces": []interface{}{
+											"kube-system",
+										},
+									},
+								},
+							},
+						},
+						"preconditions": map[string]interface{}{
+							"all": []interface{}{
+								map[string]interface{}{
+									"key":      "{{ request.object.spec.volumes[?hostPath] || `[]` | length(@) }}",
+									"operator": "GreaterThanOrEquals",
+									"value":    1,
+								},
+							},
+						},
+						"validate": map[string]interface{}{
+							"message": "HostPath volumes may only mount one of the allowed paths.",
+							"foreach": []interface{}{
+								map[string]interface{}{
+									"list": "request.object.spec.volumes[?hostPath]",
+									"deny": map[string]interface{}{
+										"conditions": map[string]interface{}{
+											"any": []interface{}{
+												map[string]interface{}{
+													"key":      "{{ element.hostPath.path }}",
+													"operator": "AnyNotIn",
+													"value":    []interface{}{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterPolicyRestrictHostPaths(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=kyverno.io,resources=clusterpolicies,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterPolicyRequirePlatformLabels creates the ClusterPolicy resource with name require-platform-labels.
+func CreateClusterPolicyRequirePlatformLabels(
+	parent *platformv1alpha1.PolicyComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Engine.Type != "kyverno" || parent.Spec.Policies.RequirePlatformLabels.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=engine.type,value="kyverno",include
+			// +operator-builder:resource:field=policies.requirePlatformLabels.enabled,value=true,include
+			"apiVersion": "kyverno.io/v1",
+			"kind":       "ClusterPolicy",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"policies.kyverno.io/title":       "Require Platform Labels",
+					"policies.kyverno.io/category":    "Best Practices",
+					"policies.kyverno.io/severity":    "low",
+					"policies.kyverno.io/subject":     "Deployment,StatefulSet,DaemonSet",
+					"policies.kyverno.io/description": "The platform labels identify the group and project which a workload belongs to.  This policy requires every workload to set both labels.",
+				},
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "kyverno",
+					"platform.nukleros.io/group":   "policy",
+					"platform.nukleros.io/project": "kyverno",
+				},
+				"name": "require-platform-labels",
+			},
+			"spec": map[string]interface{}{
+				"validationFailureAction": "audit",
+				"background":              true,
+				"rules": []interface{}{
+					map[string]interface{}{
+						"name": "require-labels",
+						"match": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"kinds": []interface{}{
+											"Deployment",
+											"StatefulSet",
+											"DaemonSet",
+										},
+									},
+								},
+							},
+						},
+						"exclude": map[string]interface{}{
+							"any": []interface{}{
+								map[string]interface{}{
+									"resources": map[string]interface{}{
+										"namespaces": []interface{}{
+											"kube-system",
+										},
+									},
+								},
+							},
+						},
+						"validate": map[string]interface{}{
+							"message": "The platform.nukleros.io/group and platform.nukleros.io/project labels are required.",
+							"pattern": map[string]interface{}{
+								"metadata": map[string]interface{}{
+									"labels": map[string]interface{}{
+										"platform.nukleros.io/group":   "?*",
+										"platform.nukleros.io/project": "?*",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterPolicyRequirePlatformLabels(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+var ErrUnknownPolicy = errors.New("unknown policy")
+
+const restrictHostPaths = "restrict-host-paths"
+
+// enforcementActions are the gatekeeper enforcement actions for each policy mode.
+var enforcementActions = map[string]string{
+	tier.PolicyModeEnforce: "deny",
+	tier.PolicyModeAudit:   "dryrun",
+}
+
+// policyFor returns the settings of the named baseline policy.
+func policyFor(parent *platformv1alpha1.PolicyComponent, name string) (platformv1alpha1.PolicyComponentSpecPolicy, error) {
+	switch name {
+	case "require-resource-limits":
+		return parent.Spec.Policies.RequireResourceLimits, nil
+	case "disallow-latest-tag":
+		return parent.Spec.Policies.DisallowLatestTag, nil
+	case restrictHostPaths:
+		return parent.Spec.Policies.RestrictHostPaths.PolicyComponentSpecPolicy, nil
+	case "require-platform-labels":
+		return parent.Spec.Policies.RequirePlatformLabels, nil
+	}
+
+	return platformv1alpha1.PolicyComponentSpecPolicy{}, fmt.Errorf("%w %s", ErrUnknownPolicy, name)
+}
+
+// policyMode returns the mode of the named baseline policy, which defaults to the policy mode of
+// the tier of the collection.
+func policyMode(
+	parent *platformv1alpha1.PolicyComponent, collection *setupv1alpha1.SupportServices,
+	name string,
+) (string, error) {
+	policy, err := policyFor(parent, name)
+	if err != nil {
+		return "", err
+	}
+
+	if policy.Mode != "" {
+		return policy.Mode, nil
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return "", err
+	}
+
+	return profile.PolicyMode, nil
+}
+
+// defaultExcludedNamespaces are the namespaces which are exempt from the policies when the
+// component does not set its own.
+var defaultExcludedNamespaces = []string{"kube-system", "kube-public", "kube-node-lease", "nukleros-*"}
+
+// excludedNamespaces returns the namespaces which are exempt from the policies.
+func excludedNamespaces(parent *platformv1alpha1.PolicyComponent) []string {
+	if len(parent.Spec.Policies.ExcludedNamespaces) == 0 {
+		return defaultExcludedNamespaces
+	}
+
+	return parent.Spec.Policies.ExcludedNamespaces
+}
+
+// stringList returns a list of strings as a list of values of an unstructured object.
+func stringList(values []string) []interface{} {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+
+	return list
+}
+
+// setClusterPolicy sets the validation failure action and the excluded namespaces of a kyverno
+// cluster policy, and the allowed paths of the host path policy.
+func setClusterPolicy(
+	original client.Object,
+	parent *platformv1alpha1.PolicyComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	policy, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	mode, err := policyMode(parent, collection, original.GetName())
+	if err != nil {
+		return err
+	}
+
+	if err := unstructured.SetNestedField(policy.Object, mode, "spec", "validationFailureAction"); err != nil {
+		return fmt.Errorf("unable to set validation failure action of %s, %w", original.GetName(), err)
+	}
+
+	spec, _ := policy.Object["spec"].(map[string]interface{})
+	rules, _ := spec["rules"].([]interface{})
+
+	for i := range rules {
+		rule, ok := rules[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule["exclude"] = map[string]interface{}{
+			"any": []interface{}{
+				map[string]interface{}{
+					"resources": map[string]interface{}{
+						"namespaces": stringList(excludedNamespaces(parent)),
+					},
+				},
+			},
+		}
+
+		if original.GetName() == restrictHostPaths {
+			setAllowedPaths(rule, parent.Spec.Policies.RestrictHostPaths.AllowedPaths)
+		}
+	}
+
+	return nil
+}
+
+// setAllowedPaths sets the allowed paths of a rule of the kyverno host path policy, which denies
+// any hostPath volume whose path is not one of the allowed paths.
+func setAllowedPaths(rule map[string]interface{}, paths []string) {
+	validate, _ := rule["validate"].(map[string]interface{})
+	foreach, _ := validate["foreach"].([]interface{})
+
+	for i := range foreach {
+		entry, _ := foreach[i].(map[string]interface{})
+		deny, _ := entry["deny"].(map[string]interface{})
+		conditions, _ := deny["conditions"].(map[string]interface{})
+		any, _ := conditions["any"].([]interface{})
+
+		for j := range any {
+			if condition, ok := any[j].(map[string]interface{}); ok {
+				condition["value"] = stringList(paths)
+			}
+		}
+	}
+}
+
+// setConstraint sets the enforcement action and the excluded namespaces of a gatekeeper
+// constraint, and the allowed paths of the host path constraint.
+func setConstraint(
+	original client.Object,
+	parent *platformv1alpha1.PolicyComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	constraint, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	mode, err := policyMode(parent, collection, original.GetName())
+	if err != nil {
+		return err
+	}
+
+	if err := unstructured.SetNestedField(
+		constraint.Object, enforcementActions[mode], "spec", "enforcementAction",
+	); err != nil {
+		return fmt.Errorf("unable to set enforcement action of %s, %w", original.GetName(), err)
+	}
+
+	if err := unstructured.SetNestedSlice(
+		constraint.Object, stringList(excludedNamespaces(parent)), "spec", "match", "excludedNamespaces",
+	); err != nil {
+		return fmt.Errorf("unable to set excluded namespaces of %s, %w", original.GetName(), err)
+	}
+
+	if original.GetName() != restrictHostPaths {
+		return nil
+	}
+
+	if err := unstructured.SetNestedSlice(
+		constraint.Object, stringList(parent.Spec.Policies.RestrictHostPaths.AllowedPaths), "spec", "parameters", "allowedPaths",
+	); err != nil {
+		return fmt.Errorf("unable to set allowed paths of %s, %w", original.GetName(), err)
+	}
+
+	return nil
+}
+
+// constraintServed returns whether the kind of a gatekeeper constraint is served.  The constraint
+// kinds are created by gatekeeper from the constraint templates, so they are not served until
+// gatekeeper is running and has processed the templates.
+func constraintServed(original client.Object, reconciler workload.Reconciler) (bool, error) {
+	gvk := original.GetObjectKind().GroupVersionKind()
+
+	if _, err := reconciler.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to map constraint kind %s, %w", gvk.Kind, err)
+	}
+
+	return true, nil
+}
+
+// kyvernoResourceFilters are the resources which kyverno ignores, in addition to those in the
+// namespace of kyverno.
+const kyvernoResourceFilters = "[Event,*,*][*,kube-system,*][*,kube-public,*][*,kube-node-lease,*]" +
+	"[Node,*,*][APIService,*,*][TokenReview,*,*][SubjectAccessReview,*,*][SelfSubjectAccessReview,*,*]" +
+	"[Binding,*,*][ReplicaSet,*,*][AdmissionReport,*,*][ClusterAdmissionReport,*,*]" +
+	"[BackgroundScanReport,*,*][ClusterBackgroundScanReport,*,*]"
+
+// setKyvernoConfig excludes the namespace of kyverno from the resources which kyverno validates
+// and from its webhooks, so that kyverno is never blocked by its own policies.
+func setKyvernoConfig(original client.Object, parent *platformv1alpha1.PolicyComponent) error {
+	config, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	webhooks, err := json.Marshal([]interface{}{
+		map[string]interface{}{
+			"namespaceSelector": map[string]interface{}{
+				"matchExpressions": []interface{}{
+					map[string]interface{}{
+						"key":      "kubernetes.io/metadata.name",
+						"operator": "NotIn",
+						"values":   []string{"kube-system", parent.Spec.Namespace},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal webhooks of %s, %w", original.GetName(), err)
+	}
+
+	return unstructured.SetNestedStringMap(config.Object, map[string]string{
+		"resourceFilters": kyvernoResourceFilters + fmt.Sprintf("[*,%s,*]", parent.Spec.Namespace),
+		"webhooks":        string(webhooks),
+	}, "data")
+}
+
+// gatekeeperIgnoreLabel is the label which exempts a namespace from the gatekeeper webhook.
+const gatekeeperIgnoreLabel = "admission.gatekeeper.sh/ignore"
+
+// setNamespaceLabels exempts the namespace of the component from the gatekeeper webhook, so that
+// gatekeeper is never blocked by its own constraints.
+func setNamespaceLabels(original client.Object, parent *platformv1alpha1.PolicyComponent) {
+	if parent.Spec.Engine.Type != "gatekeeper" {
+		return
+	}
+
+	labels := original.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels[gatekeeperIgnoreLabel] = "no-self-managing"
+
+	original.SetLabels(labels)
+}
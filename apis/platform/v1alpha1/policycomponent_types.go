@@ -0,0 +1,397 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertPolicyComponent = errors.New("unable to convert to PolicyComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PolicyComponentSpec defines the desired state of PolicyComponent.
+type PolicyComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection PolicyComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-policy-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-policy-system")
+	//
+	//	Namespace to use for policy support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Engine PolicyComponentSpecEngine `json:"engine,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Policies PolicyComponentSpecPolicies `json:"policies,omitempty"`
+}
+
+type PolicyComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type PolicyComponentSpecEngine struct {
+	// +kubebuilder:default="kyverno"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=kyverno;gatekeeper
+	// (Default: "kyverno")
+	//
+	//	Admission policy engine which enforces the policies.  One of: kyverno | gatekeeper.
+	Type string `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Kyverno PolicyComponentSpecEngineKyverno `json:"kyverno,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Gatekeeper PolicyComponentSpecEngineGatekeeper `json:"gatekeeper,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the admission controller of the engine.  Defaults to the
+	//	number of replicas of the tier of the collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the admission controller of the engine.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the admission controller pods of the engine.  Settings which are
+	//	set here take precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the admission controller container of the engine.
+	//	Requests and limits which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type PolicyComponentSpecEngineKyverno struct {
+	// +kubebuilder:default="ghcr.io/kyverno/kyverno"
+	// +kubebuilder:validation:Optional
+	// (Default: "ghcr.io/kyverno/kyverno")
+	//
+	//	Image repo and name to use for kyverno.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the kyverno image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.8.5"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.8.5")
+	//
+	//	Version of kyverno to use.
+	Version string `json:"version,omitempty"`
+}
+
+type PolicyComponentSpecEngineGatekeeper struct {
+	// +kubebuilder:default="openpolicyagent/gatekeeper"
+	// +kubebuilder:validation:Optional
+	// (Default: "openpolicyagent/gatekeeper")
+	//
+	//	Image repo and name to use for gatekeeper.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the gatekeeper image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v3.10.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v3.10.0")
+	//
+	//	Version of gatekeeper to use.
+	Version string `json:"version,omitempty"`
+}
+
+type PolicyComponentSpecPolicies struct {
+	// +kubebuilder:validation:Optional
+	//	Namespaces which are exempt from the policies.  A trailing * matches any namespace with
+	//	the given prefix.  Defaults to the kube-system, kube-public and kube-node-lease namespaces
+	//	and the namespaces of the support services (nukleros-*).
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Requires every container to set memory and CPU limits.
+	RequireResourceLimits PolicyComponentSpecPolicy `json:"requireResourceLimits,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Requires every image to set a tag or digest other than latest.
+	DisallowLatestTag PolicyComponentSpecPolicy `json:"disallowLatestTag,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Only allows hostPath volumes which mount one of the allowed paths.
+	RestrictHostPaths PolicyComponentSpecHostPathPolicy `json:"restrictHostPaths,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Requires every deployment, statefulset and daemonset to set the platform.nukleros.io/group
+	//	and platform.nukleros.io/project labels.
+	RequirePlatformLabels PolicyComponentSpecPolicy `json:"requirePlatformLabels,omitempty"`
+}
+
+type PolicyComponentSpecPolicy struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install the policy.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enforce;audit
+	//	Whether the policy rejects or only reports resources which violate it.  One of:
+	//	enforce | audit.  Defaults to the policy mode of the tier of the collection.
+	Mode string `json:"mode,omitempty"`
+}
+
+type PolicyComponentSpecHostPathPolicy struct {
+	PolicyComponentSpecPolicy `json:",inline"`
+
+	// +kubebuilder:validation:Optional
+	//	Host paths which hostPath volumes are allowed to mount.  Paths must match exactly, so no
+	//	hostPath volumes are allowed when this is empty.
+	AllowedPaths []string `json:"allowedPaths,omitempty"`
+}
+
+// PolicyComponentReport summarizes the results of the policies of the component.
+type PolicyComponentReport struct {
+	// Number of resources which passed a policy.  Only reported by kyverno.
+	Pass int `json:"pass"`
+
+	// Number of resources which violate a policy.
+	Fail int `json:"fail"`
+
+	// Number of resources which violate a policy which only warns.  Only reported by kyverno.
+	Warn int `json:"warn"`
+
+	// Number of resources which a policy was unable to evaluate.  Only reported by kyverno.
+	Error int `json:"error"`
+
+	// Number of resources which a policy skipped.  Only reported by kyverno.
+	Skip int `json:"skip"`
+
+	// Number of resources which violate each policy, keyed by the policy name.
+	Violations map[string]int `json:"violations,omitempty"`
+}
+
+type PolicyComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+
+	// Summary of the results of the policies, as reported by the engine.
+	PolicyReport *PolicyComponentReport `json:"policyReport,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// PolicyComponent is the Schema for the policycomponents API.
+type PolicyComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PolicyComponentSpec   `json:"spec,omitempty"`
+	Status            PolicyComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyComponentList contains a list of PolicyComponent.
+type PolicyComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *PolicyComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *PolicyComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *PolicyComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *PolicyComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *PolicyComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *PolicyComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *PolicyComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *PolicyComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *PolicyComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*PolicyComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*PolicyComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("PolicyComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *PolicyComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *PolicyComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name, after defaulting from the given tier profile.  Only the admission controller
+// of the engine is replicated.
+func (component *PolicyComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	return map[string]int{
+		component.AdmissionController(): profile.ReplicasFor(component.Spec.Engine.Replicas),
+	}
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *PolicyComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	return map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		component.AdmissionController(): component.Spec.Engine.PodDisruptionBudget,
+	}
+}
+
+// AdmissionController returns the name of the admission controller deployment of the engine.
+func (component *PolicyComponent) AdmissionController() string {
+	if component.Spec.Engine.Type == "gatekeeper" {
+		return "gatekeeper-controller-manager"
+	}
+
+	return "kyverno"
+}
+
+// GetPolicyReport returns the summary of the results of the policies of the component.
+func (component *PolicyComponent) GetPolicyReport() *PolicyComponentReport {
+	return component.Status.PolicyReport
+}
+
+// SetPolicyReport sets the summary of the results of the policies of the component.
+func (component *PolicyComponent) SetPolicyReport(report *PolicyComponentReport) {
+	component.Status.PolicyReport = report
+}
+
+func init() {
+	SchemeBuilder.Register(&PolicyComponent{}, &PolicyComponentList{})
+}
@@ -43,8 +43,11 @@ const (
 	ServiceNamespaceExternalSecretsWebhook               = "external-secrets-webhook"
 	ValidatingWebhookSecretstoreValidate                 = "secretstore-validate"
 	ValidatingWebhookExternalsecretValidate              = "externalsecret-validate"
+	PodMonitorNamespaceExternalSecrets                   = "external-secrets"
+	PrometheusRuleNamespaceExternalSecrets               = "external-secrets"
 	DeploymentNamespaceSecretReloader                    = "secret-reloader"
 	ServiceAccountNamespaceSecretReloader                = "secret-reloader"
 	ClusterRoleNamespaceSecretReloader                   = "secret-reloader"
 	ClusterRoleBindingNamespaceSecretReloader            = "secret-reloader"
+	CertNamespaceExternalSecretsWebhook                  = "external-secrets-webhook"
 )
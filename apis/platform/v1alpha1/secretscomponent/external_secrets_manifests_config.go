@@ -36,8 +36,12 @@ func CreateSecretNamespaceExternalSecretsWebhook(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() || parent.UsesCertManager() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.webhook.enabled,value=true,include
 			"apiVersion": "v1",
 			"kind":       "Secret",
 			"metadata": map[string]interface{}{
@@ -36,8 +36,12 @@ func CreateDeploymentNamespaceExternalSecretsCertController(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.CertController.IsEnabled() || parent.UsesCertManager() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.certController.enabled,value=true,include
 			"apiVersion": "apps/v1",
 			"kind":       "Deployment",
 			"metadata": map[string]interface{}{
@@ -293,8 +297,12 @@ func CreateDeploymentNamespaceExternalSecretsWebhook(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.webhook.enabled,value=true,include
 			"apiVersion": "apps/v1",
 			"kind":       "Deployment",
 			"metadata": map[string]interface{}{
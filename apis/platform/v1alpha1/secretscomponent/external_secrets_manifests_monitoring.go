@@ -0,0 +1,141 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretscomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/secretscomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePodMonitorNamespaceExternalSecrets creates the PodMonitor resource with name external-secrets.
+func CreatePodMonitorNamespaceExternalSecrets(
+	parent *platformv1alpha1.SecretsComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.enabled,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PodMonitor",
+			"metadata": map[string]interface{}{
+				"name":      "external-secrets",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "external-secrets",
+					"app.kubernetes.io/instance":   "external-secrets",
+					"platform.nukleros.io/group":   "secrets",
+					"platform.nukleros.io/project": "external-secrets",
+				},
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/instance": "external-secrets",
+					},
+				},
+				"podMetricsEndpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "metrics",
+						"path":     "/metrics",
+						"interval": "60s",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePodMonitorNamespaceExternalSecrets(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+
+// CreatePrometheusRuleNamespaceExternalSecrets creates the PrometheusRule resource with name external-secrets.
+func CreatePrometheusRuleNamespaceExternalSecrets(
+	parent *platformv1alpha1.SecretsComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Monitoring.Alerts != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=monitoring.alerts,value=true,include
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "PrometheusRule",
+			"metadata": map[string]interface{}{
+				"name":      "external-secrets",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "external-secrets",
+					"app.kubernetes.io/instance":   "external-secrets",
+					"platform.nukleros.io/group":   "secrets",
+					"platform.nukleros.io/project": "external-secrets",
+				},
+			},
+			"spec": map[string]interface{}{
+				"groups": []interface{}{
+					map[string]interface{}{
+						"name": "external-secrets",
+						"rules": []interface{}{
+							map[string]interface{}{
+								"alert": "ExternalSecretSyncErrors",
+								"expr":  "sum by (name, exported_namespace) (increase(externalsecret_sync_calls_error[10m])) > 0",
+								"for":   "15m",
+								"labels": map[string]interface{}{
+									"severity": "warning",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "External secret is failing to sync.",
+									"description": "The external secret {{ $labels.name }} in namespace {{ $labels.exported_namespace }} has failed to sync from its secret store for 15 minutes.",
+								},
+							},
+							map[string]interface{}{
+								"alert": "ExternalSecretNotReady",
+								"expr":  "max by (name, exported_namespace) (externalsecret_status_condition{condition=\"Ready\", status=\"False\"}) == 1",
+								"for":   "15m",
+								"labels": map[string]interface{}{
+									"severity": "critical",
+								},
+								"annotations": map[string]interface{}{
+									"summary":     "External secret is not ready.",
+									"description": "The external secret {{ $labels.name }} in namespace {{ $labels.exported_namespace }} has not been ready for 15 minutes.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutatePrometheusRuleNamespaceExternalSecrets(resourceObj, parent, collection, reconciler, req)
+}
@@ -36,8 +36,12 @@ func CreateServiceAccountNamespaceExternalSecretsCertController(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.CertController.IsEnabled() || parent.UsesCertManager() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.certController.enabled,value=true,include
 			"apiVersion": "v1",
 			"kind":       "ServiceAccount",
 			"metadata": map[string]interface{}{
@@ -96,8 +100,12 @@ func CreateServiceAccountNamespaceExternalSecretsWebhook(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.webhook.enabled,value=true,include
 			"apiVersion": "v1",
 			"kind":       "ServiceAccount",
 			"metadata": map[string]interface{}{
@@ -131,8 +139,12 @@ func CreateClusterRoleExternalSecretsCertController(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.CertController.IsEnabled() || parent.UsesCertManager() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.certController.enabled,value=true,include
 			"apiVersion": "rbac.authorization.k8s.io/v1",
 			"kind":       "ClusterRole",
 			"metadata": map[string]interface{}{
@@ -504,8 +516,12 @@ func CreateClusterRoleBindingExternalSecretsCertController(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.CertController.IsEnabled() || parent.UsesCertManager() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.certController.enabled,value=true,include
 			"apiVersion": "rbac.authorization.k8s.io/v1",
 			"kind":       "ClusterRoleBinding",
 			"metadata": map[string]interface{}{
@@ -36,8 +36,12 @@ func CreateValidatingWebhookSecretstoreValidate(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.webhook.enabled,value=true,include
 			"apiVersion": "admissionregistration.k8s.io/v1",
 			"kind":       "ValidatingWebhookConfiguration",
 			"metadata": map[string]interface{}{
@@ -135,8 +139,12 @@ func CreateValidatingWebhookExternalsecretValidate(
 	reconciler workload.Reconciler,
 	req *workload.Request,
 ) ([]client.Object, error) {
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() {
+		return []client.Object{}, nil
+	}
 	var resourceObj = &unstructured.Unstructured{
 		Object: map[string]interface{}{
+			// +operator-builder:resource:field=externalSecrets.webhook.enabled,value=true,include
 			"apiVersion": "admissionregistration.k8s.io/v1",
 			"kind":       "ValidatingWebhookConfiguration",
 			"metadata": map[string]interface{}{
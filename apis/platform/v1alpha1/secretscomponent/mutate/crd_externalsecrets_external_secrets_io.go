@@ -31,6 +31,12 @@ func MutateCRDExternalsecretsExternalSecretsIo(
 	parent *platformv1alpha1.SecretsComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// the conversion webhook is derived solely from the parent spec, so it is set
+	// regardless of whether we are reconciling or generating manifests from the CLI.
+	if err := setConversionStrategy(original, parent); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
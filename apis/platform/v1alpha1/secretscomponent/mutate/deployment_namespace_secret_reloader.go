@@ -17,12 +17,16 @@ limitations under the License.
 package mutate
 
 import (
+	"fmt"
+	"strings"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 
 	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
 )
 
 // MutateDeploymentNamespaceSecretReloader mutates the Deployment resource with name secret-reloader.
@@ -31,12 +35,81 @@ func MutateDeploymentNamespaceSecretReloader(
 	parent *platformv1alpha1.SecretsComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// reloader settings are derived solely from the parent spec, so they are applied
+	// regardless of whether we are reconciling or generating manifests from the CLI.
+	if err := setReloaderSettings(original, parent); err != nil {
+		return nil, err
+	}
+
+	// pin the image to its digest, if specified.
+	if err := podtemplate.SetImageDigest(original, "secret-reloader", parent.Spec.Reloader.Digest); err != nil {
+		return nil, err
+	}
+
+	// apply the settings which are common to all workloads of the component.
+	if err := mutateWorkload(original, parent, collection, parent.Spec.Reloader.Scheduling); err != nil {
+		return nil, err
+	}
+
+	// set the resources of the container, if specified.
+	if err := podtemplate.SetResources(original, "secret-reloader", parent.Spec.Reloader.Resources); err != nil {
+		return nil, err
+	}
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
 	}
 
+	// apply the settings which are common to all workloads of the component when reconciling.
+	if err := reconcileWorkload(original, collection, reconciler, req); err != nil {
+		return nil, err
+	}
+
 	// mutation logic goes here
 
 	return []client.Object{original}, nil
 }
+
+// setReloaderSettings translates the typed reloader settings from the parent spec into
+// arguments and environment variables on the reloader container.  The settings are merged into
+// the args and env of the manifest so that its defaults are preserved.
+func setReloaderSettings(original client.Object, parent *platformv1alpha1.SecretsComponent) error {
+	settings := parent.Spec.Reloader
+
+	args := [][2]string{}
+
+	if settings.NamespaceSelector != "" {
+		args = append(args, [2]string{"--namespace-selector", settings.NamespaceSelector})
+	}
+
+	if len(settings.NamespacesToIgnore) > 0 {
+		args = append(args, [2]string{"--namespaces-to-ignore", strings.Join(settings.NamespacesToIgnore, ",")})
+	}
+
+	if settings.ResourcesToIgnore != "" {
+		args = append(args, [2]string{"--resources-to-ignore", settings.ResourcesToIgnore})
+	}
+
+	if settings.AutoReloadAll {
+		args = append(args, [2]string{"--auto-reload-all", "true"})
+	}
+
+	if settings.LogFormat != "" {
+		args = append(args, [2]string{"--log-format", settings.LogFormat})
+	}
+
+	for _, arg := range args {
+		if err := podtemplate.SetArg(original, "secret-reloader", arg[0], arg[1]); err != nil {
+			return fmt.Errorf("unable to set reloader settings for deployment %s, %w", original.GetName(), err)
+		}
+	}
+
+	if settings.WatchNamespace != "" {
+		if err := podtemplate.SetEnv(original, "secret-reloader", "KUBERNETES_NAMESPACE", settings.WatchNamespace); err != nil {
+			return fmt.Errorf("unable to set reloader settings for deployment %s, %w", original.GetName(), err)
+		}
+	}
+
+	return nil
+}
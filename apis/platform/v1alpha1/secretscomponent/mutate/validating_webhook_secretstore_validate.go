@@ -31,6 +31,10 @@ func MutateValidatingWebhookSecretstoreValidate(
 	parent *platformv1alpha1.SecretsComponent, collection *setupv1alpha1.SupportServices,
 	reconciler workload.Reconciler, req *workload.Request,
 ) ([]client.Object, error) {
+	// ca injection is derived solely from the parent spec, so it is set regardless of
+	// whether we are reconciling or generating manifests from the CLI.
+	setCAInjection(original, parent)
+
 	// if either the reconciler or request are found to be nil, return the base object.
 	if reconciler == nil || req == nil {
 		return []client.Object{original}, nil
@@ -0,0 +1,74 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/secretscomponent/constants"
+	"github.com/nukleros/support-services-operator/internal/crdconversion"
+)
+
+// setConversionStrategy sets the conversion strategy for an external-secrets custom resource
+// definition.  When the external-secrets webhook is disabled, the conversion webhook is removed as
+// there is no service available to perform the conversion.  Otherwise the conversion webhook is
+// pointed at the webhook service and, when cert-manager issues the webhook certificate,
+// cert-manager is requested to inject its certificate authority.  The conversion of existing
+// definitions is updated by the crdconversion sync phase.
+func setConversionStrategy(original client.Object, parent *platformv1alpha1.SecretsComponent) error {
+	crd, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	if !parent.Spec.ExternalSecrets.Webhook.IsEnabled() {
+		return unstructured.SetNestedMap(crd.Object, map[string]interface{}{"strategy": "None"}, "spec", "conversion")
+	}
+
+	if err := unstructured.SetNestedField(
+		crd.Object,
+		parent.Spec.Namespace,
+		"spec", "conversion", "webhook", "clientConfig", "service", "namespace",
+	); err != nil {
+		return fmt.Errorf("unable to set conversion webhook namespace on %s, %w", original.GetName(), err)
+	}
+
+	setCAInjection(crd, parent)
+
+	return nil
+}
+
+// setCAInjection requests that cert-manager inject the certificate authority for the
+// external-secrets webhook certificate into the object, when cert-manager issues it.
+func setCAInjection(original client.Object, parent *platformv1alpha1.SecretsComponent) {
+	if !parent.UsesCertManager() {
+		return
+	}
+
+	annotations := original.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[crdconversion.CAInjectionAnnotation] = parent.Spec.Namespace + "/" + constants.CertNamespaceExternalSecretsWebhook
+
+	original.SetAnnotations(annotations)
+}
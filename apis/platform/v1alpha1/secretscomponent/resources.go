@@ -41,16 +41,31 @@ spec:
   externalSecrets:
     version: "v0.5.9"
     certController:
-      replicas: 1
+      enabled: true
+      #replicas: 1
     image: "ghcr.io/external-secrets/external-secrets"
+    #digest: ""
     controller:
-      replicas: 2
+      #replicas: 2
     webhook:
-      replicas: 2
+      enabled: true
+      #replicas: 2
+      useCertManager: false
   reloader:
+    enabled: true
     replicas: 1
     image: "stakater/reloader"
+    #digest: ""
     version: "v0.0.119"
+    #watchNamespace: ""
+    #namespaceSelector: ""
+    #namespacesToIgnore: []
+    #resourcesToIgnore: "configMaps"
+    autoReloadAll: false
+    #logFormat: "json"
+  monitoring:
+    enabled: false
+    alerts: false
 `
 
 // sampleSecretsComponentRequired is a sample containing only required fields
@@ -131,6 +146,7 @@ var CreateFuncs = []func(
 ) ([]client.Object, error){
 	CreateNamespaceNamespace,
 	CreateSecretNamespaceExternalSecretsWebhook,
+	CreateCertNamespaceExternalSecretsWebhook,
 	CreateCRDClusterexternalsecretsExternalSecretsIo,
 	CreateCRDClustersecretstoresExternalSecretsIo,
 	CreateCRDExternalsecretsExternalSecretsIo,
@@ -138,6 +154,9 @@ var CreateFuncs = []func(
 	CreateDeploymentNamespaceExternalSecretsCertController,
 	CreateDeploymentNamespaceExternalSecrets,
 	CreateDeploymentNamespaceExternalSecretsWebhook,
+	CreatePodDisruptionBudgetNamespaceExternalSecretsCertController,
+	CreatePodDisruptionBudgetNamespaceExternalSecrets,
+	CreatePodDisruptionBudgetNamespaceExternalSecretsWebhook,
 	CreateServiceAccountNamespaceExternalSecretsCertController,
 	CreateServiceAccountNamespaceExternalSecrets,
 	CreateServiceAccountNamespaceExternalSecretsWebhook,
@@ -152,6 +171,8 @@ var CreateFuncs = []func(
 	CreateServiceNamespaceExternalSecretsWebhook,
 	CreateValidatingWebhookSecretstoreValidate,
 	CreateValidatingWebhookExternalsecretValidate,
+	CreatePodMonitorNamespaceExternalSecrets,
+	CreatePrometheusRuleNamespaceExternalSecrets,
 	CreateDeploymentNamespaceSecretReloader,
 	CreateServiceAccountNamespaceSecretReloader,
 	CreateClusterRoleNamespaceSecretReloader,
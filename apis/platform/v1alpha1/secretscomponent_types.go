@@ -21,8 +21,11 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 )
 
 var ErrUnableToConvertSecretsComponent = errors.New("unable to convert to SecretsComponent")
@@ -55,6 +58,11 @@ type SecretsComponentSpec struct {
 
 	// +kubebuilder:validation:Optional
 	Reloader SecretsComponentSpecReloader `json:"reloader,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Monitoring resources for external-secrets.  Requires the prometheus-operator custom resource
+	//	definitions, e.g. from the monitoring component.
+	Monitoring setupv1alpha1.MonitoringSpec `json:"monitoring,omitempty"`
 }
 
 type SecretsComponentCollectionSpec struct {
@@ -87,41 +95,113 @@ type SecretsComponentSpecExternalSecrets struct {
 	//	Image repo and name to use for external-secrets.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the external-secrets image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	Controller SecretsComponentSpecExternalSecretsController `json:"controller,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	Webhook SecretsComponentSpecExternalSecretsWebhook `json:"webhook,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the external-secrets pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 type SecretsComponentSpecExternalSecretsCertController struct {
-	// +kubebuilder:default=1
+	// +kubebuilder:default=true
 	// +kubebuilder:validation:Optional
-	// (Default: 1)
+	// (Default: true)
 	//
-	//	Number of replicas to use for the external-secrets cert-controller deployment.
+	//	Whether to install the external-secrets cert-controller, which issues and injects the
+	//	certificates used by the external-secrets webhook.  Disable when webhook certificates
+	//	are managed by another means, such as cert-manager.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the external-secrets cert-controller deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the external-secrets cert-controller deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the external-secrets cert-controller container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type SecretsComponentSpecExternalSecretsController struct {
-	// +kubebuilder:default=2
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
-	//
-	//	Number of replicas to use for the external-secrets controller deployment.
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the external-secrets controller deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the external-secrets controller deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the external-secrets controller container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type SecretsComponentSpecExternalSecretsWebhook struct {
-	// +kubebuilder:default=2
+	// +kubebuilder:default=true
 	// +kubebuilder:validation:Optional
-	// (Default: 2)
+	// (Default: true)
 	//
-	//	Number of replicas to use for the external-secrets webhook deployment.
+	//	Whether to install the external-secrets webhook.  When disabled, the external-secrets
+	//	custom resources are not validated and no CRD conversion webhook is configured.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the external-secrets webhook deployment.  Defaults to the number of
+	//	replicas of the tier of the collection.
 	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the external-secrets webhook deployment.  Settings which are set
+	//	here take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Use cert-manager to issue the serving certificate for the external-secrets webhook from the
+	//	platform certificate authority managed by the CertificatesComponent.  When set, the
+	//	external-secrets cert-controller is not installed and the CertificatesComponent becomes
+	//	a dependency of this component.
+	UseCertManager bool `json:"useCertManager,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the external-secrets webhook container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 type SecretsComponentSpecReloader struct {
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	// (Default: true)
+	//
+	//	Whether to install reloader.
+	Enabled *bool `json:"enabled,omitempty"`
+
 	// +kubebuilder:default=1
 	// +kubebuilder:validation:Optional
 	// (Default: 1)
@@ -136,12 +216,58 @@ type SecretsComponentSpecReloader struct {
 	//	Image repo and name to use for reloader.
 	Image string `json:"image,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the reloader image (e.g. sha256:<hex>).  When set, the image is pinned to this digest
+	//	rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
 	// +kubebuilder:default="v0.0.119"
 	// +kubebuilder:validation:Optional
 	// (Default: "v0.0.119")
 	//
 	//	Version of reloader to use.
 	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespace that reloader is restricted to watching.  If unset, all namespaces are watched.
+	WatchNamespace string `json:"watchNamespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Label selector used to restrict the namespaces that reloader watches.
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Namespaces that reloader ignores.
+	NamespacesToIgnore []string `json:"namespacesToIgnore,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	+kubebuilder:validation:Enum=configMaps;secrets
+	//	Resource type that reloader ignores.  One of: configMaps | secrets.
+	ResourcesToIgnore string `json:"resourcesToIgnore,omitempty"`
+
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Reload all workloads on configmap or secret changes without requiring the
+	//	reloader.stakater.com/auto annotation.
+	AutoReloadAll bool `json:"autoReloadAll,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	+kubebuilder:validation:Enum=json
+	//	Log format used by reloader.  Defaults to reloader's plain text format when unset.
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the reloader pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the reloader container.  Requests and limits
+	//	which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // SecretsComponentStatus defines the observed state of SecretsComponent.
@@ -153,6 +279,9 @@ type SecretsComponentStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -238,16 +367,105 @@ func (component *SecretsComponent) SetChildResourceCondition(resource *status.Ch
 	component.Status.Resources = append(component.Status.Resources, resource)
 }
 
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *SecretsComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
 // GetDependencies returns the dependencies for a component.
-func (*SecretsComponent) GetDependencies() []workload.Workload {
+func (component *SecretsComponent) GetDependencies() []workload.Workload {
+	if component.UsesCertManager() {
+		return []workload.Workload{
+			&CertificatesComponent{},
+		}
+	}
+
 	return []workload.Workload{}
 }
 
+// UsesCertManager returns whether the external-secrets webhook serving certificate is issued
+// by cert-manager rather than the external-secrets cert-controller.
+func (component *SecretsComponent) UsesCertManager() bool {
+	return component.Spec.ExternalSecrets.Webhook.IsEnabled() && component.Spec.ExternalSecrets.Webhook.UseCertManager
+}
+
+// IsEnabled returns whether the external-secrets cert-controller is installed.  The API server
+// only defaults the field when its parent is set, so an unset field is enabled as well.
+func (certController SecretsComponentSpecExternalSecretsCertController) IsEnabled() bool {
+	return certController.Enabled == nil || *certController.Enabled
+}
+
+// IsEnabled returns whether the external-secrets webhook is installed.  The API server only
+// defaults the field when its parent is set, so an unset field is enabled as well.
+func (webhook SecretsComponentSpecExternalSecretsWebhook) IsEnabled() bool {
+	return webhook.Enabled == nil || *webhook.Enabled
+}
+
+// IsEnabled returns whether reloader is installed.  The API server only defaults the field when
+// its parent is set, so an unset field is enabled as well.
+func (reloader SecretsComponentSpecReloader) IsEnabled() bool {
+	return reloader.Enabled == nil || *reloader.Enabled
+}
+
 // GetComponentGVK returns a GVK object for the component.
 func (*SecretsComponent) GetWorkloadGVK() schema.GroupVersionKind {
 	return GroupVersion.WithKind("SecretsComponent")
 }
 
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *SecretsComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *SecretsComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each deployment of the component, keyed by
+// the deployment name, after defaulting from the given tier profile.
+func (component *SecretsComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	externalSecrets := component.Spec.ExternalSecrets
+
+	return map[string]int{
+		"external-secrets":                 profile.ReplicasFor(externalSecrets.Controller.Replicas),
+		"external-secrets-cert-controller": profile.ReplicasFor(externalSecrets.CertController.Replicas),
+		"external-secrets-webhook":         profile.ReplicasFor(externalSecrets.Webhook.Replicas),
+
+		// reloader runs as a single replica, so it is not defaulted from the tier
+		"secret-reloader": component.Spec.Reloader.Replicas,
+	}
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *SecretsComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	externalSecrets := component.Spec.ExternalSecrets
+
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"external-secrets": externalSecrets.Controller.PodDisruptionBudget,
+	}
+
+	if externalSecrets.CertController.IsEnabled() && !component.UsesCertManager() {
+		budgets["external-secrets-cert-controller"] = externalSecrets.CertController.PodDisruptionBudget
+	}
+
+	if externalSecrets.Webhook.IsEnabled() {
+		budgets["external-secrets-webhook"] = externalSecrets.Webhook.PodDisruptionBudget
+	}
+
+	return budgets
+}
+
 func init() {
 	SchemeBuilder.Register(&SecretsComponent{}, &SecretsComponentList{})
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                                   = "parent.Spec.Namespace"
+	IssuerNamespaceMeshSelfsigned                        = "mesh-selfsigned"
+	CertNamespaceMeshTrustAnchor                         = "mesh-trust-anchor"
+	IssuerNamespaceMeshTrustAnchor                       = "mesh-trust-anchor"
+	CRDServiceprofilesLinkerdIo                          = "serviceprofiles.linkerd.io"
+	CRDServersPolicyLinkerdIo                            = "servers.policy.linkerd.io"
+	CRDServerauthorizationsPolicyLinkerdIo               = "serverauthorizations.policy.linkerd.io"
+	CRDAuthorizationpoliciesPolicyLinkerdIo              = "authorizationpolicies.policy.linkerd.io"
+	CRDHttproutesPolicyLinkerdIo                         = "httproutes.policy.linkerd.io"
+	CRDMeshtlsauthenticationsPolicyLinkerdIo             = "meshtlsauthentications.policy.linkerd.io"
+	CRDNetworkauthenticationsPolicyLinkerdIo             = "networkauthentications.policy.linkerd.io"
+	CertNamespaceLinkerdIdentityIssuer                   = "linkerd-identity-issuer"
+	CertNamespaceLinkerdProxyInjector                    = "linkerd-proxy-injector"
+	CertNamespaceLinkerdSpValidator                      = "linkerd-sp-validator"
+	CertNamespaceLinkerdPolicyValidator                  = "linkerd-policy-validator"
+	IssuerNamespaceLinkerdWebhookIssuer                  = "linkerd-webhook-issuer"
+	ConfigMapNamespaceLinkerdConfig                      = "linkerd-config"
+	ConfigMapNamespaceLinkerdIdentityTrustRoots          = "linkerd-identity-trust-roots"
+	ServiceAccountNamespaceLinkerdIdentity               = "linkerd-identity"
+	ServiceAccountNamespaceLinkerdDestination            = "linkerd-destination"
+	ServiceAccountNamespaceLinkerdProxyInjector          = "linkerd-proxy-injector"
+	ClusterRoleLinkerdIdentity                           = "linkerd-identity"
+	ClusterRoleBindingLinkerdIdentity                    = "linkerd-identity"
+	ClusterRoleLinkerdDestination                        = "linkerd-destination"
+	ClusterRoleBindingLinkerdDestination                 = "linkerd-destination"
+	ClusterRoleLinkerdProxyInjector                      = "linkerd-proxy-injector"
+	ClusterRoleBindingLinkerdProxyInjector               = "linkerd-proxy-injector"
+	DeploymentNamespaceLinkerdIdentity                   = "linkerd-identity"
+	DeploymentNamespaceLinkerdDestination                = "linkerd-destination"
+	DeploymentNamespaceLinkerdProxyInjector              = "linkerd-proxy-injector"
+	ServiceNamespaceLinkerdIdentity                      = "linkerd-identity"
+	ServiceNamespaceLinkerdIdentityHeadless              = "linkerd-identity-headless"
+	ServiceNamespaceLinkerdDst                           = "linkerd-dst"
+	ServiceNamespaceLinkerdDstHeadless                   = "linkerd-dst-headless"
+	ServiceNamespaceLinkerdPolicy                        = "linkerd-policy"
+	ServiceNamespaceLinkerdSpValidator                   = "linkerd-sp-validator"
+	ServiceNamespaceLinkerdPolicyValidator               = "linkerd-policy-validator"
+	ServiceNamespaceLinkerdProxyInjector                 = "linkerd-proxy-injector"
+	MutatingWebhookLinkerdProxyInjectorWebhookConfig     = "linkerd-proxy-injector-webhook-config"
+	ValidatingWebhookLinkerdSpValidatorWebhookConfig     = "linkerd-sp-validator-webhook-config"
+	ValidatingWebhookLinkerdPolicyValidatorWebhookConfig = "linkerd-policy-validator-webhook-config"
+	CRDDestinationrulesNetworkingIstioIo                 = "destinationrules.networking.istio.io"
+	CRDEnvoyfiltersNetworkingIstioIo                     = "envoyfilters.networking.istio.io"
+	CRDGatewaysNetworkingIstioIo                         = "gateways.networking.istio.io"
+	CRDProxyconfigsNetworkingIstioIo                     = "proxyconfigs.networking.istio.io"
+	CRDServiceentriesNetworkingIstioIo                   = "serviceentries.networking.istio.io"
+	CRDSidecarsNetworkingIstioIo                         = "sidecars.networking.istio.io"
+	CRDVirtualservicesNetworkingIstioIo                  = "virtualservices.networking.istio.io"
+	CRDWorkloadentriesNetworkingIstioIo                  = "workloadentries.networking.istio.io"
+	CRDWorkloadgroupsNetworkingIstioIo                   = "workloadgroups.networking.istio.io"
+	CRDAuthorizationpoliciesSecurityIstioIo              = "authorizationpolicies.security.istio.io"
+	CRDPeerauthenticationsSecurityIstioIo                = "peerauthentications.security.istio.io"
+	CRDRequestauthenticationsSecurityIstioIo             = "requestauthentications.security.istio.io"
+	CRDTelemetriesTelemetryIstioIo                       = "telemetries.telemetry.istio.io"
+	CRDWasmpluginsExtensionsIstioIo                      = "wasmplugins.extensions.istio.io"
+	CertNamespaceIstioCa                                 = "istio-ca"
+	ConfigMapNamespaceIstio                              = "istio"
+	ConfigMapNamespaceIstioCniConfig                     = "istio-cni-config"
+	ServiceAccountNamespaceIstiod                        = "istiod"
+	ServiceAccountNamespaceIstioCni                      = "istio-cni"
+	ServiceAccountNamespaceZtunnel                       = "ztunnel"
+	ClusterRoleIstiod                                    = "istiod"
+	ClusterRoleBindingIstiod                             = "istiod"
+	ClusterRoleIstioCni                                  = "istio-cni"
+	ClusterRoleBindingIstioCni                           = "istio-cni"
+	DeploymentNamespaceIstiod                            = "istiod"
+	DaemonSetNamespaceIstioCniNode                       = "istio-cni-node"
+	DaemonSetNamespaceZtunnel                            = "ztunnel"
+	ServiceNamespaceIstiod                               = "istiod"
+	ValidatingWebhookIstiodDefaultValidator              = "istiod-default-validator"
+)
@@ -0,0 +1,84 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceIstioCa creates the Certificate resource with name istio-ca.
+func CreateCertNamespaceIstioCa(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "istio-ca",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"isCA":       true,
+				"commonName": "istio-ca.mesh.cluster.local",
+				"secretName": "cacerts",
+				// controlled by field: certificates.issuer.duration
+				//  How long the certificate authority of the control plane of the mesh, which is issued by the
+				//  trust anchor, is valid for.
+				"duration": parent.Spec.Certificates.Issuer.Duration,
+				// controlled by field: certificates.issuer.renewBefore
+				//  How long before the certificate authority of the control plane of the mesh expires to renew it.
+				"renewBefore": parent.Spec.Certificates.Issuer.RenewBefore,
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+				},
+				"usages": []interface{}{
+					"cert sign",
+					"crl sign",
+					"digital signature",
+					"key encipherment",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "mesh-trust-anchor",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceIstioCa(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConfigMapNamespaceIstio creates the ConfigMap resource with name istio.
+func CreateConfigMapNamespaceIstio(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+					"istio.io/rev":                 "default",
+				},
+				"name":      "istio",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"data": map[string]interface{}{
+				"mesh": `defaultConfig:
+  discoveryAddress: istiod.istio-system.svc:15012
+  proxyMetadata:
+    ISTIO_META_ENABLE_HBONE: "true"
+enablePrometheusMerge: true
+rootNamespace: istio-system
+trustDomain: cluster.local`,
+				"meshNetworks": "networks: {}",
+			},
+		},
+	}
+
+	return mutate.MutateConfigMapNamespaceIstio(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConfigMapNamespaceIstioCniConfig creates the ConfigMap resource with name istio-cni-config.
+func CreateConfigMapNamespaceIstioCniConfig(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "istio-cni-config",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"data": map[string]interface{}{
+				"cni_network_config": `{
+  "cniVersion": "0.3.1",
+  "name": "istio-cni",
+  "type": "istio-cni",
+  "log_level": "info",
+  "log_uds_address": "__LOG_UDS_ADDRESS__",
+  "ambient_enabled": true,
+  "kubernetes": {
+    "kubeconfig": "__KUBECONFIG_FILEPATH__",
+    "cni_bin_dir": "/opt/cni/bin",
+    "exclude_namespaces": ["kube-system"]
+  }
+}`,
+			},
+		},
+	}
+
+	return mutate.MutateConfigMapNamespaceIstioCniConfig(resourceObj, parent, collection, reconciler, req)
+}
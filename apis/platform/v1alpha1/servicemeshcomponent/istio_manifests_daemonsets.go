@@ -0,0 +1,485 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceIstioCniNode creates the DaemonSet resource with name istio-cni-node.
+func CreateDaemonSetNamespaceIstioCniNode(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "istio-cni-node",
+					"k8s-app":                      "istio-cni-node",
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "istio-cni-node",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"k8s-app": "istio-cni-node",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": 1,
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"sidecar.istio.io/inject": "false",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "istio-cni-node",
+							"k8s-app":                      "istio-cni-node",
+							"platform.nukleros.io/group":   "service-mesh",
+							"platform.nukleros.io/project": "istio",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "istio-cni",
+						"hostNetwork":        true,
+						"priorityClassName":  "system-node-critical",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"effect":   "NoSchedule",
+								"operator": "Exists",
+							},
+							map[string]interface{}{
+								"key":      "CriticalAddonsOnly",
+								"operator": "Exists",
+							},
+							map[string]interface{}{
+								"effect":   "NoExecute",
+								"operator": "Exists",
+							},
+						},
+						"terminationGracePeriodSeconds": 5,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "install-cni",
+								// controlled by field: mesh.istio.cniImage
+								// controlled by field: mesh.istio.version
+								//  Image repo and name to use for the istio CNI plugin.
+								//  Version of istio to use.
+								"image":           "" + parent.Spec.Mesh.Istio.CNIImage + ":" + parent.Spec.Mesh.Istio.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"install-cni",
+								},
+								"args": []interface{}{
+									"--log_output_level=default:info",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "CNI_NETWORK_CONFIG",
+										"valueFrom": map[string]interface{}{
+											"configMapKeyRef": map[string]interface{}{
+												"name": "istio-cni-config",
+												"key":  "cni_network_config",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "CNI_NET_DIR",
+										"value": "/etc/cni/net.d",
+									},
+									map[string]interface{}{
+										"name":  "CHAINED_CNI_PLUGIN",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name":  "REPAIR_ENABLED",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name":  "AMBIENT_ENABLED",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name": "KUBE_NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/readyz",
+										"port": 8000,
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "100Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "200Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"privileged":   true,
+									"runAsGroup":   0,
+									"runAsUser":    0,
+									"runAsNonRoot": false,
+									"capabilities": map[string]interface{}{
+										"add": []interface{}{
+											"NET_ADMIN",
+											"NET_RAW",
+											"SYS_ADMIN",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "cni-bin-dir",
+										"mountPath": "/host/opt/cni/bin",
+									},
+									map[string]interface{}{
+										"name":      "cni-net-dir",
+										"mountPath": "/host/etc/cni/net.d",
+									},
+									map[string]interface{}{
+										"name":      "cni-log-dir",
+										"mountPath": "/var/run/istio-cni",
+									},
+									map[string]interface{}{
+										"name":             "cni-netns-dir",
+										"mountPath":        "/var/run/netns",
+										"mountPropagation": "HostToContainer",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "cni-bin-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/opt/cni/bin",
+								},
+							},
+							map[string]interface{}{
+								"name": "cni-net-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/etc/cni/net.d",
+								},
+							},
+							map[string]interface{}{
+								"name": "cni-log-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/run/istio-cni",
+								},
+							},
+							map[string]interface{}{
+								"name": "cni-netns-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/run/netns",
+									"type": "DirectoryOrCreate",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceIstioCniNode(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceZtunnel creates the DaemonSet resource with name ztunnel.
+func CreateDaemonSetNamespaceZtunnel(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":                          "ztunnel",
+					"app.kubernetes.io/name":       "ztunnel",
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "ztunnel",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app": "ztunnel",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": 1,
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"sidecar.istio.io/inject":      "false",
+							"ambient.istio.io/redirection": "disabled",
+						},
+						"labels": map[string]interface{}{
+							"app":                          "ztunnel",
+							"app.kubernetes.io/name":       "ztunnel",
+							"platform.nukleros.io/group":   "service-mesh",
+							"platform.nukleros.io/project": "istio",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "ztunnel",
+						"priorityClassName":  "system-node-critical",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"effect":   "NoSchedule",
+								"operator": "Exists",
+							},
+							map[string]interface{}{
+								"key":      "CriticalAddonsOnly",
+								"operator": "Exists",
+							},
+							map[string]interface{}{
+								"effect":   "NoExecute",
+								"operator": "Exists",
+							},
+						},
+						"terminationGracePeriodSeconds": 30,
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "istio-proxy",
+								// controlled by field: mesh.istio.ztunnelImage
+								// controlled by field: mesh.istio.version
+								//  Image repo and name to use for ztunnel.
+								//  Version of istio to use.
+								"image":           "" + parent.Spec.Mesh.Istio.ZtunnelImage + ":" + parent.Spec.Mesh.Istio.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"proxy",
+									"ztunnel",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CLUSTER_ID",
+										"value": "Kubernetes",
+									},
+									map[string]interface{}{
+										"name": "CA_ADDRESS",
+										// controlled by field: namespace
+										"value": "istiod." + parent.Spec.Namespace + ".svc:15012",
+									},
+									map[string]interface{}{
+										"name": "XDS_ADDRESS",
+										// controlled by field: namespace
+										"value": "istiod." + parent.Spec.Namespace + ".svc:15012",
+									},
+									map[string]interface{}{
+										"name":  "RUST_LOG",
+										"value": "info",
+									},
+									map[string]interface{}{
+										"name":  "ISTIO_META_CLUSTER_ID",
+										"value": "Kubernetes",
+									},
+									map[string]interface{}{
+										"name": "INSTANCE_IP",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "status.podIP",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "SERVICE_ACCOUNT",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.serviceAccountName",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "ztunnel-stats",
+										"containerPort": 15020,
+										"protocol":      "TCP",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz/ready",
+										"port": 15021,
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "512Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "1000m",
+										"memory": "1Gi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"privileged":               false,
+									"readOnlyRootFilesystem":   true,
+									"runAsGroup":               1337,
+									"runAsNonRoot":             false,
+									"runAsUser":                0,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+										"add": []interface{}{
+											"NET_ADMIN",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "istiod-ca-cert",
+										"mountPath": "/var/run/secrets/istio",
+									},
+									map[string]interface{}{
+										"name":      "istio-token",
+										"mountPath": "/var/run/secrets/tokens",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "istio-token",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"serviceAccountToken": map[string]interface{}{
+												"audience":          "istio-ca",
+												"expirationSeconds": 43200,
+												"path":              "istio-token",
+											},
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "istiod-ca-cert",
+								"configMap": map[string]interface{}{
+									"name": "istio-ca-root-cert",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceZtunnel(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceIstiod creates the Deployment resource with name istiod.
+func CreateDeploymentNamespaceIstiod(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":                          "istiod",
+					"app.kubernetes.io/name":       "istiod",
+					"istio":                        "pilot",
+					"istio.io/rev":                 "default",
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "istiod",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name": "istiod",
+						"istio":                  "pilot",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"prometheus.io/port":      "15014",
+							"prometheus.io/scrape":    "true",
+							"sidecar.istio.io/inject": "false",
+						},
+						"labels": map[string]interface{}{
+							"app":                          "istiod",
+							"app.kubernetes.io/name":       "istiod",
+							"istio":                        "pilot",
+							"istio.io/rev":                 "default",
+							"platform.nukleros.io/group":   "service-mesh",
+							"platform.nukleros.io/project": "istio",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "istiod",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "discovery",
+								// controlled by field: mesh.istio.pilotImage
+								// controlled by field: mesh.istio.version
+								//  Image repo and name to use for istiod.
+								//  Version of istio to use.
+								"image":           "" + parent.Spec.Mesh.Istio.PilotImage + ":" + parent.Spec.Mesh.Istio.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"discovery",
+									"--monitoringAddr=:15014",
+									"--log_output_level=default:info",
+									"--domain",
+									"cluster.local",
+									"--keepaliveMaxServerConnectionAge",
+									"30m",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "REVISION",
+										"value": "default",
+									},
+									map[string]interface{}{
+										"name":  "JWT_POLICY",
+										"value": "third-party-jwt",
+									},
+									map[string]interface{}{
+										"name":  "PILOT_CERT_PROVIDER",
+										"value": "istiod",
+									},
+									map[string]interface{}{
+										"name": "POD_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "SERVICE_ACCOUNT",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.serviceAccountName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "KUBECONFIG",
+										"value": "/var/run/secrets/remote/config",
+									},
+									map[string]interface{}{
+										"name":  "PILOT_ENABLE_AMBIENT_CONTROLLERS",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name":  "PILOT_ENABLE_HBONE",
+										"value": "true",
+									},
+									map[string]interface{}{
+										"name": "CA_TRUSTED_NODE_ACCOUNTS",
+										// controlled by field: namespace
+										"value": "" + parent.Spec.Namespace + "/ztunnel",
+									},
+									map[string]interface{}{
+										"name": "ISTIOD_CUSTOM_HOST",
+										// controlled by field: namespace
+										"value": "istiod." + parent.Spec.Namespace + ".svc",
+									},
+									map[string]interface{}{
+										"name":  "CLUSTER_ID",
+										"value": "Kubernetes",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"containerPort": 8080,
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 15010,
+										"protocol":      "TCP",
+									},
+									map[string]interface{}{
+										"containerPort": 15017,
+										"protocol":      "TCP",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 8080,
+									},
+									"initialDelaySeconds": 1,
+									"periodSeconds":       3,
+									"timeoutSeconds":      5,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "1Gi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "1000m",
+										"memory": "2Gi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsNonRoot":             true,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "istio-token",
+										"mountPath": "/var/run/secrets/tokens",
+										"readOnly":  true,
+									},
+									map[string]interface{}{
+										"name":      "local-certs",
+										"mountPath": "/var/run/secrets/istio-dns",
+									},
+									map[string]interface{}{
+										"name":      "cacerts",
+										"mountPath": "/etc/cacerts",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "local-certs",
+								"emptyDir": map[string]interface{}{
+									"medium": "Memory",
+								},
+							},
+							map[string]interface{}{
+								"name": "istio-token",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"serviceAccountToken": map[string]interface{}{
+												"audience":          "istio-ca",
+												"expirationSeconds": 43200,
+												"path":              "istio-token",
+											},
+										},
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "cacerts",
+								"secret": map[string]interface{}{
+									"secretName": "cacerts",
+									"optional":   true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceIstiod(resourceObj, parent, collection, reconciler, req)
+}
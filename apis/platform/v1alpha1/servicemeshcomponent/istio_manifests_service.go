@@ -0,0 +1,90 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceIstiod creates the Service resource with name istiod.
+func CreateServiceNamespaceIstiod(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":                          "istiod",
+					"istio":                        "pilot",
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name":      "istiod",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"app":   "istiod",
+					"istio": "pilot",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":     "grpc-xds",
+						"port":     15010,
+						"protocol": "TCP",
+					},
+					map[string]interface{}{
+						"name":     "https-dns",
+						"port":     15012,
+						"protocol": "TCP",
+					},
+					map[string]interface{}{
+						"name":       "https-webhook",
+						"port":       443,
+						"targetPort": 15017,
+						"protocol":   "TCP",
+					},
+					map[string]interface{}{
+						"name":     "http-monitoring",
+						"port":     15014,
+						"protocol": "TCP",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceIstiod(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateValidatingWebhookIstiodDefaultValidator creates the ValidatingWebhookConfiguration resource with name istiod-default-validator.
+func CreateValidatingWebhookIstiodDefaultValidator(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "istio" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="istio",include
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":                          "istiod",
+					"istio":                        "istiod",
+					"istio.io/rev":                 "default",
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "istio",
+				},
+				"name": "istiod-default-validator",
+			},
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name": "validation.istio.io",
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "istiod",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/validate",
+						},
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"operations": []interface{}{
+								"CREATE",
+								"UPDATE",
+							},
+							"apiGroups": []interface{}{
+								"security.istio.io",
+								"networking.istio.io",
+								"telemetry.istio.io",
+								"extensions.istio.io",
+							},
+							"apiVersions": []interface{}{
+								"*",
+							},
+							"resources": []interface{}{
+								"*",
+							},
+						},
+					},
+					"failurePolicy": "Ignore",
+					"sideEffects":   "None",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateValidatingWebhookIstiodDefaultValidator(resourceObj, parent, collection, reconciler, req)
+}
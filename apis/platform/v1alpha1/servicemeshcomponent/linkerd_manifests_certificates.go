@@ -0,0 +1,276 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceLinkerdIdentityIssuer creates the Certificate resource with name linkerd-identity-issuer.
+func CreateCertNamespaceLinkerdIdentityIssuer(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-identity-issuer",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"isCA":       true,
+				"commonName": "identity.linkerd.cluster.local",
+				"dnsNames": []interface{}{
+					"identity.linkerd.cluster.local",
+				},
+				"secretName": "linkerd-identity-issuer",
+				// controlled by field: certificates.issuer.duration
+				//  How long the certificate authority of the control plane of the mesh, which is issued by the
+				//  trust anchor, is valid for.
+				"duration": parent.Spec.Certificates.Issuer.Duration,
+				// controlled by field: certificates.issuer.renewBefore
+				//  How long before the certificate authority of the control plane of the mesh expires to renew it.
+				"renewBefore": parent.Spec.Certificates.Issuer.RenewBefore,
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+				},
+				"usages": []interface{}{
+					"cert sign",
+					"crl sign",
+					"server auth",
+					"client auth",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "mesh-trust-anchor",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceLinkerdIdentityIssuer(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceLinkerdProxyInjector creates the Certificate resource with name linkerd-proxy-injector.
+func CreateCertNamespaceLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-proxy-injector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"secretName":  "linkerd-proxy-injector-k8s-tls",
+				"duration":    "24h0m0s",
+				"renewBefore": "1h0m0s",
+				"dnsNames": []interface{}{
+					// controlled by field: namespace
+					"linkerd-proxy-injector." + parent.Spec.Namespace + ".svc",
+				},
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+					"encoding":  "PKCS8",
+				},
+				"usages": []interface{}{
+					"server auth",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "linkerd-webhook-issuer",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceLinkerdSpValidator creates the Certificate resource with name linkerd-sp-validator.
+func CreateCertNamespaceLinkerdSpValidator(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-sp-validator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"secretName":  "linkerd-sp-validator-k8s-tls",
+				"duration":    "24h0m0s",
+				"renewBefore": "1h0m0s",
+				"dnsNames": []interface{}{
+					// controlled by field: namespace
+					"linkerd-sp-validator." + parent.Spec.Namespace + ".svc",
+				},
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+					"encoding":  "PKCS8",
+				},
+				"usages": []interface{}{
+					"server auth",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "linkerd-webhook-issuer",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceLinkerdSpValidator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceLinkerdPolicyValidator creates the Certificate resource with name linkerd-policy-validator.
+func CreateCertNamespaceLinkerdPolicyValidator(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-policy-validator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"secretName":  "linkerd-policy-validator-k8s-tls",
+				"duration":    "24h0m0s",
+				"renewBefore": "1h0m0s",
+				"dnsNames": []interface{}{
+					// controlled by field: namespace
+					"linkerd-policy-validator." + parent.Spec.Namespace + ".svc",
+				},
+				"privateKey": map[string]interface{}{
+					"algorithm": "ECDSA",
+					"encoding":  "PKCS8",
+				},
+				"usages": []interface{}{
+					"server auth",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "linkerd-webhook-issuer",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceLinkerdPolicyValidator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIssuerNamespaceLinkerdWebhookIssuer creates the Issuer resource with name linkerd-webhook-issuer.
+func CreateIssuerNamespaceLinkerdWebhookIssuer(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Issuer",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-webhook-issuer",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"ca": map[string]interface{}{
+					"secretName": "mesh-trust-anchor",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateIssuerNamespaceLinkerdWebhookIssuer(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConfigMapNamespaceLinkerdConfig creates the ConfigMap resource with name linkerd-config.
+func CreateConfigMapNamespaceLinkerdConfig(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-config",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"data": map[string]interface{}{
+				"values": `clusterDomain: cluster.local
+identityTrustDomain: cluster.local
+proxyInit:
+  image:
+    name: cr.l5d.io/linkerd/proxy-init
+    version: v2.0.0
+  ignoreInboundPorts: "4567,4568"
+  ignoreOutboundPorts: "4567,4568"
+proxy:
+  image:
+    name: cr.l5d.io/linkerd/proxy
+    version: stable-2.12.4
+  logLevel: warn,linkerd=info
+  logFormat: plain
+  inboundPort: 4143
+  outboundPort: 4140
+  controlPort: 4190
+  adminPort: 4191
+  uid: 2102
+  resources:
+    cpu:
+      request: 10m
+      limit: 100m
+    memory:
+      request: 20Mi
+      limit: 250Mi
+policyValidator:
+  namespaceSelector:
+    matchExpressions:
+      - key: config.linkerd.io/admission-webhooks
+        operator: NotIn
+        values:
+          - disabled`,
+			},
+		},
+	}
+
+	return mutate.MutateConfigMapNamespaceLinkerdConfig(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// CreateConfigMapNamespaceLinkerdIdentityTrustRoots creates the ConfigMap resource with name linkerd-identity-trust-roots.
+func CreateConfigMapNamespaceLinkerdIdentityTrustRoots(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-identity-trust-roots",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"data": map[string]interface{}{
+				"ca-bundle.crt": "",
+			},
+		},
+	}
+
+	return mutate.MutateConfigMapNamespaceLinkerdIdentityTrustRoots(resourceObj, parent, collection, reconciler, req)
+}
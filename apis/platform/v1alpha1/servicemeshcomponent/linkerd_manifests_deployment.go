@@ -0,0 +1,1319 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceLinkerdIdentity creates the Deployment resource with name linkerd-identity.
+func CreateDeploymentNamespaceLinkerdIdentity(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":             "linkerd-identity",
+					"linkerd.io/control-plane-component": "identity",
+					"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+					"platform.nukleros.io/group":         "service-mesh",
+					"platform.nukleros.io/project":       "linkerd",
+				},
+				"name":      "linkerd-identity",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":             "linkerd-identity",
+						"linkerd.io/control-plane-component": "identity",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"config.linkerd.io/default-inbound-policy": "all-unauthenticated",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":             "linkerd-identity",
+							"linkerd.io/control-plane-component": "identity",
+							"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+							"platform.nukleros.io/group":         "service-mesh",
+							"platform.nukleros.io/project":       "linkerd",
+							"linkerd.io/workload-ns":             parent.Spec.Namespace, //  controlled by field: namespace
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName":           "linkerd-identity",
+						"automountServiceAccountToken": true,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"initContainers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-init",
+								// controlled by field: mesh.linkerd.proxyInitImage
+								// controlled by field: mesh.linkerd.proxyInitVersion
+								//  Image repo and name to use for the linkerd proxy init container.
+								//  Version of the linkerd proxy init container to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyInitImage + ":" + parent.Spec.Mesh.Linkerd.ProxyInitVersion + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--incoming-proxy-port",
+									"4143",
+									"--outgoing-proxy-port",
+									"4140",
+									"--proxy-uid",
+									"2102",
+									"--inbound-ports-to-ignore",
+									"4190,4191,4567,4568",
+									"--outbound-ports-to-ignore",
+									"443,6443",
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"add": []interface{}{
+											"NET_ADMIN",
+											"NET_RAW",
+										},
+									},
+									"privileged":             false,
+									"readOnlyRootFilesystem": true,
+									"runAsNonRoot":           false,
+									"runAsUser":              0,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-proxy-init-xtables-lock",
+										"mountPath": "/run",
+									},
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-proxy",
+								// controlled by field: mesh.linkerd.proxyImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd proxy.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "_pod_name",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_ns",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_nodeName",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG",
+										"value": "warn,linkerd=info",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG_FORMAT",
+										"value": "plain",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-dst-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8086",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-policy." + parent.Spec.Namespace + ".svc.cluster.local.:8090",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_POLICY_WORKLOAD",
+										"value": "$(_pod_ns):$(_pod_name)",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_DEFAULT_POLICY",
+										"value": "all-unauthenticated",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_CONTROL_LISTEN_ADDR",
+										"value": "0.0.0.0:4190",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_ADMIN_LISTEN_ADDR",
+										"value": "0.0.0.0:4191",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_OUTBOUND_LISTEN_ADDR",
+										"value": "127.0.0.1:4140",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_LISTEN_ADDR",
+										"value": "0.0.0.0:4143",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_DIR",
+										"value": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS",
+										"valueFrom": map[string]interface{}{
+											"configMapKeyRef": map[string]interface{}{
+												"name": "linkerd-identity-trust-roots",
+												"key":  "ca-bundle.crt",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_TOKEN_FILE",
+										"value": "/var/run/secrets/tokens/linkerd-identity-token",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-identity-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8080",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-identity." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_LOCAL_NAME",
+										"value": "linkerd-identity.$(_pod_ns).serviceaccount.identity.linkerd.cluster.local",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "linkerd-proxy",
+										"containerPort": 4143,
+									},
+									map[string]interface{}{
+										"name":          "linkerd-admin",
+										"containerPort": 4191,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 4191,
+									},
+									"initialDelaySeconds": 2,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "20Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2102,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-identity-end-entity",
+										"mountPath": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name":      "linkerd-identity-token",
+										"mountPath": "/var/run/secrets/tokens",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "identity",
+								// controlled by field: mesh.linkerd.controllerImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd controllers.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ControllerImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"identity",
+									"-log-level=info",
+									"-log-format=plain",
+									// controlled by field: namespace
+									"-controller-namespace=" + parent.Spec.Namespace + "",
+									"-identity-trust-domain=cluster.local",
+									"-identity-issuance-lifetime=24h0m0s",
+									"-identity-clock-skew-allowance=20s",
+									"-identity-scheme=kubernetes.io/tls",
+									"-enable-pprof=false",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS",
+										"valueFrom": map[string]interface{}{
+											"configMapKeyRef": map[string]interface{}{
+												"name": "linkerd-identity-trust-roots",
+												"key":  "ca-bundle.crt",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "grpc",
+										"containerPort": 8080,
+									},
+									map[string]interface{}{
+										"name":          "admin-http",
+										"containerPort": 9990,
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ping",
+										"port": 9990,
+									},
+									"initialDelaySeconds": 10,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 9990,
+									},
+									"failureThreshold": 7,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "50Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2103,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "identity-issuer",
+										"mountPath": "/var/run/linkerd/identity/issuer",
+									},
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"seccompProfile": map[string]interface{}{
+								"type": "RuntimeDefault",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "identity-issuer",
+								"secret": map[string]interface{}{
+									"secretName": "linkerd-identity-issuer",
+								},
+							},
+							map[string]interface{}{
+								"name":     "linkerd-proxy-init-xtables-lock",
+								"emptyDir": map[string]interface{}{},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-end-entity",
+								"emptyDir": map[string]interface{}{
+									"medium": "Memory",
+								},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-token",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"serviceAccountToken": map[string]interface{}{
+												"audience":          "identity.l5d.io",
+												"expirationSeconds": 86400,
+												"path":              "linkerd-identity-token",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceLinkerdIdentity(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceLinkerdDestination creates the Deployment resource with name linkerd-destination.
+func CreateDeploymentNamespaceLinkerdDestination(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":             "linkerd-destination",
+					"linkerd.io/control-plane-component": "destination",
+					"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+					"platform.nukleros.io/group":         "service-mesh",
+					"platform.nukleros.io/project":       "linkerd",
+				},
+				"name":      "linkerd-destination",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":             "linkerd-destination",
+						"linkerd.io/control-plane-component": "destination",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"config.linkerd.io/default-inbound-policy": "all-unauthenticated",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":             "linkerd-destination",
+							"linkerd.io/control-plane-component": "destination",
+							"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+							"platform.nukleros.io/group":         "service-mesh",
+							"platform.nukleros.io/project":       "linkerd",
+							"linkerd.io/workload-ns":             parent.Spec.Namespace, //  controlled by field: namespace
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName":           "linkerd-destination",
+						"automountServiceAccountToken": true,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"initContainers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-init",
+								// controlled by field: mesh.linkerd.proxyInitImage
+								// controlled by field: mesh.linkerd.proxyInitVersion
+								//  Image repo and name to use for the linkerd proxy init container.
+								//  Version of the linkerd proxy init container to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyInitImage + ":" + parent.Spec.Mesh.Linkerd.ProxyInitVersion + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--incoming-proxy-port",
+									"4143",
+									"--outgoing-proxy-port",
+									"4140",
+									"--proxy-uid",
+									"2102",
+									"--inbound-ports-to-ignore",
+									"4190,4191,4567,4568",
+									"--outbound-ports-to-ignore",
+									"443,6443",
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"add": []interface{}{
+											"NET_ADMIN",
+											"NET_RAW",
+										},
+									},
+									"privileged":             false,
+									"readOnlyRootFilesystem": true,
+									"runAsNonRoot":           false,
+									"runAsUser":              0,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-proxy-init-xtables-lock",
+										"mountPath": "/run",
+									},
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-proxy",
+								// controlled by field: mesh.linkerd.proxyImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd proxy.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "_pod_name",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_ns",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_nodeName",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG",
+										"value": "warn,linkerd=info",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG_FORMAT",
+										"value": "plain",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-dst-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8086",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-policy." + parent.Spec.Namespace + ".svc.cluster.local.:8090",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_POLICY_WORKLOAD",
+										"value": "$(_pod_ns):$(_pod_name)",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_DEFAULT_POLICY",
+										"value": "all-unauthenticated",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_CONTROL_LISTEN_ADDR",
+										"value": "0.0.0.0:4190",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_ADMIN_LISTEN_ADDR",
+										"value": "0.0.0.0:4191",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_OUTBOUND_LISTEN_ADDR",
+										"value": "127.0.0.1:4140",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_LISTEN_ADDR",
+										"value": "0.0.0.0:4143",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_DIR",
+										"value": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS",
+										"valueFrom": map[string]interface{}{
+											"configMapKeyRef": map[string]interface{}{
+												"name": "linkerd-identity-trust-roots",
+												"key":  "ca-bundle.crt",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_TOKEN_FILE",
+										"value": "/var/run/secrets/tokens/linkerd-identity-token",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-identity-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8080",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-identity." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_LOCAL_NAME",
+										"value": "linkerd-destination.$(_pod_ns).serviceaccount.identity.linkerd.cluster.local",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "linkerd-proxy",
+										"containerPort": 4143,
+									},
+									map[string]interface{}{
+										"name":          "linkerd-admin",
+										"containerPort": 4191,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 4191,
+									},
+									"initialDelaySeconds": 2,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "20Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2102,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-identity-end-entity",
+										"mountPath": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name":      "linkerd-identity-token",
+										"mountPath": "/var/run/secrets/tokens",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "destination",
+								// controlled by field: mesh.linkerd.controllerImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd controllers.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ControllerImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"destination",
+									"-addr=:8086",
+									// controlled by field: namespace
+									"-controller-namespace=" + parent.Spec.Namespace + "",
+									"-enable-h2-upgrade=true",
+									"-log-level=info",
+									"-log-format=plain",
+									"-enable-endpoint-slices=true",
+									"-cluster-domain=cluster.local",
+									"-identity-trust-domain=cluster.local",
+									"-default-opaque-ports=25,587,3306,4444,5432,6379,9300,11211",
+									"-enable-pprof=false",
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "grpc",
+										"containerPort": 8086,
+									},
+									map[string]interface{}{
+										"name":          "admin-http",
+										"containerPort": 9996,
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ping",
+										"port": 9996,
+									},
+									"initialDelaySeconds": 10,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 9996,
+									},
+									"failureThreshold": 7,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "50Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "500m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2103,
+								},
+							},
+							map[string]interface{}{
+								"name": "sp-validator",
+								// controlled by field: mesh.linkerd.controllerImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd controllers.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ControllerImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"sp-validator",
+									"-log-level=info",
+									"-log-format=plain",
+									"-enable-pprof=false",
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "sp-validator",
+										"containerPort": 8443,
+									},
+									map[string]interface{}{
+										"name":          "admin-http",
+										"containerPort": 9997,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 9997,
+									},
+									"failureThreshold": 7,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "20Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "100Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2103,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "sp-tls",
+										"mountPath": "/var/run/linkerd/tls",
+										"readOnly":  true,
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "policy",
+								// controlled by field: mesh.linkerd.policyControllerImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd policy controller.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.PolicyControllerImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--admin-addr=0.0.0.0:9990",
+									"--control-plane-namespace=$(POD_NAMESPACE)",
+									"--grpc-addr=0.0.0.0:8090",
+									"--server-addr=0.0.0.0:9443",
+									"--server-tls-key=/var/run/linkerd/tls/tls.key",
+									"--server-tls-certs=/var/run/linkerd/tls/tls.crt",
+									"--cluster-networks=10.0.0.0/8,100.64.0.0/10,172.16.0.0/12,192.168.0.0/16",
+									"--identity-domain=cluster.local",
+									"--cluster-domain=cluster.local",
+									"--default-policy=all-unauthenticated",
+									"--log-level=info",
+									"--log-format=plain",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "grpc",
+										"containerPort": 8090,
+									},
+									map[string]interface{}{
+										"name":          "admin-http",
+										"containerPort": 9990,
+									},
+									map[string]interface{}{
+										"name":          "policy-https",
+										"containerPort": 9443,
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/live",
+										"port": "admin-http",
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": "admin-http",
+									},
+									"failureThreshold": 7,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "50Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2103,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "policy-tls",
+										"mountPath": "/var/run/linkerd/tls",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"seccompProfile": map[string]interface{}{
+								"type": "RuntimeDefault",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "sp-tls",
+								"secret": map[string]interface{}{
+									"secretName": "linkerd-sp-validator-k8s-tls",
+								},
+							},
+							map[string]interface{}{
+								"name": "policy-tls",
+								"secret": map[string]interface{}{
+									"secretName": "linkerd-policy-validator-k8s-tls",
+								},
+							},
+							map[string]interface{}{
+								"name":     "linkerd-proxy-init-xtables-lock",
+								"emptyDir": map[string]interface{}{},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-end-entity",
+								"emptyDir": map[string]interface{}{
+									"medium": "Memory",
+								},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-token",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"serviceAccountToken": map[string]interface{}{
+												"audience":          "identity.l5d.io",
+												"expirationSeconds": 86400,
+												"path":              "linkerd-identity-token",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceLinkerdDestination(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceLinkerdProxyInjector creates the Deployment resource with name linkerd-proxy-injector.
+func CreateDeploymentNamespaceLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":             "linkerd-proxy-injector",
+					"linkerd.io/control-plane-component": "proxy-injector",
+					"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+					"platform.nukleros.io/group":         "service-mesh",
+					"platform.nukleros.io/project":       "linkerd",
+				},
+				"name":      "linkerd-proxy-injector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":             "linkerd-proxy-injector",
+						"linkerd.io/control-plane-component": "proxy-injector",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							"config.linkerd.io/default-inbound-policy": "all-unauthenticated",
+						},
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":             "linkerd-proxy-injector",
+							"linkerd.io/control-plane-component": "proxy-injector",
+							"linkerd.io/control-plane-ns":        parent.Spec.Namespace, //  controlled by field: namespace
+							"platform.nukleros.io/group":         "service-mesh",
+							"platform.nukleros.io/project":       "linkerd",
+							"linkerd.io/workload-ns":             parent.Spec.Namespace, //  controlled by field: namespace
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName":           "linkerd-proxy-injector",
+						"automountServiceAccountToken": true,
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"initContainers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-init",
+								// controlled by field: mesh.linkerd.proxyInitImage
+								// controlled by field: mesh.linkerd.proxyInitVersion
+								//  Image repo and name to use for the linkerd proxy init container.
+								//  Version of the linkerd proxy init container to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyInitImage + ":" + parent.Spec.Mesh.Linkerd.ProxyInitVersion + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--incoming-proxy-port",
+									"4143",
+									"--outgoing-proxy-port",
+									"4140",
+									"--proxy-uid",
+									"2102",
+									"--inbound-ports-to-ignore",
+									"4190,4191,4567,4568",
+									"--outbound-ports-to-ignore",
+									"443,6443",
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"add": []interface{}{
+											"NET_ADMIN",
+											"NET_RAW",
+										},
+									},
+									"privileged":             false,
+									"readOnlyRootFilesystem": true,
+									"runAsNonRoot":           false,
+									"runAsUser":              0,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-proxy-init-xtables-lock",
+										"mountPath": "/run",
+									},
+								},
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "linkerd-proxy",
+								// controlled by field: mesh.linkerd.proxyImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd proxy.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ProxyImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "_pod_name",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.name",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_ns",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "_pod_nodeName",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG",
+										"value": "warn,linkerd=info",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_LOG_FORMAT",
+										"value": "plain",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-dst-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8086",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_DESTINATION_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-policy." + parent.Spec.Namespace + ".svc.cluster.local.:8090",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_POLICY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-destination." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_POLICY_WORKLOAD",
+										"value": "$(_pod_ns):$(_pod_name)",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_DEFAULT_POLICY",
+										"value": "all-unauthenticated",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_CONTROL_LISTEN_ADDR",
+										"value": "0.0.0.0:4190",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_ADMIN_LISTEN_ADDR",
+										"value": "0.0.0.0:4191",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_OUTBOUND_LISTEN_ADDR",
+										"value": "127.0.0.1:4140",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_INBOUND_LISTEN_ADDR",
+										"value": "0.0.0.0:4143",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_DIR",
+										"value": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_TRUST_ANCHORS",
+										"valueFrom": map[string]interface{}{
+											"configMapKeyRef": map[string]interface{}{
+												"name": "linkerd-identity-trust-roots",
+												"key":  "ca-bundle.crt",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_TOKEN_FILE",
+										"value": "/var/run/secrets/tokens/linkerd-identity-token",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_ADDR",
+										// controlled by field: namespace
+										"value": "linkerd-identity-headless." + parent.Spec.Namespace + ".svc.cluster.local.:8080",
+									},
+									map[string]interface{}{
+										"name": "LINKERD2_PROXY_IDENTITY_SVC_NAME",
+										// controlled by field: namespace
+										"value": "linkerd-identity." + parent.Spec.Namespace + ".serviceaccount.identity.linkerd.cluster.local",
+									},
+									map[string]interface{}{
+										"name":  "LINKERD2_PROXY_IDENTITY_LOCAL_NAME",
+										"value": "linkerd-proxy-injector.$(_pod_ns).serviceaccount.identity.linkerd.cluster.local",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "linkerd-proxy",
+										"containerPort": 4143,
+									},
+									map[string]interface{}{
+										"name":          "linkerd-admin",
+										"containerPort": 4191,
+									},
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 4191,
+									},
+									"initialDelaySeconds": 2,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "20Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2102,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "linkerd-identity-end-entity",
+										"mountPath": "/var/run/linkerd/identity/end-entity",
+									},
+									map[string]interface{}{
+										"name":      "linkerd-identity-token",
+										"mountPath": "/var/run/secrets/tokens",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name": "proxy-injector",
+								// controlled by field: mesh.linkerd.controllerImage
+								// controlled by field: mesh.linkerd.version
+								//  Image repo and name to use for the linkerd controllers.
+								//  Version of linkerd to use.
+								"image":           "" + parent.Spec.Mesh.Linkerd.ControllerImage + ":" + parent.Spec.Mesh.Linkerd.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"proxy-injector",
+									"-log-level=info",
+									"-log-format=plain",
+									"-linkerd-namespace=$(POD_NAMESPACE)",
+									"-enable-pprof=false",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "proxy-injector",
+										"containerPort": 8443,
+									},
+									map[string]interface{}{
+										"name":          "admin-http",
+										"containerPort": 9995,
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ping",
+										"port": 9995,
+									},
+									"initialDelaySeconds": 10,
+								},
+								"readinessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/ready",
+										"port": 9995,
+									},
+									"failureThreshold": 7,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "50Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "250m",
+										"memory": "250Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+									"runAsUser":                2103,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "config",
+										"mountPath": "/var/run/linkerd/config",
+									},
+									map[string]interface{}{
+										"name":      "trust-roots",
+										"mountPath": "/var/run/linkerd/identity/trust-roots",
+									},
+									map[string]interface{}{
+										"name":      "tls",
+										"mountPath": "/var/run/linkerd/tls",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"securityContext": map[string]interface{}{
+							"seccompProfile": map[string]interface{}{
+								"type": "RuntimeDefault",
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "config",
+								"configMap": map[string]interface{}{
+									"name": "linkerd-config",
+								},
+							},
+							map[string]interface{}{
+								"name": "trust-roots",
+								"configMap": map[string]interface{}{
+									"name": "linkerd-identity-trust-roots",
+								},
+							},
+							map[string]interface{}{
+								"name": "tls",
+								"secret": map[string]interface{}{
+									"secretName": "linkerd-proxy-injector-k8s-tls",
+								},
+							},
+							map[string]interface{}{
+								"name":     "linkerd-proxy-init-xtables-lock",
+								"emptyDir": map[string]interface{}{},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-end-entity",
+								"emptyDir": map[string]interface{}{
+									"medium": "Memory",
+								},
+							},
+							map[string]interface{}{
+								"name": "linkerd-identity-token",
+								"projected": map[string]interface{}{
+									"sources": []interface{}{
+										map[string]interface{}{
+											"serviceAccountToken": map[string]interface{}{
+												"audience":          "identity.l5d.io",
+												"expirationSeconds": 86400,
+												"path":              "linkerd-identity-token",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
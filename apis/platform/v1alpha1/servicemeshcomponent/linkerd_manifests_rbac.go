@@ -0,0 +1,594 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceLinkerdIdentity creates the ServiceAccount resource with name linkerd-identity.
+func CreateServiceAccountNamespaceLinkerdIdentity(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-identity",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceLinkerdIdentity(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceLinkerdDestination creates the ServiceAccount resource with name linkerd-destination.
+func CreateServiceAccountNamespaceLinkerdDestination(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-destination",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceLinkerdDestination(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceLinkerdProxyInjector creates the ServiceAccount resource with name linkerd-proxy-injector.
+func CreateServiceAccountNamespaceLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-proxy-injector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// CreateClusterRoleLinkerdIdentity creates the ClusterRole resource with name linkerd-identity.
+func CreateClusterRoleLinkerdIdentity(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-identity",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"authentication.k8s.io",
+					},
+					"resources": []interface{}{
+						"tokenreviews",
+					},
+					"verbs": []interface{}{
+						"create",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"deployments",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+						"patch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleLinkerdIdentity(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingLinkerdIdentity creates the ClusterRoleBinding resource with name linkerd-identity.
+func CreateClusterRoleBindingLinkerdIdentity(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-identity",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "linkerd-identity",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "linkerd-identity",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingLinkerdIdentity(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=list;get;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list;get;watch
+// +kubebuilder:rbac:groups=linkerd.io,resources=serviceprofiles,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=servers,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=serverauthorizations,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=authorizationpolicies,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=httproutes,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=meshtlsauthentications,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=networkauthentications,verbs=list;get;watch
+// +kubebuilder:rbac:groups=policy.linkerd.io,resources=httproutes/status,verbs=patch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;patch
+
+// CreateClusterRoleLinkerdDestination creates the ClusterRole resource with name linkerd-destination.
+func CreateClusterRoleLinkerdDestination(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-destination",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"apps",
+					},
+					"resources": []interface{}{
+						"replicasets",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"batch",
+					},
+					"resources": []interface{}{
+						"jobs",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+						"endpoints",
+						"services",
+						"nodes",
+						"namespaces",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"discovery.k8s.io",
+					},
+					"resources": []interface{}{
+						"endpointslices",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"linkerd.io",
+					},
+					"resources": []interface{}{
+						"serviceprofiles",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"policy.linkerd.io",
+					},
+					"resources": []interface{}{
+						"servers",
+						"serverauthorizations",
+						"authorizationpolicies",
+						"httproutes",
+						"meshtlsauthentications",
+						"networkauthentications",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"policy.linkerd.io",
+					},
+					"resources": []interface{}{
+						"httproutes/status",
+					},
+					"verbs": []interface{}{
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"get",
+						"create",
+						"patch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleLinkerdDestination(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingLinkerdDestination creates the ClusterRoleBinding resource with name linkerd-destination.
+func CreateClusterRoleBindingLinkerdDestination(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-destination",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "linkerd-destination",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "linkerd-destination",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingLinkerdDestination(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=replicationcontrollers,verbs=list;get;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=list;watch
+// +kubebuilder:rbac:groups=extensions,resources=deployments,verbs=list;get;watch
+// +kubebuilder:rbac:groups=extensions,resources=replicasets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=extensions,resources=daemonsets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=extensions,resources=statefulsets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=list;get;watch
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=list;get;watch
+// +kubebuilder:rbac:groups=extensions,resources=cronjobs,verbs=list;get;watch
+// +kubebuilder:rbac:groups=extensions,resources=jobs,verbs=list;get;watch
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=list;get;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=list;get;watch
+
+// CreateClusterRoleLinkerdProxyInjector creates the ClusterRole resource with name linkerd-proxy-injector.
+func CreateClusterRoleLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-proxy-injector",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"create",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"namespaces",
+						"replicationcontrollers",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"pods",
+					},
+					"verbs": []interface{}{
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"extensions",
+						"apps",
+					},
+					"resources": []interface{}{
+						"deployments",
+						"replicasets",
+						"daemonsets",
+						"statefulsets",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"extensions",
+						"batch",
+					},
+					"resources": []interface{}{
+						"cronjobs",
+						"jobs",
+					},
+					"verbs": []interface{}{
+						"list",
+						"get",
+						"watch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingLinkerdProxyInjector creates the ClusterRoleBinding resource with name linkerd-proxy-injector.
+func CreateClusterRoleBindingLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name": "linkerd-proxy-injector",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "linkerd-proxy-injector",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "linkerd-proxy-injector",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdIdentity creates the Service resource with name linkerd-identity.
+func CreateServiceNamespaceLinkerdIdentity(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-identity",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "identity",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "grpc",
+						"port":       8080,
+						"targetPort": 8080,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdIdentity(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdIdentityHeadless creates the Service resource with name linkerd-identity-headless.
+func CreateServiceNamespaceLinkerdIdentityHeadless(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-identity-headless",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type":      "ClusterIP",
+				"clusterIP": "None",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "identity",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "grpc",
+						"port":       8080,
+						"targetPort": 8080,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdIdentityHeadless(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdDst creates the Service resource with name linkerd-dst.
+func CreateServiceNamespaceLinkerdDst(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-dst",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "destination",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "grpc",
+						"port":       8086,
+						"targetPort": 8086,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdDst(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdDstHeadless creates the Service resource with name linkerd-dst-headless.
+func CreateServiceNamespaceLinkerdDstHeadless(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-dst-headless",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type":      "ClusterIP",
+				"clusterIP": "None",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "destination",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "grpc",
+						"port":       8086,
+						"targetPort": 8086,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdDstHeadless(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdPolicy creates the Service resource with name linkerd-policy.
+func CreateServiceNamespaceLinkerdPolicy(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-policy",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type":      "ClusterIP",
+				"clusterIP": "None",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "destination",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "grpc",
+						"port":       8090,
+						"targetPort": 8090,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdPolicy(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdSpValidator creates the Service resource with name linkerd-sp-validator.
+func CreateServiceNamespaceLinkerdSpValidator(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-sp-validator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "destination",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "sp-validator",
+						"port":       443,
+						"targetPort": "sp-validator",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdSpValidator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdPolicyValidator creates the Service resource with name linkerd-policy-validator.
+func CreateServiceNamespaceLinkerdPolicyValidator(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-policy-validator",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "destination",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "policy-https",
+						"port":       443,
+						"targetPort": "policy-https",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdPolicyValidator(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceNamespaceLinkerdProxyInjector creates the Service resource with name linkerd-proxy-injector.
+func CreateServiceNamespaceLinkerdProxyInjector(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"name":      "linkerd-proxy-injector",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"type": "ClusterIP",
+				"selector": map[string]interface{}{
+					"linkerd.io/control-plane-component": "proxy-injector",
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"name":       "proxy-injector",
+						"port":       443,
+						"targetPort": "proxy-injector",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateServiceNamespaceLinkerdProxyInjector(resourceObj, parent, collection, reconciler, req)
+}
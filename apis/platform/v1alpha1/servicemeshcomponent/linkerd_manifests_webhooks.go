@@ -0,0 +1,295 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateMutatingWebhookLinkerdProxyInjectorWebhookConfig creates the MutatingWebhookConfiguration resource with name linkerd-proxy-injector-webhook-config.
+func CreateMutatingWebhookLinkerdProxyInjectorWebhookConfig(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "MutatingWebhookConfiguration",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"annotations": map[string]interface{}{
+					// controlled by field: namespace
+					"cert-manager.io/inject-ca-from": "" + parent.Spec.Namespace + "/linkerd-proxy-injector",
+				},
+				"name": "linkerd-proxy-injector-webhook-config",
+			},
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name": "linkerd-proxy-injector.linkerd.io",
+					"namespaceSelector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "config.linkerd.io/admission-webhooks",
+								"operator": "NotIn",
+								"values": []interface{}{
+									"disabled",
+								},
+							},
+							map[string]interface{}{
+								"key":      "kubernetes.io/metadata.name",
+								"operator": "NotIn",
+								"values": []interface{}{
+									"kube-system",
+									"cert-manager",
+								},
+							},
+						},
+					},
+					"objectSelector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "linkerd.io/control-plane-component",
+								"operator": "DoesNotExist",
+							},
+							map[string]interface{}{
+								"key":      "linkerd.io/cni-resource",
+								"operator": "DoesNotExist",
+							},
+						},
+					},
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "linkerd-proxy-injector",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/",
+						},
+					},
+					"failurePolicy": "Ignore",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+					"reinvocationPolicy": "IfNeeded",
+					"rules": []interface{}{
+						map[string]interface{}{
+							"operations": []interface{}{
+								"CREATE",
+							},
+							"apiGroups": []interface{}{
+								"",
+							},
+							"apiVersions": []interface{}{
+								"v1",
+							},
+							"resources": []interface{}{
+								"pods",
+								"services",
+							},
+							"scope": "Namespaced",
+						},
+					},
+					"sideEffects": "None",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateMutatingWebhookLinkerdProxyInjectorWebhookConfig(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateValidatingWebhookLinkerdSpValidatorWebhookConfig creates the ValidatingWebhookConfiguration resource with name linkerd-sp-validator-webhook-config.
+func CreateValidatingWebhookLinkerdSpValidatorWebhookConfig(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"annotations": map[string]interface{}{
+					// controlled by field: namespace
+					"cert-manager.io/inject-ca-from": "" + parent.Spec.Namespace + "/linkerd-sp-validator",
+				},
+				"name": "linkerd-sp-validator-webhook-config",
+			},
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name": "linkerd-sp-validator.linkerd.io",
+					"namespaceSelector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "config.linkerd.io/admission-webhooks",
+								"operator": "NotIn",
+								"values": []interface{}{
+									"disabled",
+								},
+							},
+						},
+					},
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "linkerd-sp-validator",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/",
+						},
+					},
+					"failurePolicy": "Fail",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"operations": []interface{}{
+								"CREATE",
+								"UPDATE",
+							},
+							"apiGroups": []interface{}{
+								"linkerd.io",
+							},
+							"apiVersions": []interface{}{
+								"*",
+							},
+							"resources": []interface{}{
+								"serviceprofiles",
+							},
+						},
+					},
+					"sideEffects": "None",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateValidatingWebhookLinkerdSpValidatorWebhookConfig(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
+
+// CreateValidatingWebhookLinkerdPolicyValidatorWebhookConfig creates the ValidatingWebhookConfiguration resource with name linkerd-policy-validator-webhook-config.
+func CreateValidatingWebhookLinkerdPolicyValidatorWebhookConfig(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Mesh.Type != "linkerd" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=mesh.type,value="linkerd",include
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "linkerd",
+				},
+				"annotations": map[string]interface{}{
+					// controlled by field: namespace
+					"cert-manager.io/inject-ca-from": "" + parent.Spec.Namespace + "/linkerd-policy-validator",
+				},
+				"name": "linkerd-policy-validator-webhook-config",
+			},
+			"webhooks": []interface{}{
+				map[string]interface{}{
+					"name": "linkerd-policy-validator.linkerd.io",
+					"namespaceSelector": map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{
+								"key":      "config.linkerd.io/admission-webhooks",
+								"operator": "NotIn",
+								"values": []interface{}{
+									"disabled",
+								},
+							},
+						},
+					},
+					"clientConfig": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name":      "linkerd-policy-validator",
+							"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+							"path":      "/",
+						},
+					},
+					"failurePolicy": "Fail",
+					"admissionReviewVersions": []interface{}{
+						"v1",
+						"v1beta1",
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"operations": []interface{}{
+								"CREATE",
+								"UPDATE",
+							},
+							"apiGroups": []interface{}{
+								"policy.linkerd.io",
+							},
+							"apiVersions": []interface{}{
+								"*",
+							},
+							"resources": []interface{}{
+								"authorizationpolicies",
+								"httproutes",
+								"networkauthentications",
+								"meshtlsauthentications",
+								"serverauthorizations",
+								"servers",
+							},
+						},
+					},
+					"sideEffects": "None",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateValidatingWebhookLinkerdPolicyValidatorWebhookConfig(resourceObj, parent, collection, reconciler, req)
+}
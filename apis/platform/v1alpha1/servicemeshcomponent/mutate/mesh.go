@@ -0,0 +1,224 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+const (
+	linkerd = "linkerd"
+
+	// trustAnchorSecret is the secret of the trust anchor of the mesh, which is issued by
+	// cert-manager.
+	trustAnchorSecret = "mesh-trust-anchor"
+
+	// trustRootsKey is the key of the trust roots in the linkerd-identity-trust-roots config map.
+	trustRootsKey = "ca-bundle.crt"
+)
+
+// setNamespaceLabels labels the namespace of the component as the control plane of linkerd, which
+// also keeps the control plane from being injected by its own proxy injector.
+func setNamespaceLabels(original client.Object, parent *platformv1alpha1.ServiceMeshComponent) {
+	if parent.Spec.Mesh.Type != linkerd {
+		return
+	}
+
+	labels := original.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels["linkerd.io/is-control-plane"] = "true"
+	labels["linkerd.io/control-plane-ns"] = parent.Spec.Namespace
+	labels["config.linkerd.io/admission-webhooks"] = "disabled"
+
+	original.SetLabels(labels)
+}
+
+// linkerdValues returns the values of the linkerd-config config map which are set from the spec
+// of the component, keyed by their path in the values.  The proxy injector reads the images of
+// the proxies which it injects into the workloads of the mesh from these values.
+func linkerdValues(
+	parent *platformv1alpha1.ServiceMeshComponent, collection *setupv1alpha1.SupportServices,
+) (map[string]string, error) {
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	linkerdSpec := parent.Spec.Mesh.Linkerd
+	mirrors := collection.Spec.RegistryMirrors
+
+	return map[string]string{
+		"proxy.image.name":        podtemplate.MirrorImage(linkerdSpec.ProxyImage, mirrors),
+		"proxy.image.version":     linkerdSpec.Version,
+		"proxy.logLevel":          proxyLogLevel(profile.LogLevel),
+		"proxyInit.image.name":    podtemplate.MirrorImage(linkerdSpec.ProxyInitImage, mirrors),
+		"proxyInit.image.version": linkerdSpec.ProxyInitVersion,
+	}, nil
+}
+
+// setLinkerdConfig sets the values of the linkerd-config config map from the spec of the
+// component.
+func setLinkerdConfig(
+	original client.Object,
+	parent *platformv1alpha1.ServiceMeshComponent, collection *setupv1alpha1.SupportServices,
+) error {
+	settings, err := linkerdValues(parent, collection)
+	if err != nil {
+		return err
+	}
+
+	return setConfigValues(original, "values", func(values map[string]interface{}) {
+		for path, value := range settings {
+			setPath(values, path, value)
+		}
+	})
+}
+
+// setIstioMeshConfig points the mesh configuration of istio at the namespace of the component,
+// which is the root namespace of the mesh and the namespace of istiod.
+func setIstioMeshConfig(original client.Object, parent *platformv1alpha1.ServiceMeshComponent) error {
+	return setConfigValues(original, "mesh", func(values map[string]interface{}) {
+		setPath(values, "rootNamespace", parent.Spec.Namespace)
+		setPath(values, "defaultConfig.discoveryAddress", fmt.Sprintf("istiod.%s.svc:15012", parent.Spec.Namespace))
+	})
+}
+
+// setConfigValues parses the yaml document of the given key of a config map, applies the given
+// mutation to it and writes it back.
+func setConfigValues(original client.Object, key string, mutate func(map[string]interface{})) error {
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	data, _ := configMap.Object["data"].(map[string]interface{})
+	document, _ := data[key].(string)
+
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(document), &values); err != nil {
+		return fmt.Errorf("unable to parse %s of config map %s; %w", key, original.GetName(), err)
+	}
+
+	mutate(values)
+
+	rendered, err := yaml.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("unable to render %s of config map %s; %w", key, original.GetName(), err)
+	}
+
+	data[key] = string(rendered)
+
+	return nil
+}
+
+// setPath sets the value at the given dotted path of a yaml document, creating the intermediate
+// maps as needed.
+func setPath(values map[string]interface{}, path, value string) {
+	current := values
+	keys := strings.Split(path, ".")
+
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+
+		current = next
+	}
+
+	current[keys[len(keys)-1]] = value
+}
+
+// trustRoots returns the certificate of the trust anchor of the mesh.  The trust roots are empty
+// until cert-manager has issued the trust anchor, in which case the control plane is not ready
+// and the component is reconciled again.
+func trustRoots(reconciler workload.Reconciler, req *workload.Request, namespace string) (string, error) {
+	secret := &corev1.Secret{}
+
+	if err := reconciler.Get(req.Context, types.NamespacedName{Name: trustAnchorSecret, Namespace: namespace}, secret); err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("unable to get trust anchor secret %s/%s; %w", namespace, trustAnchorSecret, err)
+	}
+
+	return string(secret.Data[corev1.TLSCertKey]), nil
+}
+
+// setTrustRoots copies the certificate of the trust anchor of the mesh into the
+// linkerd-identity-trust-roots config map, from which the proxies read their trust anchors.
+func setTrustRoots(
+	original client.Object,
+	parent *platformv1alpha1.ServiceMeshComponent,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	roots, err := trustRoots(reconciler, req, parent.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+
+	configMap, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	return unstructured.SetNestedField(configMap.Object, roots, "data", trustRootsKey)
+}
+
+// setLinkerdConfigHash rolls out the pods of a deployment of the linkerd control plane when the
+// configuration of linkerd or, when reconciling, the trust roots of the mesh change.
+func setLinkerdConfigHash(
+	original client.Object,
+	parent *platformv1alpha1.ServiceMeshComponent, collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	data, err := linkerdValues(parent, collection)
+	if err != nil {
+		return err
+	}
+
+	if reconciler != nil && req != nil {
+		roots, err := trustRoots(reconciler, req, parent.Spec.Namespace)
+		if err != nil {
+			return err
+		}
+
+		data[trustRootsKey] = roots
+	}
+
+	return podtemplate.SetConfigHash(original, data)
+}
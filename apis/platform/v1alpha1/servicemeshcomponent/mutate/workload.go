@@ -0,0 +1,126 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// logLevelFlags are the flags which set the log level of the controllers of the mesh, keyed by
+// the container name.
+var logLevelFlags = map[string]string{
+	"identity":       "-log-level",
+	"destination":    "-log-level",
+	"sp-validator":   "-log-level",
+	"proxy-injector": "-log-level",
+	"policy":         "--log-level",
+}
+
+// mutateWorkload applies the settings which are common to all workloads of the component.
+func mutateWorkload(
+	original client.Object,
+	parent *platformv1alpha1.ServiceMeshComponent, collection *setupv1alpha1.SupportServices,
+	scheduling *setupv1alpha1.SchedulingSpec,
+) error {
+	if err := podtemplate.SetImageRegistry(original, collection); err != nil {
+		return err
+	}
+
+	profile, err := tier.ForCollection(collection)
+	if err != nil {
+		return err
+	}
+
+	if err := podtemplate.ScaleResources(original, profile.ResourcePercent); err != nil {
+		return err
+	}
+
+	if replicas, ok := parent.EffectiveReplicas(profile.TierProfileSpec)[original.GetName()]; ok {
+		if err := podtemplate.SetReplicas(original, replicas); err != nil {
+			return err
+		}
+	}
+
+	if err := setLogLevel(original, profile.LogLevel); err != nil {
+		return err
+	}
+
+	if scheduling == nil {
+		return nil
+	}
+
+	return podtemplate.SetScheduling(original, collection.Spec.Scheduling.Override(*scheduling))
+}
+
+// setLogLevel sets the log level of each container of a workload of the mesh.  The proxies only
+// log their own messages at the given level to keep the noise of their dependencies down.
+func setLogLevel(original client.Object, level string) error {
+	containers, err := podtemplate.Containers(original)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		name, _ := container["name"].(string)
+
+		switch name {
+		case "discovery", "install-cni":
+			err = podtemplate.SetArg(original, name, "--log_output_level", "default:"+level)
+		case "linkerd-proxy":
+			err = podtemplate.SetEnv(original, name, "LINKERD2_PROXY_LOG", proxyLogLevel(level))
+		case "istio-proxy":
+			err = podtemplate.SetEnv(original, name, "RUST_LOG", level)
+		default:
+			if flag, ok := logLevelFlags[name]; ok {
+				err = podtemplate.SetArg(original, name, flag, level)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// proxyLogLevel returns the log filter of the linkerd proxy for the given log level.
+func proxyLogLevel(level string) string {
+	return "warn,linkerd=" + level
+}
+
+// reconcileWorkload applies the settings which are common to all workloads of the component when
+// reconciling.
+func reconcileWorkload(
+	original client.Object,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler, req *workload.Request,
+) error {
+	if err := podtemplate.PruneNodeSelector(req.Context, reconciler, original); err != nil {
+		return err
+	}
+
+	return imagepolicy.Apply(reconciler, req, original, collection)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemeshcomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/servicemeshcomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIssuerNamespaceMeshSelfsigned creates the Issuer resource with name mesh-selfsigned.
+func CreateIssuerNamespaceMeshSelfsigned(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Issuer",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+				"name":      "mesh-selfsigned",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selfSigned": map[string]interface{}{},
+			},
+		},
+	}
+
+	return mutate.MutateIssuerNamespaceMeshSelfsigned(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCertNamespaceMeshTrustAnchor creates the Certificate resource with name mesh-trust-anchor.
+func CreateCertNamespaceMeshTrustAnchor(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+				"name":      "mesh-trust-anchor",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"isCA":       true,
+				"commonName": "root.mesh.cluster.local",
+				"secretName": "mesh-trust-anchor",
+				// controlled by field: certificates.trustAnchor.duration
+				//  How long the trust anchor of the mesh is valid for.
+				"duration": parent.Spec.Certificates.TrustAnchor.Duration,
+				// controlled by field: certificates.trustAnchor.renewBefore
+				//  How long before the trust anchor of the mesh expires to renew it.
+				"renewBefore": parent.Spec.Certificates.TrustAnchor.RenewBefore,
+				"privateKey": map[string]interface{}{
+					"algorithm":      "ECDSA",
+					"size":           256,
+					"rotationPolicy": "Never",
+				},
+				"issuerRef": map[string]interface{}{
+					"kind": "Issuer",
+					"name": "mesh-selfsigned",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCertNamespaceMeshTrustAnchor(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateIssuerNamespaceMeshTrustAnchor creates the Issuer resource with name mesh-trust-anchor.
+func CreateIssuerNamespaceMeshTrustAnchor(
+	parent *platformv1alpha1.ServiceMeshComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Issuer",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "service-mesh",
+					"platform.nukleros.io/project": "cert-manager",
+				},
+				"name":      "mesh-trust-anchor",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"ca": map[string]interface{}{
+					"secretName": "mesh-trust-anchor",
+				},
+			},
+		},
+	}
+
+	return mutate.MutateIssuerNamespaceMeshTrustAnchor(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,411 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertServiceMeshComponent = errors.New("unable to convert to ServiceMeshComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ServiceMeshComponentSpec defines the desired state of ServiceMeshComponent.
+type ServiceMeshComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection ServiceMeshComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-mesh-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-mesh-system")
+	//
+	//	Namespace to use for service mesh support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Mesh ServiceMeshComponentSpecMesh `json:"mesh,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Certificates ServiceMeshComponentSpecCertificates `json:"certificates,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Injection ServiceMeshComponentSpecInjection `json:"injection,omitempty"`
+}
+
+type ServiceMeshComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type ServiceMeshComponentSpecMesh struct {
+	// +kubebuilder:default="linkerd"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=linkerd;istio
+	// (Default: "linkerd")
+	//
+	//	Service mesh to install.  One of: linkerd | istio.  istio is installed in ambient mode,
+	//	without sidecars.
+	Type string `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Linkerd ServiceMeshComponentSpecMeshLinkerd `json:"linkerd,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Istio ServiceMeshComponentSpecMeshIstio `json:"istio,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for each deployment of the control plane of the mesh.  Defaults
+	//	to the number of replicas of the tier of the collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the deployments of the control plane of the mesh.  Settings
+	//	which are set here take precedence over the pod disruption budget settings of the
+	//	collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the deployments of the control plane of the mesh.  Settings which
+	//	are set here take precedence over the scheduling settings of the collection.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+}
+
+type ServiceMeshComponentSpecMeshLinkerd struct {
+	// +kubebuilder:default="cr.l5d.io/linkerd/controller"
+	// +kubebuilder:validation:Optional
+	// (Default: "cr.l5d.io/linkerd/controller")
+	//
+	//	Image repo and name to use for the linkerd controllers.
+	ControllerImage string `json:"controllerImage,omitempty"`
+
+	// +kubebuilder:default="cr.l5d.io/linkerd/policy-controller"
+	// +kubebuilder:validation:Optional
+	// (Default: "cr.l5d.io/linkerd/policy-controller")
+	//
+	//	Image repo and name to use for the linkerd policy controller.
+	PolicyControllerImage string `json:"policyControllerImage,omitempty"`
+
+	// +kubebuilder:default="cr.l5d.io/linkerd/proxy"
+	// +kubebuilder:validation:Optional
+	// (Default: "cr.l5d.io/linkerd/proxy")
+	//
+	//	Image repo and name to use for the linkerd proxy.
+	ProxyImage string `json:"proxyImage,omitempty"`
+
+	// +kubebuilder:default="stable-2.12.4"
+	// +kubebuilder:validation:Optional
+	// (Default: "stable-2.12.4")
+	//
+	//	Version of linkerd to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:default="cr.l5d.io/linkerd/proxy-init"
+	// +kubebuilder:validation:Optional
+	// (Default: "cr.l5d.io/linkerd/proxy-init")
+	//
+	//	Image repo and name to use for the linkerd proxy init container.
+	ProxyInitImage string `json:"proxyInitImage,omitempty"`
+
+	// +kubebuilder:default="v2.0.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v2.0.0")
+	//
+	//	Version of the linkerd proxy init container to use.
+	ProxyInitVersion string `json:"proxyInitVersion,omitempty"`
+}
+
+type ServiceMeshComponentSpecMeshIstio struct {
+	// +kubebuilder:default="docker.io/istio/pilot"
+	// +kubebuilder:validation:Optional
+	// (Default: "docker.io/istio/pilot")
+	//
+	//	Image repo and name to use for istiod.
+	PilotImage string `json:"pilotImage,omitempty"`
+
+	// +kubebuilder:default="docker.io/istio/install-cni"
+	// +kubebuilder:validation:Optional
+	// (Default: "docker.io/istio/install-cni")
+	//
+	//	Image repo and name to use for the istio CNI plugin.
+	CNIImage string `json:"cniImage,omitempty"`
+
+	// +kubebuilder:default="docker.io/istio/ztunnel"
+	// +kubebuilder:validation:Optional
+	// (Default: "docker.io/istio/ztunnel")
+	//
+	//	Image repo and name to use for ztunnel.
+	ZtunnelImage string `json:"ztunnelImage,omitempty"`
+
+	// +kubebuilder:default="1.18.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "1.18.0")
+	//
+	//	Version of istio to use.
+	Version string `json:"version,omitempty"`
+}
+
+type ServiceMeshComponentSpecCertificates struct {
+	// +kubebuilder:validation:Optional
+	TrustAnchor ServiceMeshComponentSpecCertificatesTrustAnchor `json:"trustAnchor,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Issuer ServiceMeshComponentSpecCertificatesIssuer `json:"issuer,omitempty"`
+}
+
+type ServiceMeshComponentSpecCertificatesTrustAnchor struct {
+	// +kubebuilder:default="87600h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "87600h0m0s")
+	//
+	//	How long the trust anchor of the mesh is valid for.
+	Duration string `json:"duration,omitempty"`
+
+	// +kubebuilder:default="8760h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "8760h0m0s")
+	//
+	//	How long before the trust anchor of the mesh expires to renew it.
+	RenewBefore string `json:"renewBefore,omitempty"`
+}
+
+type ServiceMeshComponentSpecCertificatesIssuer struct {
+	// +kubebuilder:default="48h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "48h0m0s")
+	//
+	//	How long the certificate authority of the control plane of the mesh, which is issued by the
+	//	trust anchor, is valid for.
+	Duration string `json:"duration,omitempty"`
+
+	// +kubebuilder:default="25h0m0s"
+	// +kubebuilder:validation:Optional
+	// (Default: "25h0m0s")
+	//
+	//	How long before the certificate authority of the control plane of the mesh expires to renew it.
+	RenewBefore string `json:"renewBefore,omitempty"`
+}
+
+type ServiceMeshComponentSpecInjection struct {
+	// +kubebuilder:validation:Optional
+	//	Selects the namespaces whose workloads join the mesh.  The selected namespaces are
+	//	annotated for proxy injection by linkerd or labeled for the ambient mesh of istio.  The
+	//	kubernetes system namespaces and the namespace of the component are never selected.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+type ServiceMeshComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// ServiceMeshComponent is the Schema for the servicemeshcomponents API.
+type ServiceMeshComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServiceMeshComponentSpec   `json:"spec,omitempty"`
+	Status            ServiceMeshComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceMeshComponentList contains a list of ServiceMeshComponent.
+type ServiceMeshComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceMeshComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *ServiceMeshComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *ServiceMeshComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *ServiceMeshComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *ServiceMeshComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *ServiceMeshComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *ServiceMeshComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *ServiceMeshComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *ServiceMeshComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *ServiceMeshComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*ServiceMeshComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{
+		&CertificatesComponent{},
+	}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*ServiceMeshComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("ServiceMeshComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *ServiceMeshComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *ServiceMeshComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name, after defaulting from the given tier profile.  Only the deployments of the
+// control plane of the mesh are replicated.
+func (component *ServiceMeshComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	replicas := map[string]int{}
+
+	for _, name := range component.ControlPlaneDeployments() {
+		replicas[name] = profile.ReplicasFor(component.Spec.Mesh.Replicas)
+	}
+
+	return replicas
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *ServiceMeshComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{}
+
+	for _, name := range component.ControlPlaneDeployments() {
+		budgets[name] = component.Spec.Mesh.PodDisruptionBudget
+	}
+
+	return budgets
+}
+
+// ControlPlaneDeployments returns the names of the deployments of the control plane of the mesh.
+func (component *ServiceMeshComponent) ControlPlaneDeployments() []string {
+	if component.Spec.Mesh.Type == "istio" {
+		return []string{"istiod"}
+	}
+
+	return []string{"linkerd-identity", "linkerd-destination", "linkerd-proxy-injector"}
+}
+
+// ControlPlaneDaemonSets returns the names of the daemonsets of the control plane of the mesh.
+func (component *ServiceMeshComponent) ControlPlaneDaemonSets() []string {
+	if component.Spec.Mesh.Type == "istio" {
+		return []string{"istio-cni-node", "ztunnel"}
+	}
+
+	return []string{}
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceMeshComponent{}, &ServiceMeshComponentList{})
+}
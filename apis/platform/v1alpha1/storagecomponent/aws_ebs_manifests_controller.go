@@ -0,0 +1,339 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceEbsCsiController creates the Deployment resource with name ebs-csi-controller.
+func CreateDeploymentNamespaceEbsCsiController(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-controller",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "aws-ebs-csi-driver",
+						"app.kubernetes.io/component": "controller",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+							"app.kubernetes.io/component":  "controller",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "aws-ebs-csi-driver",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "ebs-csi-controller-sa",
+						"priorityClassName":  "system-cluster-critical",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "ebs-plugin",
+								// controlled by field: driver.awsEBS.image
+								// controlled by field: driver.awsEBS.version
+								//  Image repo and name to use for aws-ebs-csi-driver.
+								//  Version of aws-ebs-csi-driver to use.
+								"image":           "" + parent.Spec.Driver.AWSEBS.Image + ":" + parent.Spec.Driver.AWSEBS.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"controller",
+									"--endpoint=$(CSI_ENDPOINT)",
+									"--logtostderr",
+									"--v=2",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CSI_ENDPOINT",
+										"value": "unix:///var/lib/csi/sockets/pluginproxy/csi.sock",
+									},
+									map[string]interface{}{
+										"name": "CSI_NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "healthz",
+										"containerPort": 9808,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": "healthz",
+									},
+									"initialDelaySeconds": 10,
+									"timeoutSeconds":      3,
+									"periodSeconds":       10,
+									"failureThreshold":    5,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-provisioner",
+								"image":           "registry.k8s.io/sig-storage/csi-provisioner:v3.2.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--feature-gates=Topology=true",
+									"--extra-create-metadata",
+									"--leader-election=true",
+									"--default-fstype=ext4",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/var/lib/csi/sockets/pluginproxy/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-attacher",
+								"image":           "registry.k8s.io/sig-storage/csi-attacher:v3.5.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/var/lib/csi/sockets/pluginproxy/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-resizer",
+								"image":           "registry.k8s.io/sig-storage/csi-resizer:v1.5.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+									"--handle-volume-inuse-error=false",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/var/lib/csi/sockets/pluginproxy/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-snapshotter",
+								"image":           "registry.k8s.io/sig-storage/csi-snapshotter:v6.0.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+									"--extra-create-metadata",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/var/lib/csi/sockets/pluginproxy/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "liveness-probe",
+								"image":           "registry.k8s.io/sig-storage/livenessprobe:v2.7.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=/var/lib/csi/sockets/pluginproxy/csi.sock",
+									"--health-port=9808",
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/var/lib/csi/sockets/pluginproxy/",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "socket-dir",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceEbsCsiController(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceEbsCsiNode creates the DaemonSet resource with name ebs-csi-node.
+func CreateDaemonSetNamespaceEbsCsiNode(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "node",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-node",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "aws-ebs-csi-driver",
+						"app.kubernetes.io/component": "node",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "10%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+							"app.kubernetes.io/component":  "node",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "aws-ebs-csi-driver",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "ebs-csi-node-sa",
+						"priorityClassName":  "system-node-critical",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"operator": "Exists",
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "ebs-plugin",
+								// controlled by field: driver.awsEBS.image
+								// controlled by field: driver.awsEBS.version
+								//  Image repo and name to use for aws-ebs-csi-driver.
+								//  Version of aws-ebs-csi-driver to use.
+								"image":           "" + parent.Spec.Driver.AWSEBS.Image + ":" + parent.Spec.Driver.AWSEBS.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"node",
+									"--endpoint=$(CSI_ENDPOINT)",
+									"--logtostderr",
+									"--v=2",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CSI_ENDPOINT",
+										"value": "unix:/csi/csi.sock",
+									},
+									map[string]interface{}{
+										"name": "CSI_NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "healthz",
+										"containerPort": 9808,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": "healthz",
+									},
+									"initialDelaySeconds": 10,
+									"timeoutSeconds":      3,
+									"periodSeconds":       10,
+									"failureThreshold":    5,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"privileged": true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":             "kubelet-dir",
+										"mountPath":        "/var/lib/kubelet",
+										"mountPropagation": "Bidirectional",
+									},
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+									map[string]interface{}{
+										"name":      "device-dir",
+										"mountPath": "/dev",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "node-driver-registrar",
+								"image":           "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.5.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--kubelet-registration-path=$(DRIVER_REG_SOCK_PATH)",
+									"--v=2",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+									map[string]interface{}{
+										"name":  "DRIVER_REG_SOCK_PATH",
+										"value": "/var/lib/kubelet/plugins/ebs.csi.aws.com/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+									map[string]interface{}{
+										"name":      "registration-dir",
+										"mountPath": "/registration",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "liveness-probe",
+								"image":           "registry.k8s.io/sig-storage/livenessprobe:v2.7.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=/csi/csi.sock",
+									"--health-port=9808",
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "kubelet-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "plugin-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet/plugins/ebs.csi.aws.com/",
+									"type": "DirectoryOrCreate",
+								},
+							},
+							map[string]interface{}{
+								"name": "registration-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet/plugins_registry/",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "device-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/dev",
+									"type": "Directory",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceEbsCsiNode(resourceObj, parent, collection, reconciler, req)
+}
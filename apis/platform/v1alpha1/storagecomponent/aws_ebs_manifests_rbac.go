@@ -0,0 +1,530 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceEbsCsiControllerSa creates the ServiceAccount resource with name ebs-csi-controller-sa.
+func CreateServiceAccountNamespaceEbsCsiControllerSa(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-controller-sa",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceEbsCsiControllerSa(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims/status,verbs=update;patch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csinodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments/status,verbs=patch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents/status,verbs=update;patch
+
+// CreateClusterRoleEbsCsiController creates the ClusterRole resource with name ebs-csi-controller.
+func CreateClusterRoleEbsCsiController(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name": "ebs-csi-controller",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"persistentvolumes",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"update",
+						"patch",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"persistentvolumeclaims",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"update",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"persistentvolumeclaims/status",
+					},
+					"verbs": []interface{}{
+						"update",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+						"pods",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"events",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"update",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"storageclasses",
+						"csinodes",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"volumeattachments",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"update",
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"volumeattachments/status",
+					},
+					"verbs": []interface{}{
+						"patch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"snapshot.storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"volumesnapshotclasses",
+						"volumesnapshots",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"snapshot.storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"volumesnapshotcontents",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"update",
+						"patch",
+						"delete",
+					},
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"snapshot.storage.k8s.io",
+					},
+					"resources": []interface{}{
+						"volumesnapshotcontents/status",
+					},
+					"verbs": []interface{}{
+						"update",
+						"patch",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleEbsCsiController(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingEbsCsiController creates the ClusterRoleBinding resource with name ebs-csi-controller.
+func CreateClusterRoleBindingEbsCsiController(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name": "ebs-csi-controller",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "ebs-csi-controller",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "ebs-csi-controller-sa",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingEbsCsiController(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+
+// CreateServiceAccountNamespaceEbsCsiNodeSa creates the ServiceAccount resource with name ebs-csi-node-sa.
+func CreateServiceAccountNamespaceEbsCsiNodeSa(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "node",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-node-sa",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+		},
+	}
+
+	return mutate.MutateServiceAccountNamespaceEbsCsiNodeSa(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get
+
+// CreateClusterRoleEbsCsiNode creates the ClusterRole resource with name ebs-csi-node.
+func CreateClusterRoleEbsCsiNode(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "node",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name": "ebs-csi-node",
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"",
+					},
+					"resources": []interface{}{
+						"nodes",
+					},
+					"verbs": []interface{}{
+						"get",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleEbsCsiNode(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateClusterRoleBindingEbsCsiNode creates the ClusterRoleBinding resource with name ebs-csi-node.
+func CreateClusterRoleBindingEbsCsiNode(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "node",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name": "ebs-csi-node",
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "ClusterRole",
+				"name":     "ebs-csi-node",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "ebs-csi-node-sa",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateClusterRoleBindingEbsCsiNode(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRoleNamespaceEbsCsiControllerLeaderElection creates the Role resource with name ebs-csi-controller-leader-election.
+func CreateRoleNamespaceEbsCsiControllerLeaderElection(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "Role",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-controller-leader-election",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{
+						"coordination.k8s.io",
+					},
+					"resources": []interface{}{
+						"leases",
+					},
+					"verbs": []interface{}{
+						"get",
+						"list",
+						"watch",
+						"create",
+						"update",
+						"patch",
+						"delete",
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleNamespaceEbsCsiControllerLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+
+// CreateRoleBindingNamespaceEbsCsiControllerLeaderElection creates the RoleBinding resource with name ebs-csi-controller-leader-election.
+func CreateRoleBindingNamespaceEbsCsiControllerLeaderElection(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "aws-ebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="aws-ebs",include
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "aws-ebs-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "aws-ebs-csi-driver",
+				},
+				"name":      "ebs-csi-controller-leader-election",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "Role",
+				"name":     "ebs-csi-controller-leader-election",
+			},
+			"subjects": []interface{}{
+				map[string]interface{}{
+					"kind":      "ServiceAccount",
+					"name":      "ebs-csi-controller-sa",
+					"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+				},
+			},
+		},
+	}
+
+	return mutate.MutateRoleBindingNamespaceEbsCsiControllerLeaderElection(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceCsiAzurediskController creates the Deployment resource with name csi-azuredisk-controller.
+func CreateDeploymentNamespaceCsiAzurediskController(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "azure-disk" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="azure-disk",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "azuredisk-csi-driver",
+					"app.kubernetes.io/component":  "controller",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "azuredisk-csi-driver",
+				},
+				"name":      "csi-azuredisk-controller",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 2,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "azuredisk-csi-driver",
+						"app.kubernetes.io/component": "controller",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "azuredisk-csi-driver",
+							"app.kubernetes.io/component":  "controller",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "azuredisk-csi-driver",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "csi-azuredisk-controller-sa",
+						"priorityClassName":  "system-cluster-critical",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "azuredisk",
+								// controlled by field: driver.azureDisk.image
+								// controlled by field: driver.azureDisk.version
+								//  Image repo and name to use for azuredisk-csi-driver.
+								//  Version of azuredisk-csi-driver to use.
+								"image":           "" + parent.Spec.Driver.AzureDisk.Image + ":" + parent.Spec.Driver.AzureDisk.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--v=5",
+									"--endpoint=$(CSI_ENDPOINT)",
+									"--metrics-address=0.0.0.0:29604",
+									"--disable-avset-nodes=false",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CSI_ENDPOINT",
+										"value": "unix:///csi/csi.sock",
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "healthz",
+										"containerPort": 29602,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": "healthz",
+									},
+									"initialDelaySeconds": 10,
+									"timeoutSeconds":      3,
+									"periodSeconds":       10,
+									"failureThreshold":    5,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-provisioner",
+								"image":           "registry.k8s.io/sig-storage/csi-provisioner:v3.2.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--feature-gates=Topology=true",
+									"--extra-create-metadata",
+									"--leader-election=true",
+									"--default-fstype=ext4",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-attacher",
+								"image":           "registry.k8s.io/sig-storage/csi-attacher:v3.5.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-resizer",
+								"image":           "registry.k8s.io/sig-storage/csi-resizer:v1.5.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+									"--handle-volume-inuse-error=false",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "csi-snapshotter",
+								"image":           "registry.k8s.io/sig-storage/csi-snapshotter:v6.0.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--v=2",
+									"--leader-election=true",
+									"--extra-create-metadata",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "liveness-probe",
+								"image":           "registry.k8s.io/sig-storage/livenessprobe:v2.7.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=/csi/csi.sock",
+									"--health-port=29602",
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "socket-dir",
+										"mountPath": "/csi/",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "socket-dir",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceCsiAzurediskController(resourceObj, parent, collection, reconciler, req)
+}
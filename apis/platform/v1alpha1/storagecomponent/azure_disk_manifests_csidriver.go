@@ -0,0 +1,64 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csidrivers,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCSIDriverDiskCsiAzureCom creates the CSIDriver resource with name disk.csi.azure.com.
+func CreateCSIDriverDiskCsiAzureCom(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "azure-disk" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="azure-disk",include
+			"apiVersion": "storage.k8s.io/v1",
+			"kind":       "CSIDriver",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "azuredisk-csi-driver",
+					"app.kubernetes.io/component":  "csi-driver",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "azuredisk-csi-driver",
+				},
+				"name": "disk.csi.azure.com",
+			},
+			"spec": map[string]interface{}{
+				"attachRequired": true,
+				"podInfoOnMount": false,
+			},
+		},
+	}
+
+	return mutate.MutateCSIDriverDiskCsiAzureCom(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDaemonSetNamespaceCsiAzurediskNode creates the DaemonSet resource with name csi-azuredisk-node.
+func CreateDaemonSetNamespaceCsiAzurediskNode(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "azure-disk" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="azure-disk",include
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "azuredisk-csi-driver",
+					"app.kubernetes.io/component":  "node",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "azuredisk-csi-driver",
+				},
+				"name":      "csi-azuredisk-node",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "azuredisk-csi-driver",
+						"app.kubernetes.io/component": "node",
+					},
+				},
+				"updateStrategy": map[string]interface{}{
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "10%",
+					},
+					"type": "RollingUpdate",
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "azuredisk-csi-driver",
+							"app.kubernetes.io/component":  "node",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "azuredisk-csi-driver",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "csi-azuredisk-node-sa",
+						"priorityClassName":  "system-node-critical",
+						"hostNetwork":        true,
+						"dnsPolicy":          "ClusterFirstWithHostNet",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"tolerations": []interface{}{
+							map[string]interface{}{
+								"operator": "Exists",
+							},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "azuredisk",
+								// controlled by field: driver.azureDisk.image
+								// controlled by field: driver.azureDisk.version
+								//  Image repo and name to use for azuredisk-csi-driver.
+								//  Version of azuredisk-csi-driver to use.
+								"image":           "" + parent.Spec.Driver.AzureDisk.Image + ":" + parent.Spec.Driver.AzureDisk.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--v=5",
+									"--endpoint=$(CSI_ENDPOINT)",
+									"--nodeid=$(KUBE_NODE_NAME)",
+									"--metrics-address=0.0.0.0:29605",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "CSI_ENDPOINT",
+										"value": "unix:///csi/csi.sock",
+									},
+									map[string]interface{}{
+										"name": "KUBE_NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "healthz",
+										"containerPort": 29603,
+										"protocol":      "TCP",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"httpGet": map[string]interface{}{
+										"path": "/healthz",
+										"port": "healthz",
+									},
+									"initialDelaySeconds": 10,
+									"timeoutSeconds":      3,
+									"periodSeconds":       10,
+									"failureThreshold":    5,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "200m",
+										"memory": "256Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"privileged": true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":             "kubelet-dir",
+										"mountPath":        "/var/lib/kubelet",
+										"mountPropagation": "Bidirectional",
+									},
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+									map[string]interface{}{
+										"name":      "device-dir",
+										"mountPath": "/dev",
+									},
+									map[string]interface{}{
+										"name":      "sys-devices-dir",
+										"mountPath": "/sys/bus/scsi/devices",
+									},
+									map[string]interface{}{
+										"name":      "sys-class",
+										"mountPath": "/sys/class/",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "node-driver-registrar",
+								"image":           "registry.k8s.io/sig-storage/csi-node-driver-registrar:v2.5.1",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=$(ADDRESS)",
+									"--kubelet-registration-path=$(DRIVER_REG_SOCK_PATH)",
+									"--v=2",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name":  "ADDRESS",
+										"value": "/csi/csi.sock",
+									},
+									map[string]interface{}{
+										"name":  "DRIVER_REG_SOCK_PATH",
+										"value": "/var/lib/kubelet/plugins/disk.csi.azure.com/csi.sock",
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+									map[string]interface{}{
+										"name":      "registration-dir",
+										"mountPath": "/registration",
+									},
+								},
+							},
+							map[string]interface{}{
+								"name":            "liveness-probe",
+								"image":           "registry.k8s.io/sig-storage/livenessprobe:v2.7.0",
+								"imagePullPolicy": "IfNotPresent",
+								"args": []interface{}{
+									"--csi-address=/csi/csi.sock",
+									"--health-port=29603",
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "40Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "plugin-dir",
+										"mountPath": "/csi",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "kubelet-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "plugin-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet/plugins/disk.csi.azure.com/",
+									"type": "DirectoryOrCreate",
+								},
+							},
+							map[string]interface{}{
+								"name": "registration-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/var/lib/kubelet/plugins_registry/",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "device-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/dev",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "sys-devices-dir",
+								"hostPath": map[string]interface{}{
+									"path": "/sys/bus/scsi/devices",
+									"type": "Directory",
+								},
+							},
+							map[string]interface{}{
+								"name": "sys-class",
+								"hostPath": map[string]interface{}{
+									"path": "/sys/class/",
+									"type": "Directory",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDaemonSetNamespaceCsiAzurediskNode(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// this package includes the constants which include the resource names.  it is a standalone
+// package to prevent import cycle errors when attempting to reference the names from other
+// packages (e.g. mutate).
+const (
+	NamespaceNamespace                                       = "parent.Spec.Namespace"
+	CSIDriverEbsCsiAwsCom                                    = "ebs.csi.aws.com"
+	DeploymentNamespaceEbsCsiController                      = "ebs-csi-controller"
+	DaemonSetNamespaceEbsCsiNode                             = "ebs-csi-node"
+	ServiceAccountNamespaceEbsCsiControllerSa                = "ebs-csi-controller-sa"
+	ClusterRoleEbsCsiController                              = "ebs-csi-controller"
+	ClusterRoleBindingEbsCsiController                       = "ebs-csi-controller"
+	ServiceAccountNamespaceEbsCsiNodeSa                      = "ebs-csi-node-sa"
+	ClusterRoleEbsCsiNode                                    = "ebs-csi-node"
+	ClusterRoleBindingEbsCsiNode                             = "ebs-csi-node"
+	RoleNamespaceEbsCsiControllerLeaderElection              = "ebs-csi-controller-leader-election"
+	RoleBindingNamespaceEbsCsiControllerLeaderElection       = "ebs-csi-controller-leader-election"
+	CSIDriverPdCsiStorageGkeIo                               = "pd.csi.storage.gke.io"
+	DeploymentNamespaceCsiGcePdController                    = "csi-gce-pd-controller"
+	DaemonSetNamespaceCsiGcePdNode                           = "csi-gce-pd-node"
+	ServiceAccountNamespaceCsiGcePdControllerSa              = "csi-gce-pd-controller-sa"
+	ClusterRoleCsiGcePdController                            = "csi-gce-pd-controller"
+	ClusterRoleBindingCsiGcePdController                     = "csi-gce-pd-controller"
+	ServiceAccountNamespaceCsiGcePdNodeSa                    = "csi-gce-pd-node-sa"
+	ClusterRoleCsiGcePdNode                                  = "csi-gce-pd-node"
+	ClusterRoleBindingCsiGcePdNode                           = "csi-gce-pd-node"
+	RoleNamespaceCsiGcePdControllerLeaderElection            = "csi-gce-pd-controller-leader-election"
+	RoleBindingNamespaceCsiGcePdControllerLeaderElection     = "csi-gce-pd-controller-leader-election"
+	CSIDriverDiskCsiAzureCom                                 = "disk.csi.azure.com"
+	DeploymentNamespaceCsiAzurediskController                = "csi-azuredisk-controller"
+	DaemonSetNamespaceCsiAzurediskNode                       = "csi-azuredisk-node"
+	ServiceAccountNamespaceCsiAzurediskControllerSa          = "csi-azuredisk-controller-sa"
+	ClusterRoleCsiAzurediskController                        = "csi-azuredisk-controller"
+	ClusterRoleBindingCsiAzurediskController                 = "csi-azuredisk-controller"
+	ServiceAccountNamespaceCsiAzurediskNodeSa                = "csi-azuredisk-node-sa"
+	ClusterRoleCsiAzurediskNode                              = "csi-azuredisk-node"
+	ClusterRoleBindingCsiAzurediskNode                       = "csi-azuredisk-node"
+	RoleNamespaceCsiAzurediskControllerLeaderElection        = "csi-azuredisk-controller-leader-election"
+	RoleBindingNamespaceCsiAzurediskControllerLeaderElection = "csi-azuredisk-controller-leader-election"
+	ConfigMapNamespaceLocalPathConfig                        = "local-path-config"
+	DeploymentNamespaceLocalPathProvisioner                  = "local-path-provisioner"
+	ServiceAccountNamespaceLocalPathProvisioner              = "local-path-provisioner"
+	ClusterRoleLocalPathProvisioner                          = "local-path-provisioner"
+	ClusterRoleBindingLocalPathProvisioner                   = "local-path-provisioner"
+	DeploymentNamespaceOpenebsLocalpvProvisioner             = "openebs-localpv-provisioner"
+	ServiceAccountNamespaceOpenebsLocalpvProvisioner         = "openebs-localpv-provisioner"
+	ClusterRoleOpenebsLocalpvProvisioner                     = "openebs-localpv-provisioner"
+	ClusterRoleBindingOpenebsLocalpvProvisioner              = "openebs-localpv-provisioner"
+	StorageClassFast                                         = "fast"
+	StorageClassStandard                                     = "standard"
+	StorageClassRetain                                       = "retain"
+	CRDVolumesnapshotclassesSnapshotStorageK8sIo             = "volumesnapshotclasses.snapshot.storage.k8s.io"
+	CRDVolumesnapshotcontentsSnapshotStorageK8sIo            = "volumesnapshotcontents.snapshot.storage.k8s.io"
+	CRDVolumesnapshotsSnapshotStorageK8sIo                   = "volumesnapshots.snapshot.storage.k8s.io"
+	DeploymentNamespaceSnapshotController                    = "snapshot-controller"
+	ServiceAccountNamespaceSnapshotController                = "snapshot-controller"
+	ClusterRoleSnapshotController                            = "snapshot-controller"
+	ClusterRoleBindingSnapshotController                     = "snapshot-controller"
+	RoleNamespaceSnapshotControllerLeaderElection            = "snapshot-controller-leader-election"
+	RoleBindingNamespaceSnapshotControllerLeaderElection     = "snapshot-controller-leader-election"
+	VolumeSnapshotClassStandard                              = "standard"
+	PodDisruptionBudgetNamespaceSnapshotController           = "snapshot-controller"
+)
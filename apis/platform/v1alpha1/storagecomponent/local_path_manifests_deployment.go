@@ -0,0 +1,141 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceLocalPathProvisioner creates the Deployment resource with name local-path-provisioner.
+func CreateDeploymentNamespaceLocalPathProvisioner(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "local-path" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="local-path",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "local-path-provisioner",
+					"app.kubernetes.io/component":  "provisioner",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "local-path-provisioner",
+				},
+				"name":      "local-path-provisioner",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "local-path-provisioner",
+						"app.kubernetes.io/component": "provisioner",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "local-path-provisioner",
+							"app.kubernetes.io/component":  "provisioner",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "local-path-provisioner",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "local-path-provisioner",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "local-path-provisioner",
+								// controlled by field: driver.localPath.image
+								// controlled by field: driver.localPath.version
+								//  Image repo and name to use for local-path-provisioner.
+								//  Version of local-path-provisioner to use.
+								"image":           "" + parent.Spec.Driver.LocalPath.Image + ":" + parent.Spec.Driver.LocalPath.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"command": []interface{}{
+									"local-path-provisioner",
+									"start",
+									"--config",
+									"/etc/config/config.json",
+								},
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "POD_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "32Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+									"readOnlyRootFilesystem":   true,
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"name":      "config-volume",
+										"mountPath": "/etc/config/",
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "config-volume",
+								"configMap": map[string]interface{}{
+									"name": "local-path-config",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceLocalPathProvisioner(resourceObj, parent, collection, reconciler, req)
+}
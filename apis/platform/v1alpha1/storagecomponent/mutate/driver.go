@@ -0,0 +1,275 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	storagev1 "k8s.io/api/storage/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+var ErrUnsupportedDriver = errors.New("unsupported storage driver")
+
+// driver is a storage driver which the component installs.
+type driver struct {
+	// provisioner is the name of the provisioner of the volumes of the driver, which is also the
+	// name of the CSI driver for the CSI drivers.
+	provisioner string
+
+	// plugin is the name of the driver container of the workloads of the driver.
+	plugin string
+
+	// csi is whether the driver is a CSI driver, which supports volume expansion and snapshots.
+	csi bool
+
+	// parameters are the parameters of the storage classes of the driver, keyed by the name of
+	// the storage class.
+	parameters map[string]map[string]string
+
+	// annotations are the annotations of the storage classes of the driver.
+	annotations map[string]string
+}
+
+// drivers are the storage drivers which the component installs, keyed by the driver type.
+var drivers = map[string]driver{
+	"aws-ebs": {
+		provisioner: "ebs.csi.aws.com",
+		plugin:      "ebs-plugin",
+		csi:         true,
+		parameters: map[string]map[string]string{
+			"fast":     {"type": "gp3", "iops": "6000", "throughput": "250", "encrypted": "true"},
+			"standard": {"type": "gp3", "encrypted": "true"},
+			"retain":   {"type": "gp3", "encrypted": "true"},
+		},
+	},
+	"gce-pd": {
+		provisioner: "pd.csi.storage.gke.io",
+		plugin:      "gce-pd-driver",
+		csi:         true,
+		parameters: map[string]map[string]string{
+			"fast":     {"type": "pd-ssd"},
+			"standard": {"type": "pd-balanced"},
+			"retain":   {"type": "pd-balanced"},
+		},
+	},
+	"azure-disk": {
+		provisioner: "disk.csi.azure.com",
+		plugin:      "azuredisk",
+		csi:         true,
+		parameters: map[string]map[string]string{
+			"fast":     {"skuName": "Premium_LRS"},
+			"standard": {"skuName": "StandardSSD_LRS"},
+			"retain":   {"skuName": "StandardSSD_LRS"},
+		},
+	},
+	"local-path": {
+		provisioner: "rancher.io/local-path",
+		plugin:      "local-path-provisioner",
+	},
+	"openebs": {
+		provisioner: "openebs.io/local",
+		plugin:      "openebs-provisioner-hostpath",
+		annotations: map[string]string{
+			"openebs.io/cas-type": "local",
+			"cas.openebs.io/config": "- name: StorageType\n  value: hostpath\n" +
+				"- name: BasePath\n  value: /var/openebs/local\n",
+		},
+	},
+}
+
+// driverFor returns the storage driver of the component.
+func driverFor(parent *platformv1alpha1.StorageComponent) (driver, error) {
+	selected, ok := drivers[parent.Spec.Driver.Type]
+	if !ok {
+		return driver{}, fmt.Errorf("%w %s", ErrUnsupportedDriver, parent.Spec.Driver.Type)
+	}
+
+	return selected, nil
+}
+
+// defaultClassAnnotation is the annotation of the default storage class of the cluster.
+const defaultClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// setStorageClass sets the provisioner and parameters of a storage class from the driver of the
+// component, and annotates the storage class as the default storage class of the cluster if it
+// is selected as such.
+func setStorageClass(original client.Object, parent *platformv1alpha1.StorageComponent) error {
+	storageClass, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	selected, err := driverFor(parent)
+	if err != nil {
+		return err
+	}
+
+	storageClass.Object["provisioner"] = selected.provisioner
+	storageClass.Object["allowVolumeExpansion"] = selected.csi
+
+	if len(selected.parameters[original.GetName()]) > 0 {
+		parameters := map[string]interface{}{}
+		for key, value := range selected.parameters[original.GetName()] {
+			parameters[key] = value
+		}
+
+		storageClass.Object["parameters"] = parameters
+	}
+
+	annotations := original.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	for key, value := range selected.annotations {
+		annotations[key] = value
+	}
+
+	if parent.Spec.StorageClasses.Default == original.GetName() {
+		annotations[defaultClassAnnotation] = "true"
+	}
+
+	if len(annotations) > 0 {
+		original.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+// replaceStorageClass deletes the existing storage class if its provisioner, parameters or
+// policies differ from those of the desired storage class, e.g. when the driver of the component
+// is changed.  These fields of a storage class are immutable, so the storage class is recreated
+// instead of updated.  Existing volumes are not affected.
+func replaceStorageClass(original client.Object, reconciler workload.Reconciler, req *workload.Request) error {
+	storageClass, ok := original.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", original.GetName())
+	}
+
+	desired := &storagev1.StorageClass{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(storageClass.Object, desired); err != nil {
+		return fmt.Errorf("unable to convert storage class %s, %w", original.GetName(), err)
+	}
+
+	existing := &storagev1.StorageClass{}
+	if err := reconciler.Get(req.Context, client.ObjectKeyFromObject(original), existing); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to get storage class %s, %w", original.GetName(), err)
+	}
+
+	if existing.Provisioner == desired.Provisioner &&
+		reflect.DeepEqual(existing.Parameters, desired.Parameters) &&
+		reflect.DeepEqual(existing.ReclaimPolicy, desired.ReclaimPolicy) &&
+		reflect.DeepEqual(existing.VolumeBindingMode, desired.VolumeBindingMode) {
+		return nil
+	}
+
+	req.Log.Info("replacing storage class", "name", existing.Name, "provisioner", desired.Provisioner)
+
+	if err := reconciler.Delete(req.Context, existing); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("unable to delete storage class %s, %w", existing.Name, err)
+	}
+
+	return nil
+}
+
+// setDriverCredentials sets the cloud credentials of the driver in the named container of a
+// workload of the driver, if a credentials secret is set.
+func setDriverCredentials(original client.Object, parent *platformv1alpha1.StorageComponent, container string) error {
+	secret := parent.Spec.Driver.CredentialsSecret
+	if secret == "" {
+		return nil
+	}
+
+	switch parent.Spec.Driver.Type {
+	case "aws-ebs":
+		if err := podtemplate.SetEnvFromSecret(original, container, "AWS_ACCESS_KEY_ID", secret, "key_id", false); err != nil {
+			return err
+		}
+
+		return podtemplate.SetEnvFromSecret(original, container, "AWS_SECRET_ACCESS_KEY", secret, "access_key", false)
+	case "gce-pd":
+		if err := podtemplate.MountSecret(original, container, "cloud-sa", secret, "/etc/cloud-sa"); err != nil {
+			return err
+		}
+
+		return podtemplate.SetEnv(original, container, "GOOGLE_APPLICATION_CREDENTIALS", "/etc/cloud-sa/cloud-sa.json")
+	case "azure-disk":
+		if err := podtemplate.SetArg(original, container, "--cloud-config-secret-name", secret); err != nil {
+			return err
+		}
+
+		return podtemplate.SetArg(original, container, "--cloud-config-secret-namespace", parent.Spec.Namespace)
+	}
+
+	return nil
+}
+
+// setServiceAccountAnnotations annotates the service account of the driver controller, e.g. to
+// assume a cloud identity.
+func setServiceAccountAnnotations(original client.Object, parent *platformv1alpha1.StorageComponent) {
+	if len(parent.Spec.Driver.ServiceAccountAnnotations) == 0 {
+		return
+	}
+
+	annotations := original.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	for key, value := range parent.Spec.Driver.ServiceAccountAnnotations {
+		annotations[key] = value
+	}
+
+	original.SetAnnotations(annotations)
+}
+
+// mutateDriverController applies the settings of the driver to the controller deployment of a CSI
+// driver.  The snapshotter sidecar watches the volume snapshot custom resources, so it is removed
+// unless snapshots are enabled.
+func mutateDriverController(original client.Object, parent *platformv1alpha1.StorageComponent) error {
+	selected, err := driverFor(parent)
+	if err != nil {
+		return err
+	}
+
+	if !parent.Spec.Snapshots.Enabled {
+		if err := podtemplate.RemoveContainer(original, "csi-snapshotter"); err != nil {
+			return err
+		}
+	}
+
+	if err := podtemplate.SetResources(original, selected.plugin, parent.Spec.Driver.Resources); err != nil {
+		return err
+	}
+
+	return setDriverCredentials(original, parent, selected.plugin)
+}
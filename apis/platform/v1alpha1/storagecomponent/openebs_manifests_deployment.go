@@ -0,0 +1,166 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+
+// CreateDeploymentNamespaceOpenebsLocalpvProvisioner creates the Deployment resource with name openebs-localpv-provisioner.
+func CreateDeploymentNamespaceOpenebsLocalpvProvisioner(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Driver.Type != "openebs" {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=driver.type,value="openebs",include
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "openebs-localpv-provisioner",
+					"app.kubernetes.io/component":  "provisioner",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "openebs-localpv-provisioner",
+				},
+				"name":      "openebs-localpv-provisioner",
+				"namespace": parent.Spec.Namespace, //  controlled by field: namespace
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"strategy": map[string]interface{}{
+					"type": "Recreate",
+				},
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "openebs-localpv-provisioner",
+						"app.kubernetes.io/component": "provisioner",
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":       "openebs-localpv-provisioner",
+							"app.kubernetes.io/component":  "provisioner",
+							"platform.nukleros.io/group":   "storage",
+							"platform.nukleros.io/project": "openebs-localpv-provisioner",
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": "openebs-localpv-provisioner",
+						"nodeSelector": map[string]interface{}{
+							"kubernetes.io/os": "linux",
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "openebs-provisioner-hostpath",
+								// controlled by field: driver.openEBS.image
+								// controlled by field: driver.openEBS.version
+								//  Image repo and name to use for the openebs local PV provisioner.
+								//  Version of the openebs local PV provisioner to use.
+								"image":           "" + parent.Spec.Driver.OpenEBS.Image + ":" + parent.Spec.Driver.OpenEBS.Version + "",
+								"imagePullPolicy": "IfNotPresent",
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "NODE_NAME",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.nodeName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "OPENEBS_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name": "OPENEBS_SERVICE_ACCOUNT",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "spec.serviceAccountName",
+											},
+										},
+									},
+									map[string]interface{}{
+										"name":  "OPENEBS_IO_ENABLE_ANALYTICS",
+										"value": "false",
+									},
+									map[string]interface{}{
+										"name":  "OPENEBS_IO_INSTALLER_TYPE",
+										"value": "openebs-operator-lite",
+									},
+									map[string]interface{}{
+										"name":  "OPENEBS_IO_HELPER_IMAGE",
+										"value": "openebs/linux-utils:3.3.0",
+									},
+									map[string]interface{}{
+										"name":  "OPENEBS_IO_BASE_PATH",
+										"value": "/var/openebs/local",
+									},
+								},
+								"livenessProbe": map[string]interface{}{
+									"exec": map[string]interface{}{
+										"command": []interface{}{
+											"sh",
+											"-c",
+											"test $(pgrep -c \"^provisioner-loc.*\") = 1",
+										},
+									},
+									"initialDelaySeconds": 30,
+									"periodSeconds":       60,
+								},
+								"resources": map[string]interface{}{
+									"requests": map[string]interface{}{
+										"cpu":    "10m",
+										"memory": "32Mi",
+									},
+									"limits": map[string]interface{}{
+										"cpu":    "100m",
+										"memory": "128Mi",
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"allowPrivilegeEscalation": false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateDeploymentNamespaceOpenebsLocalpvProvisioner(resourceObj, parent, collection, reconciler, req)
+}
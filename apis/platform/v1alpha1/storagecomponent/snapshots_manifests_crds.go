@@ -0,0 +1,283 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDVolumesnapshotclassesSnapshotStorageK8sIo creates the CustomResourceDefinition resource with name volumesnapshotclasses.snapshot.storage.k8s.io.
+func CreateCRDVolumesnapshotclassesSnapshotStorageK8sIo(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Snapshots.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=snapshots.enabled,value=true,include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io": "https://github.com/kubernetes-csi/external-snapshotter/pull/665",
+				},
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "snapshot-controller",
+				},
+				"name": "volumesnapshotclasses.snapshot.storage.k8s.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "snapshot.storage.k8s.io",
+				"names": map[string]interface{}{
+					"kind":     "VolumeSnapshotClass",
+					"listKind": "VolumeSnapshotClassList",
+					"plural":   "volumesnapshotclasses",
+					"shortNames": []interface{}{
+						"vsclass",
+					},
+					"singular": "volumesnapshotclass",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"driver": map[string]interface{}{
+										"type": "string",
+									},
+									"deletionPolicy": map[string]interface{}{
+										"type": "string",
+										"enum": []interface{}{
+											"Delete",
+											"Retain",
+										},
+									},
+									"parameters": map[string]interface{}{
+										"type": "object",
+										"additionalProperties": map[string]interface{}{
+											"type": "string",
+										},
+									},
+								},
+								"required": []interface{}{
+									"deletionPolicy",
+									"driver",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDVolumesnapshotclassesSnapshotStorageK8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDVolumesnapshotcontentsSnapshotStorageK8sIo creates the CustomResourceDefinition resource with name volumesnapshotcontents.snapshot.storage.k8s.io.
+func CreateCRDVolumesnapshotcontentsSnapshotStorageK8sIo(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Snapshots.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=snapshots.enabled,value=true,include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io": "https://github.com/kubernetes-csi/external-snapshotter/pull/665",
+				},
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "snapshot-controller",
+				},
+				"name": "volumesnapshotcontents.snapshot.storage.k8s.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "snapshot.storage.k8s.io",
+				"names": map[string]interface{}{
+					"kind":     "VolumeSnapshotContent",
+					"listKind": "VolumeSnapshotContentList",
+					"plural":   "volumesnapshotcontents",
+					"shortNames": []interface{}{
+						"vsc",
+					},
+					"singular": "volumesnapshotcontent",
+				},
+				"scope": "Cluster",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDVolumesnapshotcontentsSnapshotStorageK8sIo(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+
+// CreateCRDVolumesnapshotsSnapshotStorageK8sIo creates the CustomResourceDefinition resource with name volumesnapshots.snapshot.storage.k8s.io.
+func CreateCRDVolumesnapshotsSnapshotStorageK8sIo(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	if parent.Spec.Snapshots.Enabled != true {
+		return []client.Object{}, nil
+	}
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			// +operator-builder:resource:field=snapshots.enabled,value=true,include
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"api-approved.kubernetes.io": "https://github.com/kubernetes-csi/external-snapshotter/pull/665",
+				},
+				"labels": map[string]interface{}{
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "snapshot-controller",
+				},
+				"name": "volumesnapshots.snapshot.storage.k8s.io",
+			},
+			"spec": map[string]interface{}{
+				"group": "snapshot.storage.k8s.io",
+				"names": map[string]interface{}{
+					"kind":     "VolumeSnapshot",
+					"listKind": "VolumeSnapshotList",
+					"plural":   "volumesnapshots",
+					"shortNames": []interface{}{
+						"vs",
+					},
+					"singular": "volumesnapshot",
+				},
+				"scope": "Namespaced",
+				"versions": []interface{}{
+					map[string]interface{}{
+						"name": "v1",
+						"schema": map[string]interface{}{
+							"openAPIV3Schema": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"apiVersion": map[string]interface{}{
+										"type": "string",
+									},
+									"kind": map[string]interface{}{
+										"type": "string",
+									},
+									"metadata": map[string]interface{}{
+										"type": "object",
+									},
+									"spec": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+									"status": map[string]interface{}{
+										"type":                                 "object",
+										"x-kubernetes-preserve-unknown-fields": true,
+									},
+								},
+								"required": []interface{}{
+									"spec",
+								},
+							},
+						},
+						"served":  true,
+						"storage": true,
+						"subresources": map[string]interface{}{
+							"status": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return mutate.MutateCRDVolumesnapshotsSnapshotStorageK8sIo(resourceObj, parent, collection, reconciler, req)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecomponent
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/storagecomponent/mutate"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateStorageClassFast creates the StorageClass resource with name fast.
+func CreateStorageClassFast(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "storage.k8s.io/v1",
+			"kind":       "StorageClass",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "fast",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "storage-classes",
+				},
+				"name": "fast",
+			},
+			"provisioner":          "rancher.io/local-path",
+			"reclaimPolicy":        "Delete",
+			"volumeBindingMode":    "WaitForFirstConsumer",
+			"allowVolumeExpansion": false,
+		},
+	}
+
+	return mutate.MutateStorageClassFast(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateStorageClassStandard creates the StorageClass resource with name standard.
+func CreateStorageClassStandard(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "storage.k8s.io/v1",
+			"kind":       "StorageClass",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "standard",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "storage-classes",
+				},
+				"name": "standard",
+			},
+			"provisioner":          "rancher.io/local-path",
+			"reclaimPolicy":        "Delete",
+			"volumeBindingMode":    "WaitForFirstConsumer",
+			"allowVolumeExpansion": false,
+		},
+	}
+
+	return mutate.MutateStorageClassStandard(resourceObj, parent, collection, reconciler, req)
+}
+
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch;create;update;patch;delete
+
+// CreateStorageClassRetain creates the StorageClass resource with name retain.
+func CreateStorageClassRetain(
+	parent *platformv1alpha1.StorageComponent,
+	collection *setupv1alpha1.SupportServices,
+	reconciler workload.Reconciler,
+	req *workload.Request,
+) ([]client.Object, error) {
+	var resourceObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "storage.k8s.io/v1",
+			"kind":       "StorageClass",
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       "retain",
+					"platform.nukleros.io/group":   "storage",
+					"platform.nukleros.io/project": "storage-classes",
+				},
+				"name": "retain",
+			},
+			"provisioner":          "rancher.io/local-path",
+			"reclaimPolicy":        "Retain",
+			"volumeBindingMode":    "WaitForFirstConsumer",
+			"allowVolumeExpansion": false,
+		},
+	}
+
+	return mutate.MutateStorageClassRetain(resourceObj, parent, collection, reconciler, req)
+}
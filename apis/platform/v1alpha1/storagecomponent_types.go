@@ -0,0 +1,478 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+var ErrUnableToConvertStorageComponent = errors.New("unable to convert to StorageComponent")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// StorageComponentSpec defines the desired state of StorageComponent.
+type StorageComponentSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection StorageComponentCollectionSpec `json:"collection"`
+
+	// +kubebuilder:default="nukleros-storage-system"
+	// +kubebuilder:validation:Optional
+	// (Default: "nukleros-storage-system")
+	//
+	//	Namespace to use for storage support services.
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Driver StorageComponentSpecDriver `json:"driver,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	StorageClasses StorageComponentSpecStorageClasses `json:"storageClasses,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Snapshots StorageComponentSpecSnapshots `json:"snapshots,omitempty"`
+}
+
+type StorageComponentCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+type StorageComponentSpecDriver struct {
+	// +kubebuilder:default="local-path"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=aws-ebs;gce-pd;azure-disk;local-path;openebs
+	// (Default: "local-path")
+	//
+	//	Storage driver which provisions the volumes of the storage classes.  One of: aws-ebs |
+	//	gce-pd | azure-disk | local-path | openebs.  The local-path and openebs drivers provision
+	//	volumes from the disks of the nodes and are intended for development clusters.
+	Type string `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	AWSEBS StorageComponentSpecDriverAWSEBS `json:"awsEBS,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	GCEPD StorageComponentSpecDriverGCEPD `json:"gcePD,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	AzureDisk StorageComponentSpecDriverAzureDisk `json:"azureDisk,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	LocalPath StorageComponentSpecDriverLocalPath `json:"localPath,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	OpenEBS StorageComponentSpecDriverOpenEBS `json:"openEBS,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Name of a secret in the namespace of the component which holds the cloud credentials of
+	//	the driver.  The secret holds the key_id and access_key keys for aws-ebs, the
+	//	cloud-sa.json key for gce-pd and the cloud-config key for azure-disk.  When not set, the
+	//	driver uses the identity of the nodes or of its service account.
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Annotations of the service account of the driver controller, e.g. to assume a cloud
+	//	identity with IAM roles for service accounts or workload identity.
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the driver controller deployment of the aws-ebs, gce-pd and
+	//	azure-disk drivers.  Defaults to the number of replicas of the tier of the collection.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget for the driver controller deployment.  Settings which are set here
+	//	take precedence over the pod disruption budget settings of the collection.
+	PodDisruptionBudget setupv1alpha1.PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for the driver controller pods.  Settings which are set here take
+	//	precedence over the scheduling settings of the collection.  The driver node pods run on
+	//	every node.
+	Scheduling setupv1alpha1.SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Resource requests and limits for the driver container of the driver controller.  Requests
+	//	and limits which are not set here default to those of the tier of the collection.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type StorageComponentSpecDriverAWSEBS struct {
+	// +kubebuilder:default="public.ecr.aws/ebs-csi-driver/aws-ebs-csi-driver"
+	// +kubebuilder:validation:Optional
+	// (Default: "public.ecr.aws/ebs-csi-driver/aws-ebs-csi-driver")
+	//
+	//	Image repo and name to use for aws-ebs-csi-driver.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the aws-ebs-csi-driver image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.10.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.10.0")
+	//
+	//	Version of aws-ebs-csi-driver to use.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageComponentSpecDriverGCEPD struct {
+	// +kubebuilder:default="registry.k8s.io/cloud-provider-gcp/gcp-compute-persistent-disk-csi-driver"
+	// +kubebuilder:validation:Optional
+	// (Default: "registry.k8s.io/cloud-provider-gcp/gcp-compute-persistent-disk-csi-driver")
+	//
+	//	Image repo and name to use for gcp-compute-persistent-disk-csi-driver.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the gcp-compute-persistent-disk-csi-driver image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.7.3"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.7.3")
+	//
+	//	Version of gcp-compute-persistent-disk-csi-driver to use.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageComponentSpecDriverAzureDisk struct {
+	// +kubebuilder:default="mcr.microsoft.com/k8s/csi/azuredisk-csi"
+	// +kubebuilder:validation:Optional
+	// (Default: "mcr.microsoft.com/k8s/csi/azuredisk-csi")
+	//
+	//	Image repo and name to use for azuredisk-csi-driver.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the azuredisk-csi-driver image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v1.21.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "v1.21.0")
+	//
+	//	Version of azuredisk-csi-driver to use.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageComponentSpecDriverLocalPath struct {
+	// +kubebuilder:default="rancher/local-path-provisioner"
+	// +kubebuilder:validation:Optional
+	// (Default: "rancher/local-path-provisioner")
+	//
+	//	Image repo and name to use for local-path-provisioner.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the local-path-provisioner image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v0.0.22"
+	// +kubebuilder:validation:Optional
+	// (Default: "v0.0.22")
+	//
+	//	Version of local-path-provisioner to use.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageComponentSpecDriverOpenEBS struct {
+	// +kubebuilder:default="openebs/provisioner-localpv"
+	// +kubebuilder:validation:Optional
+	// (Default: "openebs/provisioner-localpv")
+	//
+	//	Image repo and name to use for the openebs local PV provisioner.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the the openebs local PV provisioner image (e.g. sha256:<hex>).  When set, the image is pinned to this
+	//	digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="3.3.0"
+	// +kubebuilder:validation:Optional
+	// (Default: "3.3.0")
+	//
+	//	Version of the openebs local PV provisioner to use.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageComponentSpecStorageClasses struct {
+	// +kubebuilder:default="standard"
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=fast;standard;retain;none
+	// (Default: "standard")
+	//
+	//	Storage class which is annotated as the default storage class of the cluster, which is
+	//	used by claims without a storage class such as those of the database clusters.  One of:
+	//	fast | standard | retain | none.  Use none when the cluster already has a default storage
+	//	class.
+	Default string `json:"default,omitempty"`
+}
+
+type StorageComponentSpecSnapshots struct {
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Whether to install the snapshot-controller and the volume snapshot custom resources, and to
+	//	create a volume snapshot class for the driver.  Only the aws-ebs, gce-pd and azure-disk
+	//	drivers support snapshots.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default="registry.k8s.io/sig-storage/snapshot-controller"
+	// +kubebuilder:validation:Optional
+	// (Default: "registry.k8s.io/sig-storage/snapshot-controller")
+	//
+	//	Image repo and name to use for snapshot-controller.
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	//	Digest of the snapshot-controller image (e.g. sha256:<hex>).  When set, the image is pinned
+	//	to this digest rather than relying on the tag alone.
+	Digest string `json:"digest,omitempty"`
+
+	// +kubebuilder:default="v6.0.1"
+	// +kubebuilder:validation:Optional
+	// (Default: "v6.0.1")
+	//
+	//	Version of snapshot-controller to use.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas to use for the snapshot-controller deployment.  Defaults to the number
+	//	of replicas of the tier of the collection.
+	Replicas int `json:"replicas,omitempty"`
+}
+
+type StorageComponentStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The settings which are in effect after merging the tier profile of the collection with the spec.
+	Effective *setupv1alpha1.EffectiveSettings `json:"effective,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// StorageComponent is the Schema for the storagecomponents API.
+type StorageComponent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              StorageComponentSpec   `json:"spec,omitempty"`
+	Status            StorageComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageComponentList contains a list of StorageComponent.
+type StorageComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageComponent `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *StorageComponent) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *StorageComponent) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *StorageComponent) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *StorageComponent) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *StorageComponent) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *StorageComponent) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *StorageComponent) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *StorageComponent) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// RemoveChildResourceCondition removes the child resource status of a pruned resource from a component.
+func (component *StorageComponent) RemoveChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources = append(component.Status.Resources[:i], component.Status.Resources[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*StorageComponent) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*StorageComponent) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("StorageComponent")
+}
+
+// GetEffectiveSettings returns the settings which are in effect for the component.
+func (component *StorageComponent) GetEffectiveSettings() *setupv1alpha1.EffectiveSettings {
+	return component.Status.Effective
+}
+
+// SetEffectiveSettings sets the settings which are in effect for the component.
+func (component *StorageComponent) SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings) {
+	component.Status.Effective = settings
+}
+
+// EffectiveReplicas returns the number of replicas of each workload of the component, keyed by
+// the workload name, after defaulting from the given tier profile.  The provisioners of the
+// local drivers do not elect a leader, so they are not replicated.
+func (component *StorageComponent) EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int {
+	replicas := map[string]int{
+		"snapshot-controller": profile.ReplicasFor(component.Spec.Snapshots.Replicas),
+	}
+
+	if controller := component.DriverController(); controller != "" {
+		replicas[controller] = profile.ReplicasFor(component.Spec.Driver.Replicas)
+	}
+
+	return replicas
+}
+
+// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the component
+// which are protected by a pod disruption budget once they run more than one replica, keyed by the
+// workload name.
+func (component *StorageComponent) PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{}
+
+	// the snapshot controller is protected by the pod disruption budget of the collection
+	if component.Spec.Snapshots.Enabled {
+		budgets["snapshot-controller"] = setupv1alpha1.PodDisruptionBudgetSpec{}
+	}
+
+	if controller := component.DriverController(); controller != "" {
+		budgets[controller] = component.Spec.Driver.PodDisruptionBudget
+	}
+
+	return budgets
+}
+
+// DriverController returns the name of the controller deployment of the CSI driver of the
+// component, or an empty string for the local drivers, which are not CSI drivers.
+func (component *StorageComponent) DriverController() string {
+	switch component.Spec.Driver.Type {
+	case "aws-ebs":
+		return "ebs-csi-controller"
+	case "gce-pd":
+		return "csi-gce-pd-controller"
+	case "azure-disk":
+		return "csi-azuredisk-controller"
+	}
+
+	return ""
+}
+
+func init() {
+	SchemeBuilder.Register(&StorageComponent{}, &StorageComponentList{})
+}
@@ -23,30 +23,2083 @@ package v1alpha1
 
 import (
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponent) DeepCopyInto(out *BackupComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponent.
+func (in *BackupComponent) DeepCopy() *BackupComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentCollectionSpec) DeepCopyInto(out *BackupComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentCollectionSpec.
+func (in *BackupComponentCollectionSpec) DeepCopy() *BackupComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentList) DeepCopyInto(out *BackupComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentList.
+func (in *BackupComponentList) DeepCopy() *BackupComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSchedule) DeepCopyInto(out *BackupComponentSchedule) {
+	*out = *in
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotVolumes != nil {
+		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSchedule.
+func (in *BackupComponentSchedule) DeepCopy() *BackupComponentSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpec) DeepCopyInto(out *BackupComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.Velero.DeepCopyInto(&out.Velero)
+	out.Storage = in.Storage
+	out.VolumeSnapshots = in.VolumeSnapshots
+	out.SupportServices = in.SupportServices
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]BackupComponentSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpec.
+func (in *BackupComponentSpec) DeepCopy() *BackupComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpecStorage) DeepCopyInto(out *BackupComponentSpecStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpecStorage.
+func (in *BackupComponentSpecStorage) DeepCopy() *BackupComponentSpecStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpecStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpecSupportServices) DeepCopyInto(out *BackupComponentSpecSupportServices) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpecSupportServices.
+func (in *BackupComponentSpecSupportServices) DeepCopy() *BackupComponentSpecSupportServices {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpecSupportServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpecVelero) DeepCopyInto(out *BackupComponentSpecVelero) {
+	*out = *in
+	out.Plugin = in.Plugin
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpecVelero.
+func (in *BackupComponentSpecVelero) DeepCopy() *BackupComponentSpecVelero {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpecVelero)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpecVeleroPlugin) DeepCopyInto(out *BackupComponentSpecVeleroPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpecVeleroPlugin.
+func (in *BackupComponentSpecVeleroPlugin) DeepCopy() *BackupComponentSpecVeleroPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpecVeleroPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentSpecVolumeSnapshots) DeepCopyInto(out *BackupComponentSpecVolumeSnapshots) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentSpecVolumeSnapshots.
+func (in *BackupComponentSpecVolumeSnapshots) DeepCopy() *BackupComponentSpecVolumeSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentSpecVolumeSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupComponentStatus) DeepCopyInto(out *BackupComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupComponentStatus.
+func (in *BackupComponentStatus) DeepCopy() *BackupComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatesComponent) DeepCopyInto(out *CertificatesComponent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponent.
+func (in *CertificatesComponent) DeepCopy() *CertificatesComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificatesComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentCollectionSpec) DeepCopyInto(out *CertificatesComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentCollectionSpec.
+func (in *CertificatesComponentCollectionSpec) DeepCopy() *CertificatesComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentList) DeepCopyInto(out *CertificatesComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificatesComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentList.
+func (in *CertificatesComponentList) DeepCopy() *CertificatesComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificatesComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentSpec) DeepCopyInto(out *CertificatesComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.CertManager.DeepCopyInto(&out.CertManager)
+	out.Monitoring = in.Monitoring
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpec.
+func (in *CertificatesComponentSpec) DeepCopy() *CertificatesComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentSpecCertManager) DeepCopyInto(out *CertificatesComponentSpecCertManager) {
+	*out = *in
+	in.Cainjector.DeepCopyInto(&out.Cainjector)
+	in.Controller.DeepCopyInto(&out.Controller)
+	in.Webhook.DeepCopyInto(&out.Webhook)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManager.
+func (in *CertificatesComponentSpecCertManager) DeepCopy() *CertificatesComponentSpecCertManager {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentSpecCertManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentSpecCertManagerCainjector) DeepCopyInto(out *CertificatesComponentSpecCertManagerCainjector) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerCainjector.
+func (in *CertificatesComponentSpecCertManagerCainjector) DeepCopy() *CertificatesComponentSpecCertManagerCainjector {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentSpecCertManagerCainjector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentSpecCertManagerController) DeepCopyInto(out *CertificatesComponentSpecCertManagerController) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerController.
+func (in *CertificatesComponentSpecCertManagerController) DeepCopy() *CertificatesComponentSpecCertManagerController {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentSpecCertManagerController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentSpecCertManagerWebhook) DeepCopyInto(out *CertificatesComponentSpecCertManagerWebhook) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerWebhook.
+func (in *CertificatesComponentSpecCertManagerWebhook) DeepCopy() *CertificatesComponentSpecCertManagerWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentSpecCertManagerWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesComponentStatus) DeepCopyInto(out *CertificatesComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentStatus.
+func (in *CertificatesComponentStatus) DeepCopy() *CertificatesComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponent) DeepCopyInto(out *IngressComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponent.
+func (in *IngressComponent) DeepCopy() *IngressComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentCollectionSpec) DeepCopyInto(out *IngressComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentCollectionSpec.
+func (in *IngressComponentCollectionSpec) DeepCopy() *IngressComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentList) DeepCopyInto(out *IngressComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentList.
+func (in *IngressComponentList) DeepCopy() *IngressComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpec) DeepCopyInto(out *IngressComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.Nginx.DeepCopyInto(&out.Nginx)
+	in.ExternalDNS.DeepCopyInto(&out.ExternalDNS)
+	out.Certificate = in.Certificate
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]IngressComponentSpecDomain, len(*in))
+		copy(*out, *in)
+	}
+	in.Kong.DeepCopyInto(&out.Kong)
+	out.GatewayAPI = in.GatewayAPI
+	out.ServiceMesh = in.ServiceMesh
+	out.Monitoring = in.Monitoring
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpec.
+func (in *IngressComponentSpec) DeepCopy() *IngressComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecAutoscaling) DeepCopyInto(out *IngressComponentSpecAutoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecAutoscaling.
+func (in *IngressComponentSpecAutoscaling) DeepCopy() *IngressComponentSpecAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecCertificate) DeepCopyInto(out *IngressComponentSpecCertificate) {
+	*out = *in
+	out.Route53 = in.Route53
+	out.Google = in.Google
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecCertificate.
+func (in *IngressComponentSpecCertificate) DeepCopy() *IngressComponentSpecCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecCertificateGoogle) DeepCopyInto(out *IngressComponentSpecCertificateGoogle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecCertificateGoogle.
+func (in *IngressComponentSpecCertificateGoogle) DeepCopy() *IngressComponentSpecCertificateGoogle {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecCertificateGoogle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecCertificateRoute53) DeepCopyInto(out *IngressComponentSpecCertificateRoute53) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecCertificateRoute53.
+func (in *IngressComponentSpecCertificateRoute53) DeepCopy() *IngressComponentSpecCertificateRoute53 {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecCertificateRoute53)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecDomain) DeepCopyInto(out *IngressComponentSpecDomain) {
+	*out = *in
+	out.Issuer = in.Issuer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecDomain.
+func (in *IngressComponentSpecDomain) DeepCopy() *IngressComponentSpecDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecDomainIssuer) DeepCopyInto(out *IngressComponentSpecDomainIssuer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecDomainIssuer.
+func (in *IngressComponentSpecDomainIssuer) DeepCopy() *IngressComponentSpecDomainIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecDomainIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecExternalDNS) DeepCopyInto(out *IngressComponentSpecExternalDNS) {
+	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecExternalDNS.
+func (in *IngressComponentSpecExternalDNS) DeepCopy() *IngressComponentSpecExternalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecExternalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecGatewayAPI) DeepCopyInto(out *IngressComponentSpecGatewayAPI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecGatewayAPI.
+func (in *IngressComponentSpecGatewayAPI) DeepCopy() *IngressComponentSpecGatewayAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecGatewayAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKong) DeepCopyInto(out *IngressComponentSpecKong) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	out.Autoscaling = in.Autoscaling
+	in.Listeners.DeepCopyInto(&out.Listeners)
+	out.License = in.License
+	in.Plugins.DeepCopyInto(&out.Plugins)
+	in.Gateway.DeepCopyInto(&out.Gateway)
+	in.IngressController.DeepCopyInto(&out.IngressController)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKong.
+func (in *IngressComponentSpecKong) DeepCopy() *IngressComponentSpecKong {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKong)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongAdminListener) DeepCopyInto(out *IngressComponentSpecKongAdminListener) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongAdminListener.
+func (in *IngressComponentSpecKongAdminListener) DeepCopy() *IngressComponentSpecKongAdminListener {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongAdminListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongCORS) DeepCopyInto(out *IngressComponentSpecKongCORS) {
+	*out = *in
+	if in.Origins != nil {
+		in, out := &in.Origins, &out.Origins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposedHeaders != nil {
+		in, out := &in.ExposedHeaders, &out.ExposedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongCORS.
+func (in *IngressComponentSpecKongCORS) DeepCopy() *IngressComponentSpecKongCORS {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongCORS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongGateway) DeepCopyInto(out *IngressComponentSpecKongGateway) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongGateway.
+func (in *IngressComponentSpecKongGateway) DeepCopy() *IngressComponentSpecKongGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongIngressController) DeepCopyInto(out *IngressComponentSpecKongIngressController) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongIngressController.
+func (in *IngressComponentSpecKongIngressController) DeepCopy() *IngressComponentSpecKongIngressController {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongIngressController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongLicense) DeepCopyInto(out *IngressComponentSpecKongLicense) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongLicense.
+func (in *IngressComponentSpecKongLicense) DeepCopy() *IngressComponentSpecKongLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongListeners) DeepCopyInto(out *IngressComponentSpecKongListeners) {
+	*out = *in
+	in.Proxy.DeepCopyInto(&out.Proxy)
+	out.Admin = in.Admin
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongListeners.
+func (in *IngressComponentSpecKongListeners) DeepCopy() *IngressComponentSpecKongListeners {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongListeners)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongPlugins) DeepCopyInto(out *IngressComponentSpecKongPlugins) {
+	*out = *in
+	out.RateLimiting = in.RateLimiting
+	in.CORS.DeepCopyInto(&out.CORS)
+	out.Prometheus = in.Prometheus
+	out.RequestID = in.RequestID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongPlugins.
+func (in *IngressComponentSpecKongPlugins) DeepCopy() *IngressComponentSpecKongPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongPrometheus) DeepCopyInto(out *IngressComponentSpecKongPrometheus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongPrometheus.
+func (in *IngressComponentSpecKongPrometheus) DeepCopy() *IngressComponentSpecKongPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongProxyListener) DeepCopyInto(out *IngressComponentSpecKongProxyListener) {
+	*out = *in
+	if in.HTTP2 != nil {
+		in, out := &in.HTTP2, &out.HTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongProxyListener.
+func (in *IngressComponentSpecKongProxyListener) DeepCopy() *IngressComponentSpecKongProxyListener {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongProxyListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongRateLimiting) DeepCopyInto(out *IngressComponentSpecKongRateLimiting) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongRateLimiting.
+func (in *IngressComponentSpecKongRateLimiting) DeepCopy() *IngressComponentSpecKongRateLimiting {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongRateLimiting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecKongRequestID) DeepCopyInto(out *IngressComponentSpecKongRequestID) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongRequestID.
+func (in *IngressComponentSpecKongRequestID) DeepCopy() *IngressComponentSpecKongRequestID {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecKongRequestID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecNginx) DeepCopyInto(out *IngressComponentSpecNginx) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	out.Autoscaling = in.Autoscaling
+	in.Config.DeepCopyInto(&out.Config)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]IngressComponentSpecNginxInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginx.
+func (in *IngressComponentSpecNginx) DeepCopy() *IngressComponentSpecNginx {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecNginx)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecNginxConfig) DeepCopyInto(out *IngressComponentSpecNginxConfig) {
+	*out = *in
+	out.HSTS = in.HSTS
+	in.RealIP.DeepCopyInto(&out.RealIP)
+	if in.SSLProtocols != nil {
+		in, out := &in.SSLProtocols, &out.SSLProtocols
+		*out = make([]IngressComponentSpecNginxConfigSSLProtocol, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginxConfig.
+func (in *IngressComponentSpecNginxConfig) DeepCopy() *IngressComponentSpecNginxConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecNginxConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecNginxConfigHSTS) DeepCopyInto(out *IngressComponentSpecNginxConfigHSTS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginxConfigHSTS.
+func (in *IngressComponentSpecNginxConfigHSTS) DeepCopy() *IngressComponentSpecNginxConfigHSTS {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecNginxConfigHSTS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecNginxConfigRealIP) DeepCopyInto(out *IngressComponentSpecNginxConfigRealIP) {
+	*out = *in
+	if in.TrustedAddresses != nil {
+		in, out := &in.TrustedAddresses, &out.TrustedAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginxConfigRealIP.
+func (in *IngressComponentSpecNginxConfigRealIP) DeepCopy() *IngressComponentSpecNginxConfigRealIP {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecNginxConfigRealIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecNginxInstance) DeepCopyInto(out *IngressComponentSpecNginxInstance) {
+	*out = *in
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginxInstance.
+func (in *IngressComponentSpecNginxInstance) DeepCopy() *IngressComponentSpecNginxInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecNginxInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentSpecServiceMesh) DeepCopyInto(out *IngressComponentSpecServiceMesh) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecServiceMesh.
+func (in *IngressComponentSpecServiceMesh) DeepCopy() *IngressComponentSpecServiceMesh {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentSpecServiceMesh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponentStatus) DeepCopyInto(out *IngressComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentStatus.
+func (in *IngressComponentStatus) DeepCopy() *IngressComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponent) DeepCopyInto(out *LoggingComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponent.
+func (in *LoggingComponent) DeepCopy() *LoggingComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoggingComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentCollectionSpec) DeepCopyInto(out *LoggingComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentCollectionSpec.
+func (in *LoggingComponentCollectionSpec) DeepCopy() *LoggingComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentList) DeepCopyInto(out *LoggingComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoggingComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentList.
+func (in *LoggingComponentList) DeepCopy() *LoggingComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoggingComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpec) DeepCopyInto(out *LoggingComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.Collector.DeepCopyInto(&out.Collector)
+	in.Aggregator.DeepCopyInto(&out.Aggregator)
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]LoggingComponentSpecOutput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpec.
+func (in *LoggingComponentSpec) DeepCopy() *LoggingComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpecAggregator) DeepCopyInto(out *LoggingComponentSpecAggregator) {
+	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpecAggregator.
+func (in *LoggingComponentSpecAggregator) DeepCopy() *LoggingComponentSpecAggregator {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpecAggregator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpecCollector) DeepCopyInto(out *LoggingComponentSpecCollector) {
+	*out = *in
+	out.FluentBit = in.FluentBit
+	out.Vector = in.Vector
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpecCollector.
+func (in *LoggingComponentSpecCollector) DeepCopy() *LoggingComponentSpecCollector {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpecCollector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpecCollectorFluentBit) DeepCopyInto(out *LoggingComponentSpecCollectorFluentBit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpecCollectorFluentBit.
+func (in *LoggingComponentSpecCollectorFluentBit) DeepCopy() *LoggingComponentSpecCollectorFluentBit {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpecCollectorFluentBit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpecCollectorVector) DeepCopyInto(out *LoggingComponentSpecCollectorVector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpecCollectorVector.
+func (in *LoggingComponentSpecCollectorVector) DeepCopy() *LoggingComponentSpecCollectorVector {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpecCollectorVector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentSpecOutput) DeepCopyInto(out *LoggingComponentSpecOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentSpecOutput.
+func (in *LoggingComponentSpecOutput) DeepCopy() *LoggingComponentSpecOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentSpecOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingComponentStatus) DeepCopyInto(out *LoggingComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingComponentStatus.
+func (in *LoggingComponentStatus) DeepCopy() *LoggingComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponent) DeepCopyInto(out *MonitoringComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponent.
+func (in *MonitoringComponent) DeepCopy() *MonitoringComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MonitoringComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentCollectionSpec) DeepCopyInto(out *MonitoringComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentCollectionSpec.
+func (in *MonitoringComponentCollectionSpec) DeepCopy() *MonitoringComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentList) DeepCopyInto(out *MonitoringComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MonitoringComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentList.
+func (in *MonitoringComponentList) DeepCopy() *MonitoringComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MonitoringComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpec) DeepCopyInto(out *MonitoringComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.PrometheusOperator.DeepCopyInto(&out.PrometheusOperator)
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	in.Alertmanager.DeepCopyInto(&out.Alertmanager)
+	in.KubeStateMetrics.DeepCopyInto(&out.KubeStateMetrics)
+	in.NodeExporter.DeepCopyInto(&out.NodeExporter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpec.
+func (in *MonitoringComponentSpec) DeepCopy() *MonitoringComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecAlertmanager) DeepCopyInto(out *MonitoringComponentSpecAlertmanager) {
+	*out = *in
+	out.Storage = in.Storage
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecAlertmanager.
+func (in *MonitoringComponentSpecAlertmanager) DeepCopy() *MonitoringComponentSpecAlertmanager {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecAlertmanager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecAlertmanagerStorage) DeepCopyInto(out *MonitoringComponentSpecAlertmanagerStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecAlertmanagerStorage.
+func (in *MonitoringComponentSpecAlertmanagerStorage) DeepCopy() *MonitoringComponentSpecAlertmanagerStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecAlertmanagerStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecKubeStateMetrics) DeepCopyInto(out *MonitoringComponentSpecKubeStateMetrics) {
+	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecKubeStateMetrics.
+func (in *MonitoringComponentSpecKubeStateMetrics) DeepCopy() *MonitoringComponentSpecKubeStateMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecKubeStateMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecNodeExporter) DeepCopyInto(out *MonitoringComponentSpecNodeExporter) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecNodeExporter.
+func (in *MonitoringComponentSpecNodeExporter) DeepCopy() *MonitoringComponentSpecNodeExporter {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecNodeExporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecPrometheus) DeepCopyInto(out *MonitoringComponentSpecPrometheus) {
+	*out = *in
+	out.Storage = in.Storage
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecPrometheus.
+func (in *MonitoringComponentSpecPrometheus) DeepCopy() *MonitoringComponentSpecPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecPrometheusOperator) DeepCopyInto(out *MonitoringComponentSpecPrometheusOperator) {
+	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecPrometheusOperator.
+func (in *MonitoringComponentSpecPrometheusOperator) DeepCopy() *MonitoringComponentSpecPrometheusOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecPrometheusOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentSpecPrometheusStorage) DeepCopyInto(out *MonitoringComponentSpecPrometheusStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentSpecPrometheusStorage.
+func (in *MonitoringComponentSpecPrometheusStorage) DeepCopy() *MonitoringComponentSpecPrometheusStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentSpecPrometheusStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringComponentStatus) DeepCopyInto(out *MonitoringComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringComponentStatus.
+func (in *MonitoringComponentStatus) DeepCopy() *MonitoringComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponent) DeepCopyInto(out *ObjectStorageComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponent.
+func (in *ObjectStorageComponent) DeepCopy() *ObjectStorageComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectStorageComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentBucket) DeepCopyInto(out *ObjectStorageComponentBucket) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentBucket.
+func (in *ObjectStorageComponentBucket) DeepCopy() *ObjectStorageComponentBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentCollectionSpec) DeepCopyInto(out *ObjectStorageComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentCollectionSpec.
+func (in *ObjectStorageComponentCollectionSpec) DeepCopy() *ObjectStorageComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentList) DeepCopyInto(out *ObjectStorageComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectStorageComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentList.
+func (in *ObjectStorageComponentList) DeepCopy() *ObjectStorageComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectStorageComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentSpec) DeepCopyInto(out *ObjectStorageComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.MinIO.DeepCopyInto(&out.MinIO)
+	in.MinIOOperator.DeepCopyInto(&out.MinIOOperator)
+	out.MinIOClient = in.MinIOClient
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]ObjectStorageComponentBucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentSpec.
+func (in *ObjectStorageComponentSpec) DeepCopy() *ObjectStorageComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentSpecMinIO) DeepCopyInto(out *ObjectStorageComponentSpecMinIO) {
+	*out = *in
+	out.Storage = in.Storage
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentSpecMinIO.
+func (in *ObjectStorageComponentSpecMinIO) DeepCopy() *ObjectStorageComponentSpecMinIO {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentSpecMinIO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentSpecMinIOClient) DeepCopyInto(out *ObjectStorageComponentSpecMinIOClient) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentSpecMinIOClient.
+func (in *ObjectStorageComponentSpecMinIOClient) DeepCopy() *ObjectStorageComponentSpecMinIOClient {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentSpecMinIOClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentSpecMinIOOperator) DeepCopyInto(out *ObjectStorageComponentSpecMinIOOperator) {
+	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentSpecMinIOOperator.
+func (in *ObjectStorageComponentSpecMinIOOperator) DeepCopy() *ObjectStorageComponentSpecMinIOOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentSpecMinIOOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentSpecMinIOStorage) DeepCopyInto(out *ObjectStorageComponentSpecMinIOStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentSpecMinIOStorage.
+func (in *ObjectStorageComponentSpecMinIOStorage) DeepCopy() *ObjectStorageComponentSpecMinIOStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentSpecMinIOStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageComponentStatus) DeepCopyInto(out *ObjectStorageComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageComponentStatus.
+func (in *ObjectStorageComponentStatus) DeepCopy() *ObjectStorageComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponent) DeepCopyInto(out *PolicyComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponent.
+func (in *PolicyComponent) DeepCopy() *PolicyComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyComponent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentCollectionSpec) DeepCopyInto(out *PolicyComponentCollectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentCollectionSpec.
+func (in *PolicyComponentCollectionSpec) DeepCopy() *PolicyComponentCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentList) DeepCopyInto(out *PolicyComponentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentList.
+func (in *PolicyComponentList) DeepCopy() *PolicyComponentList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyComponentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentReport) DeepCopyInto(out *PolicyComponentReport) {
+	*out = *in
+	if in.Violations != nil {
+		in, out := &in.Violations, &out.Violations
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentReport.
+func (in *PolicyComponentReport) DeepCopy() *PolicyComponentReport {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpec) DeepCopyInto(out *PolicyComponentSpec) {
+	*out = *in
+	out.Collection = in.Collection
+	in.Engine.DeepCopyInto(&out.Engine)
+	in.Policies.DeepCopyInto(&out.Policies)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpec.
+func (in *PolicyComponentSpec) DeepCopy() *PolicyComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecEngine) DeepCopyInto(out *PolicyComponentSpecEngine) {
+	*out = *in
+	out.Kyverno = in.Kyverno
+	out.Gatekeeper = in.Gatekeeper
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecEngine.
+func (in *PolicyComponentSpecEngine) DeepCopy() *PolicyComponentSpecEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecEngineGatekeeper) DeepCopyInto(out *PolicyComponentSpecEngineGatekeeper) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecEngineGatekeeper.
+func (in *PolicyComponentSpecEngineGatekeeper) DeepCopy() *PolicyComponentSpecEngineGatekeeper {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecEngineGatekeeper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecEngineKyverno) DeepCopyInto(out *PolicyComponentSpecEngineKyverno) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecEngineKyverno.
+func (in *PolicyComponentSpecEngineKyverno) DeepCopy() *PolicyComponentSpecEngineKyverno {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecEngineKyverno)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecHostPathPolicy) DeepCopyInto(out *PolicyComponentSpecHostPathPolicy) {
+	*out = *in
+	out.PolicyComponentSpecPolicy = in.PolicyComponentSpecPolicy
+	if in.AllowedPaths != nil {
+		in, out := &in.AllowedPaths, &out.AllowedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecHostPathPolicy.
+func (in *PolicyComponentSpecHostPathPolicy) DeepCopy() *PolicyComponentSpecHostPathPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecHostPathPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecPolicies) DeepCopyInto(out *PolicyComponentSpecPolicies) {
+	*out = *in
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.RequireResourceLimits = in.RequireResourceLimits
+	out.DisallowLatestTag = in.DisallowLatestTag
+	in.RestrictHostPaths.DeepCopyInto(&out.RestrictHostPaths)
+	out.RequirePlatformLabels = in.RequirePlatformLabels
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecPolicies.
+func (in *PolicyComponentSpecPolicies) DeepCopy() *PolicyComponentSpecPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentSpecPolicy) DeepCopyInto(out *PolicyComponentSpecPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentSpecPolicy.
+func (in *PolicyComponentSpecPolicy) DeepCopy() *PolicyComponentSpecPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentSpecPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComponentStatus) DeepCopyInto(out *PolicyComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyReport != nil {
+		in, out := &in.PolicyReport, &out.PolicyReport
+		*out = new(PolicyComponentReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComponentStatus.
+func (in *PolicyComponentStatus) DeepCopy() *PolicyComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsComponent) DeepCopyInto(out *SecretsComponent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponent.
-func (in *CertificatesComponent) DeepCopy() *CertificatesComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponent.
+func (in *SecretsComponent) DeepCopy() *SecretsComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponent)
+	out := new(SecretsComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CertificatesComponent) DeepCopyObject() runtime.Object {
+func (in *SecretsComponent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -54,46 +2107,46 @@ func (in *CertificatesComponent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentCollectionSpec) DeepCopyInto(out *CertificatesComponentCollectionSpec) {
+func (in *SecretsComponentCollectionSpec) DeepCopyInto(out *SecretsComponentCollectionSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentCollectionSpec.
-func (in *CertificatesComponentCollectionSpec) DeepCopy() *CertificatesComponentCollectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentCollectionSpec.
+func (in *SecretsComponentCollectionSpec) DeepCopy() *SecretsComponentCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentCollectionSpec)
+	out := new(SecretsComponentCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentList) DeepCopyInto(out *CertificatesComponentList) {
+func (in *SecretsComponentList) DeepCopyInto(out *SecretsComponentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CertificatesComponent, len(*in))
+		*out = make([]SecretsComponent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentList.
-func (in *CertificatesComponentList) DeepCopy() *CertificatesComponentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentList.
+func (in *SecretsComponentList) DeepCopy() *SecretsComponentList {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentList)
+	out := new(SecretsComponentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CertificatesComponentList) DeepCopyObject() runtime.Object {
+func (in *SecretsComponentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -101,87 +2154,133 @@ func (in *CertificatesComponentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentSpec) DeepCopyInto(out *CertificatesComponentSpec) {
+func (in *SecretsComponentSpec) DeepCopyInto(out *SecretsComponentSpec) {
 	*out = *in
 	out.Collection = in.Collection
-	out.CertManager = in.CertManager
+	in.ExternalSecrets.DeepCopyInto(&out.ExternalSecrets)
+	in.Reloader.DeepCopyInto(&out.Reloader)
+	out.Monitoring = in.Monitoring
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpec.
-func (in *CertificatesComponentSpec) DeepCopy() *CertificatesComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpec.
+func (in *SecretsComponentSpec) DeepCopy() *SecretsComponentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentSpec)
+	out := new(SecretsComponentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentSpecCertManager) DeepCopyInto(out *CertificatesComponentSpecCertManager) {
+func (in *SecretsComponentSpecExternalSecrets) DeepCopyInto(out *SecretsComponentSpecExternalSecrets) {
 	*out = *in
-	out.Cainjector = in.Cainjector
-	out.Controller = in.Controller
-	out.Webhook = in.Webhook
+	in.CertController.DeepCopyInto(&out.CertController)
+	in.Controller.DeepCopyInto(&out.Controller)
+	in.Webhook.DeepCopyInto(&out.Webhook)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManager.
-func (in *CertificatesComponentSpecCertManager) DeepCopy() *CertificatesComponentSpecCertManager {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecrets.
+func (in *SecretsComponentSpecExternalSecrets) DeepCopy() *SecretsComponentSpecExternalSecrets {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentSpecCertManager)
+	out := new(SecretsComponentSpecExternalSecrets)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentSpecCertManagerCainjector) DeepCopyInto(out *CertificatesComponentSpecCertManagerCainjector) {
+func (in *SecretsComponentSpecExternalSecretsCertController) DeepCopyInto(out *SecretsComponentSpecExternalSecretsCertController) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerCainjector.
-func (in *CertificatesComponentSpecCertManagerCainjector) DeepCopy() *CertificatesComponentSpecCertManagerCainjector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsCertController.
+func (in *SecretsComponentSpecExternalSecretsCertController) DeepCopy() *SecretsComponentSpecExternalSecretsCertController {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentSpecCertManagerCainjector)
+	out := new(SecretsComponentSpecExternalSecretsCertController)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentSpecCertManagerController) DeepCopyInto(out *CertificatesComponentSpecCertManagerController) {
+func (in *SecretsComponentSpecExternalSecretsController) DeepCopyInto(out *SecretsComponentSpecExternalSecretsController) {
 	*out = *in
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerController.
-func (in *CertificatesComponentSpecCertManagerController) DeepCopy() *CertificatesComponentSpecCertManagerController {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsController.
+func (in *SecretsComponentSpecExternalSecretsController) DeepCopy() *SecretsComponentSpecExternalSecretsController {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentSpecCertManagerController)
+	out := new(SecretsComponentSpecExternalSecretsController)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentSpecCertManagerWebhook) DeepCopyInto(out *CertificatesComponentSpecCertManagerWebhook) {
+func (in *SecretsComponentSpecExternalSecretsWebhook) DeepCopyInto(out *SecretsComponentSpecExternalSecretsWebhook) {
 	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentSpecCertManagerWebhook.
-func (in *CertificatesComponentSpecCertManagerWebhook) DeepCopy() *CertificatesComponentSpecCertManagerWebhook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsWebhook.
+func (in *SecretsComponentSpecExternalSecretsWebhook) DeepCopy() *SecretsComponentSpecExternalSecretsWebhook {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentSpecCertManagerWebhook)
+	out := new(SecretsComponentSpecExternalSecretsWebhook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificatesComponentStatus) DeepCopyInto(out *CertificatesComponentStatus) {
+func (in *SecretsComponentSpecReloader) DeepCopyInto(out *SecretsComponentSpecReloader) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NamespacesToIgnore != nil {
+		in, out := &in.NamespacesToIgnore, &out.NamespacesToIgnore
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecReloader.
+func (in *SecretsComponentSpecReloader) DeepCopy() *SecretsComponentSpecReloader {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsComponentSpecReloader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsComponentStatus) DeepCopyInto(out *SecretsComponentStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -205,39 +2304,44 @@ func (in *CertificatesComponentStatus) DeepCopyInto(out *CertificatesComponentSt
 			}
 		}
 	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesComponentStatus.
-func (in *CertificatesComponentStatus) DeepCopy() *CertificatesComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentStatus.
+func (in *SecretsComponentStatus) DeepCopy() *SecretsComponentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificatesComponentStatus)
+	out := new(SecretsComponentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponent) DeepCopyInto(out *IngressComponent) {
+func (in *ServiceMeshComponent) DeepCopyInto(out *ServiceMeshComponent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponent.
-func (in *IngressComponent) DeepCopy() *IngressComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponent.
+func (in *ServiceMeshComponent) DeepCopy() *ServiceMeshComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponent)
+	out := new(ServiceMeshComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressComponent) DeepCopyObject() runtime.Object {
+func (in *ServiceMeshComponent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -245,46 +2349,46 @@ func (in *IngressComponent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentCollectionSpec) DeepCopyInto(out *IngressComponentCollectionSpec) {
+func (in *ServiceMeshComponentCollectionSpec) DeepCopyInto(out *ServiceMeshComponentCollectionSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentCollectionSpec.
-func (in *IngressComponentCollectionSpec) DeepCopy() *IngressComponentCollectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentCollectionSpec.
+func (in *ServiceMeshComponentCollectionSpec) DeepCopy() *ServiceMeshComponentCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentCollectionSpec)
+	out := new(ServiceMeshComponentCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentList) DeepCopyInto(out *IngressComponentList) {
+func (in *ServiceMeshComponentList) DeepCopyInto(out *ServiceMeshComponentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressComponent, len(*in))
+		*out = make([]ServiceMeshComponent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentList.
-func (in *IngressComponentList) DeepCopy() *IngressComponentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentList.
+func (in *ServiceMeshComponentList) DeepCopy() *ServiceMeshComponentList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentList)
+	out := new(ServiceMeshComponentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressComponentList) DeepCopyObject() runtime.Object {
+func (in *ServiceMeshComponentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -292,103 +2396,142 @@ func (in *IngressComponentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpec) DeepCopyInto(out *IngressComponentSpec) {
+func (in *ServiceMeshComponentSpec) DeepCopyInto(out *ServiceMeshComponentSpec) {
 	*out = *in
 	out.Collection = in.Collection
-	out.Nginx = in.Nginx
-	out.ExternalDNS = in.ExternalDNS
-	out.Kong = in.Kong
+	in.Mesh.DeepCopyInto(&out.Mesh)
+	out.Certificates = in.Certificates
+	in.Injection.DeepCopyInto(&out.Injection)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpec.
-func (in *IngressComponentSpec) DeepCopy() *IngressComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpec.
+func (in *ServiceMeshComponentSpec) DeepCopy() *ServiceMeshComponentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpec)
+	out := new(ServiceMeshComponentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpecExternalDNS) DeepCopyInto(out *IngressComponentSpecExternalDNS) {
+func (in *ServiceMeshComponentSpecCertificates) DeepCopyInto(out *ServiceMeshComponentSpecCertificates) {
 	*out = *in
+	out.TrustAnchor = in.TrustAnchor
+	out.Issuer = in.Issuer
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecExternalDNS.
-func (in *IngressComponentSpecExternalDNS) DeepCopy() *IngressComponentSpecExternalDNS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecCertificates.
+func (in *ServiceMeshComponentSpecCertificates) DeepCopy() *ServiceMeshComponentSpecCertificates {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpecExternalDNS)
+	out := new(ServiceMeshComponentSpecCertificates)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpecKong) DeepCopyInto(out *IngressComponentSpecKong) {
+func (in *ServiceMeshComponentSpecCertificatesIssuer) DeepCopyInto(out *ServiceMeshComponentSpecCertificatesIssuer) {
 	*out = *in
-	out.Gateway = in.Gateway
-	out.IngressController = in.IngressController
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKong.
-func (in *IngressComponentSpecKong) DeepCopy() *IngressComponentSpecKong {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecCertificatesIssuer.
+func (in *ServiceMeshComponentSpecCertificatesIssuer) DeepCopy() *ServiceMeshComponentSpecCertificatesIssuer {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpecKong)
+	out := new(ServiceMeshComponentSpecCertificatesIssuer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpecKongGateway) DeepCopyInto(out *IngressComponentSpecKongGateway) {
+func (in *ServiceMeshComponentSpecCertificatesTrustAnchor) DeepCopyInto(out *ServiceMeshComponentSpecCertificatesTrustAnchor) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongGateway.
-func (in *IngressComponentSpecKongGateway) DeepCopy() *IngressComponentSpecKongGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecCertificatesTrustAnchor.
+func (in *ServiceMeshComponentSpecCertificatesTrustAnchor) DeepCopy() *ServiceMeshComponentSpecCertificatesTrustAnchor {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpecKongGateway)
+	out := new(ServiceMeshComponentSpecCertificatesTrustAnchor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpecKongIngressController) DeepCopyInto(out *IngressComponentSpecKongIngressController) {
+func (in *ServiceMeshComponentSpecInjection) DeepCopyInto(out *ServiceMeshComponentSpecInjection) {
 	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecKongIngressController.
-func (in *IngressComponentSpecKongIngressController) DeepCopy() *IngressComponentSpecKongIngressController {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecInjection.
+func (in *ServiceMeshComponentSpecInjection) DeepCopy() *ServiceMeshComponentSpecInjection {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpecKongIngressController)
+	out := new(ServiceMeshComponentSpecInjection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentSpecNginx) DeepCopyInto(out *IngressComponentSpecNginx) {
+func (in *ServiceMeshComponentSpecMesh) DeepCopyInto(out *ServiceMeshComponentSpecMesh) {
 	*out = *in
+	out.Linkerd = in.Linkerd
+	out.Istio = in.Istio
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentSpecNginx.
-func (in *IngressComponentSpecNginx) DeepCopy() *IngressComponentSpecNginx {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecMesh.
+func (in *ServiceMeshComponentSpecMesh) DeepCopy() *ServiceMeshComponentSpecMesh {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentSpecNginx)
+	out := new(ServiceMeshComponentSpecMesh)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressComponentStatus) DeepCopyInto(out *IngressComponentStatus) {
+func (in *ServiceMeshComponentSpecMeshIstio) DeepCopyInto(out *ServiceMeshComponentSpecMeshIstio) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecMeshIstio.
+func (in *ServiceMeshComponentSpecMeshIstio) DeepCopy() *ServiceMeshComponentSpecMeshIstio {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMeshComponentSpecMeshIstio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMeshComponentSpecMeshLinkerd) DeepCopyInto(out *ServiceMeshComponentSpecMeshLinkerd) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentSpecMeshLinkerd.
+func (in *ServiceMeshComponentSpecMeshLinkerd) DeepCopy() *ServiceMeshComponentSpecMeshLinkerd {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMeshComponentSpecMeshLinkerd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMeshComponentStatus) DeepCopyInto(out *ServiceMeshComponentStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -412,39 +2555,44 @@ func (in *IngressComponentStatus) DeepCopyInto(out *IngressComponentStatus) {
 			}
 		}
 	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponentStatus.
-func (in *IngressComponentStatus) DeepCopy() *IngressComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshComponentStatus.
+func (in *ServiceMeshComponentStatus) DeepCopy() *ServiceMeshComponentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressComponentStatus)
+	out := new(ServiceMeshComponentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponent) DeepCopyInto(out *SecretsComponent) {
+func (in *StorageComponent) DeepCopyInto(out *StorageComponent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponent.
-func (in *SecretsComponent) DeepCopy() *SecretsComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponent.
+func (in *StorageComponent) DeepCopy() *StorageComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponent)
+	out := new(StorageComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretsComponent) DeepCopyObject() runtime.Object {
+func (in *StorageComponent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -452,46 +2600,46 @@ func (in *SecretsComponent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentCollectionSpec) DeepCopyInto(out *SecretsComponentCollectionSpec) {
+func (in *StorageComponentCollectionSpec) DeepCopyInto(out *StorageComponentCollectionSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentCollectionSpec.
-func (in *SecretsComponentCollectionSpec) DeepCopy() *SecretsComponentCollectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentCollectionSpec.
+func (in *StorageComponentCollectionSpec) DeepCopy() *StorageComponentCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentCollectionSpec)
+	out := new(StorageComponentCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentList) DeepCopyInto(out *SecretsComponentList) {
+func (in *StorageComponentList) DeepCopyInto(out *StorageComponentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecretsComponent, len(*in))
+		*out = make([]StorageComponent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentList.
-func (in *SecretsComponentList) DeepCopy() *SecretsComponentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentList.
+func (in *StorageComponentList) DeepCopy() *StorageComponentList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentList)
+	out := new(StorageComponentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretsComponentList) DeepCopyObject() runtime.Object {
+func (in *StorageComponentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -499,103 +2647,161 @@ func (in *SecretsComponentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpec) DeepCopyInto(out *SecretsComponentSpec) {
+func (in *StorageComponentSpec) DeepCopyInto(out *StorageComponentSpec) {
 	*out = *in
 	out.Collection = in.Collection
-	out.ExternalSecrets = in.ExternalSecrets
-	out.Reloader = in.Reloader
+	in.Driver.DeepCopyInto(&out.Driver)
+	out.StorageClasses = in.StorageClasses
+	out.Snapshots = in.Snapshots
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpec.
-func (in *SecretsComponentSpec) DeepCopy() *SecretsComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpec.
+func (in *StorageComponentSpec) DeepCopy() *StorageComponentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpec)
+	out := new(StorageComponentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpecExternalSecrets) DeepCopyInto(out *SecretsComponentSpecExternalSecrets) {
+func (in *StorageComponentSpecDriver) DeepCopyInto(out *StorageComponentSpecDriver) {
 	*out = *in
-	out.CertController = in.CertController
-	out.Controller = in.Controller
-	out.Webhook = in.Webhook
+	out.AWSEBS = in.AWSEBS
+	out.GCEPD = in.GCEPD
+	out.AzureDisk = in.AzureDisk
+	out.LocalPath = in.LocalPath
+	out.OpenEBS = in.OpenEBS
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.PodDisruptionBudget.DeepCopyInto(&out.PodDisruptionBudget)
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecrets.
-func (in *SecretsComponentSpecExternalSecrets) DeepCopy() *SecretsComponentSpecExternalSecrets {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriver.
+func (in *StorageComponentSpecDriver) DeepCopy() *StorageComponentSpecDriver {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpecExternalSecrets)
+	out := new(StorageComponentSpecDriver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpecExternalSecretsCertController) DeepCopyInto(out *SecretsComponentSpecExternalSecretsCertController) {
+func (in *StorageComponentSpecDriverAWSEBS) DeepCopyInto(out *StorageComponentSpecDriverAWSEBS) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsCertController.
-func (in *SecretsComponentSpecExternalSecretsCertController) DeepCopy() *SecretsComponentSpecExternalSecretsCertController {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriverAWSEBS.
+func (in *StorageComponentSpecDriverAWSEBS) DeepCopy() *StorageComponentSpecDriverAWSEBS {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpecExternalSecretsCertController)
+	out := new(StorageComponentSpecDriverAWSEBS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpecExternalSecretsController) DeepCopyInto(out *SecretsComponentSpecExternalSecretsController) {
+func (in *StorageComponentSpecDriverAzureDisk) DeepCopyInto(out *StorageComponentSpecDriverAzureDisk) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsController.
-func (in *SecretsComponentSpecExternalSecretsController) DeepCopy() *SecretsComponentSpecExternalSecretsController {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriverAzureDisk.
+func (in *StorageComponentSpecDriverAzureDisk) DeepCopy() *StorageComponentSpecDriverAzureDisk {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpecExternalSecretsController)
+	out := new(StorageComponentSpecDriverAzureDisk)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpecExternalSecretsWebhook) DeepCopyInto(out *SecretsComponentSpecExternalSecretsWebhook) {
+func (in *StorageComponentSpecDriverGCEPD) DeepCopyInto(out *StorageComponentSpecDriverGCEPD) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecExternalSecretsWebhook.
-func (in *SecretsComponentSpecExternalSecretsWebhook) DeepCopy() *SecretsComponentSpecExternalSecretsWebhook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriverGCEPD.
+func (in *StorageComponentSpecDriverGCEPD) DeepCopy() *StorageComponentSpecDriverGCEPD {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpecExternalSecretsWebhook)
+	out := new(StorageComponentSpecDriverGCEPD)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentSpecReloader) DeepCopyInto(out *SecretsComponentSpecReloader) {
+func (in *StorageComponentSpecDriverLocalPath) DeepCopyInto(out *StorageComponentSpecDriverLocalPath) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentSpecReloader.
-func (in *SecretsComponentSpecReloader) DeepCopy() *SecretsComponentSpecReloader {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriverLocalPath.
+func (in *StorageComponentSpecDriverLocalPath) DeepCopy() *StorageComponentSpecDriverLocalPath {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentSpecReloader)
+	out := new(StorageComponentSpecDriverLocalPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsComponentStatus) DeepCopyInto(out *SecretsComponentStatus) {
+func (in *StorageComponentSpecDriverOpenEBS) DeepCopyInto(out *StorageComponentSpecDriverOpenEBS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecDriverOpenEBS.
+func (in *StorageComponentSpecDriverOpenEBS) DeepCopy() *StorageComponentSpecDriverOpenEBS {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageComponentSpecDriverOpenEBS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageComponentSpecSnapshots) DeepCopyInto(out *StorageComponentSpecSnapshots) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecSnapshots.
+func (in *StorageComponentSpecSnapshots) DeepCopy() *StorageComponentSpecSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageComponentSpecSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageComponentSpecStorageClasses) DeepCopyInto(out *StorageComponentSpecStorageClasses) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentSpecStorageClasses.
+func (in *StorageComponentSpecStorageClasses) DeepCopy() *StorageComponentSpecStorageClasses {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageComponentSpecStorageClasses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageComponentStatus) DeepCopyInto(out *StorageComponentStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -619,14 +2825,19 @@ func (in *SecretsComponentStatus) DeepCopyInto(out *SecretsComponentStatus) {
 			}
 		}
 	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(setupv1alpha1.EffectiveSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsComponentStatus.
-func (in *SecretsComponentStatus) DeepCopy() *SecretsComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageComponentStatus.
+func (in *StorageComponentStatus) DeepCopy() *StorageComponentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsComponentStatus)
+	out := new(StorageComponentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
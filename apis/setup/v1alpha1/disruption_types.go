@@ -0,0 +1,49 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PodDisruptionBudgetSpec defines the pod disruption budget which protects a support services
+// workload running more than one replica.  It may be set on the collection, in which case it
+// applies to all workloads, and overridden for individual workloads on their component.
+type PodDisruptionBudgetSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	//	Number or percentage of pods which must remain available during a voluntary disruption.
+	//	Takes precedence over maxUnavailable when both are set.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	//	Number or percentage of pods which may be unavailable during a voluntary disruption.
+	//	Defaults to 1 when neither minAvailable nor maxUnavailable are set.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// Override returns the pod disruption budget with the override taking precedence if it sets
+// either minAvailable or maxUnavailable.  Unlike the scheduling settings, the budget is overridden
+// as a whole, as minAvailable and maxUnavailable are mutually exclusive.
+func (budget PodDisruptionBudgetSpec) Override(override PodDisruptionBudgetSpec) PodDisruptionBudgetSpec {
+	if override.MinAvailable != nil || override.MaxUnavailable != nil {
+		return override
+	}
+
+	return budget
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// MonitoringSpec defines the prometheus-operator resources which are generated for the workloads
+// of a component.  The resources are only created when the prometheus-operator custom resource
+// definitions are installed in the cluster, e.g. by the monitoring component.
+type MonitoringSpec struct {
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Whether to create ServiceMonitor and PodMonitor resources so that the metrics of the
+	//	workloads are scraped by Prometheus.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Whether to create a PrometheusRule resource with a curated set of alerts for the workloads.
+	Alerts bool `json:"alerts,omitempty"`
+}
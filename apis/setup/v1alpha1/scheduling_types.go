@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SchedulingSpec defines where the pods of a support services workload are scheduled.  It may be
+// set on the collection, in which case it applies to all workloads, and overridden for individual
+// workloads on their component.
+type SchedulingSpec struct {
+	// +kubebuilder:validation:Optional
+	//	Node labels which must be present on a node for the pods to be scheduled onto it.  These
+	//	are added to the default node selector of the workload (kubernetes.io/os: linux).
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Tolerations which allow the pods to be scheduled onto tainted nodes.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	//	Affinity rules for the pods.  When set, these replace the default affinity of the
+	//	workload, which prefers to spread replicas across nodes.  The schema is omitted to keep
+	//	the size of the custom resource definitions down; the rules are validated when the pods
+	//	are created.
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Priority class to assign to the pods.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Topology spread constraints for the pods.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// Override returns the scheduling settings with any settings that are set in the override taking
+// precedence.  Each setting is overridden as a whole, e.g. overriding the tolerations replaces
+// all tolerations rather than adding to them.
+func (scheduling SchedulingSpec) Override(override SchedulingSpec) SchedulingSpec {
+	if override.NodeSelector != nil {
+		scheduling.NodeSelector = override.NodeSelector
+	}
+
+	if override.Tolerations != nil {
+		scheduling.Tolerations = override.Tolerations
+	}
+
+	if override.Affinity != nil {
+		scheduling.Affinity = override.Affinity
+	}
+
+	if override.PriorityClassName != "" {
+		scheduling.PriorityClassName = override.PriorityClassName
+	}
+
+	if override.TopologySpreadConstraints != nil {
+		scheduling.TopologySpreadConstraints = override.TopologySpreadConstraints
+	}
+
+	return scheduling
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -39,9 +40,55 @@ type SupportServicesSpec struct {
 	// +kubebuilder:validation:Optional
 	// (Default: "development")
 	//
-	//	+kubebuilder:validation:Enum=development;staging;production
-	//	The tier of cluster being used.  One of: development | staging | production.
+	//	The tier of cluster being used.  One of the built-in tiers: development | staging | production,
+	//	or the name of a TierProfile which defines a custom tier.
 	Tier string `json:"tier,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Registry mirrors to pull all support services images from, keyed by the source registry
+	//	(e.g. quay.io) with the mirror to use in its place as the value (e.g. registry.example.com/quay).
+	//	Images which do not specify a registry are considered to belong to docker.io.
+	RegistryMirrors map[string]string `json:"registryMirrors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Image pull secrets to use for all support services workloads.  The secrets must exist
+	//	in the namespace of each support services component.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Settings for pinning support services images to digests and verifying their signatures.
+	ImageVerification SupportServicesSpecImageVerification `json:"imageVerification,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Scheduling settings for all support services workloads, e.g. to pin them to dedicated
+	//	infrastructure nodes.  These may be overridden for individual workloads on their component.
+	Scheduling SchedulingSpec `json:"scheduling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Pod disruption budget settings for all support services workloads which run more than one
+	//	replica, when the tier of the collection uses pod disruption budgets.  These may be
+	//	overridden for individual workloads on their component.
+	PodDisruptionBudget PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+type SupportServicesSpecImageVerification struct {
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	// (Default: false)
+	//
+	//	Resolve image tags to digests at reconcile time so that workloads are pinned to the
+	//	image which was resolved.  Images with an explicit digest are not resolved.
+	ResolveDigests bool `json:"resolveDigests,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	PEM encoded cosign public key used to verify image signatures.  When set, images are
+	//	always resolved to digests and components with unsigned or mismatched images are
+	//	marked as degraded rather than rolled out.
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Registries which are accessed over plain HTTP rather than HTTPS (e.g. localhost:5000).
+	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
 }
 
 // SupportServicesStatus defines the observed state of SupportServices.
@@ -53,6 +100,9 @@ type SupportServicesStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// The tier profile which supplies the defaults of all components in the collection.
+	TierProfile *ResolvedTierProfile `json:"tierProfile,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -148,6 +198,17 @@ func (*SupportServices) GetWorkloadGVK() schema.GroupVersionKind {
 	return GroupVersion.WithKind("SupportServices")
 }
 
+// VerifiesImages returns whether image signatures are verified at reconcile time.
+func (component *SupportServices) VerifiesImages() bool {
+	return component.Spec.ImageVerification.PublicKey != ""
+}
+
+// PinsImages returns whether image tags are resolved to digests at reconcile time.  Verifying
+// images implies pinning them, otherwise the tag could move after it has been verified.
+func (component *SupportServices) PinsImages() bool {
+	return component.Spec.ImageVerification.ResolveDigests || component.VerifiesImages()
+}
+
 func init() {
 	SchemeBuilder.Register(&SupportServices{}, &SupportServicesList{})
 }
@@ -34,6 +34,25 @@ metadata:
   name: supportservices-sample
 spec:
   tier: "development"
+  #registryMirrors:
+    #docker.io: "registry.example.com/docker.io"
+    #quay.io: "registry.example.com/quay.io"
+  #imagePullSecrets:
+    #- name: "registry-credentials"
+  imageVerification:
+    resolveDigests: false
+    #publicKey: ""
+    #insecureRegistries: []
+  #scheduling:
+    #nodeSelector:
+      #node-role.kubernetes.io/infra: ""
+    #tolerations:
+      #- key: "node-role.kubernetes.io/infra"
+        #operator: "Exists"
+        #effect: "NoSchedule"
+    #priorityClassName: "system-cluster-critical"
+  #podDisruptionBudget:
+    #maxUnavailable: 1
 `
 
 // sampleSupportServicesRequired is a sample containing only required fields
@@ -0,0 +1,162 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TierProfileSpec defines the defaults which a tier supplies to all support services workloads.
+// Settings which are not set are taken from the built-in tier of the same name, or from the
+// staging tier for custom tiers.
+type TierProfileSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Number of replicas for workloads which are able to run multiple replicas and which do
+	//	not set their own number of replicas.
+	Replicas int `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	//	Default resource requests and limits of all workloads, as a percentage of the requests
+	//	and limits in their manifests, which are sized for staging.
+	ResourcePercent int `json:"resourcePercent,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	//	Whether to protect workloads with more than one replica with a pod disruption budget.
+	PodDisruptionBudgets *bool `json:"podDisruptionBudgets,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=debug;info;warn;error
+	//	Log verbosity of all workloads.  One of: debug | info | warn | error.
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=letsencrypt-staging;letsencrypt-production
+	//	Cluster issuer which issues the publicly trusted certificates of the support services.
+	//	One of: letsencrypt-staging | letsencrypt-production.
+	Issuer string `json:"issuer,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enforce;audit
+	//	Whether admission policies reject or only report resources which violate them, for
+	//	policies which do not set their own mode.  One of: enforce | audit.
+	PolicyMode string `json:"policyMode,omitempty"`
+}
+
+// ReplicasFor returns the given number of replicas of a workload, or the default number of
+// replicas of the profile if the workload does not set its own.
+func (profile TierProfileSpec) ReplicasFor(replicas int) int {
+	if replicas > 0 {
+		return replicas
+	}
+
+	return profile.Replicas
+}
+
+// UsesPodDisruptionBudgets returns whether workloads with more than one replica are protected
+// with a pod disruption budget.
+func (profile TierProfileSpec) UsesPodDisruptionBudgets() bool {
+	return profile.PodDisruptionBudgets != nil && *profile.PodDisruptionBudgets
+}
+
+// Merge returns the profile with any settings which are not set taken from the base profile.
+func (profile TierProfileSpec) Merge(base TierProfileSpec) TierProfileSpec {
+	if profile.Replicas == 0 {
+		profile.Replicas = base.Replicas
+	}
+
+	if profile.ResourcePercent == 0 {
+		profile.ResourcePercent = base.ResourcePercent
+	}
+
+	if profile.PodDisruptionBudgets == nil {
+		profile.PodDisruptionBudgets = base.PodDisruptionBudgets
+	}
+
+	if profile.LogLevel == "" {
+		profile.LogLevel = base.LogLevel
+	}
+
+	if profile.Issuer == "" {
+		profile.Issuer = base.Issuer
+	}
+
+	if profile.PolicyMode == "" {
+		profile.PolicyMode = base.PolicyMode
+	}
+
+	return profile
+}
+
+// ResolvedTierProfile is a tier profile which has been resolved for a collection.
+type ResolvedTierProfile struct {
+	// Name of the tier which the profile was resolved from.
+	Name string `json:"name"`
+
+	TierProfileSpec `json:",inline"`
+}
+
+// EffectiveSettings are the settings which are in effect for a component after merging the tier
+// profile of its collection with its own spec.
+type EffectiveSettings struct {
+	// Tier profile which supplied the defaults of the component.
+	TierProfile ResolvedTierProfile `json:"tierProfile"`
+
+	// Number of replicas of each deployment of the component, keyed by the deployment name.  For
+	// autoscaled deployments, this is the minimum number of replicas.
+	Replicas map[string]int `json:"replicas,omitempty"`
+
+	// Pod disruption budget of each workload of the component which is protected by one, keyed by
+	// the workload name.
+	PodDisruptionBudgets map[string]PodDisruptionBudgetSpec `json:"podDisruptionBudgets,omitempty"`
+
+	// Resource requests and limits of the workloads of the component which do not set their own,
+	// as a percentage of the requests and limits in their manifests.
+	ResourcePercent int `json:"resourcePercent,omitempty"`
+
+	// Log verbosity of the workloads of the component which do not set their own.
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// Cluster issuer which issues the publicly trusted certificates of the component.
+	Issuer string `json:"issuer,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// TierProfile is the Schema for the tierprofiles API.  It defines a custom tier, which is
+// selected by setting the tier of a collection to the name of the profile.  A profile with the
+// name of a built-in tier (development, staging or production) customizes the built-in tier.
+type TierProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TierProfileSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TierProfileList contains a list of TierProfile.
+type TierProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TierProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TierProfile{}, &TierProfileList{})
+}
@@ -73,6 +73,16 @@ func (c *SsctlCommand) newInitSubCommand() {
 	initplatform.NewCertificatesComponentSubCommand(parentCommand)
 	initplatform.NewIngressComponentSubCommand(parentCommand)
 	initplatform.NewSecretsComponentSubCommand(parentCommand)
+	initplatform.NewMonitoringComponentSubCommand(parentCommand)
+	initplatform.NewLoggingComponentSubCommand(parentCommand)
+	initplatform.NewStorageComponentSubCommand(parentCommand)
+	initplatform.NewPolicyComponentSubCommand(parentCommand)
+	initplatform.NewBackupComponentSubCommand(parentCommand)
+	initplatform.NewServiceMeshComponentSubCommand(parentCommand)
+	initapplication.NewMessagingComponentSubCommand(parentCommand)
+	initapplication.NewCacheComponentSubCommand(parentCommand)
+	initapplication.NewRedisInstanceSubCommand(parentCommand)
+	initplatform.NewObjectStorageComponentSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:init
 }
 
@@ -86,6 +96,16 @@ func (c *SsctlCommand) newGenerateSubCommand() {
 	generateplatform.NewCertificatesComponentSubCommand(parentCommand)
 	generateplatform.NewIngressComponentSubCommand(parentCommand)
 	generateplatform.NewSecretsComponentSubCommand(parentCommand)
+	generateplatform.NewMonitoringComponentSubCommand(parentCommand)
+	generateplatform.NewLoggingComponentSubCommand(parentCommand)
+	generateplatform.NewStorageComponentSubCommand(parentCommand)
+	generateplatform.NewPolicyComponentSubCommand(parentCommand)
+	generateplatform.NewBackupComponentSubCommand(parentCommand)
+	generateplatform.NewServiceMeshComponentSubCommand(parentCommand)
+	generateapplication.NewMessagingComponentSubCommand(parentCommand)
+	generateapplication.NewCacheComponentSubCommand(parentCommand)
+	generateapplication.NewRedisInstanceSubCommand(parentCommand)
+	generateplatform.NewObjectStorageComponentSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:generate
 }
 
@@ -99,6 +119,16 @@ func (c *SsctlCommand) newVersionSubCommand() {
 	versionplatform.NewCertificatesComponentSubCommand(parentCommand)
 	versionplatform.NewIngressComponentSubCommand(parentCommand)
 	versionplatform.NewSecretsComponentSubCommand(parentCommand)
+	versionplatform.NewMonitoringComponentSubCommand(parentCommand)
+	versionplatform.NewLoggingComponentSubCommand(parentCommand)
+	versionplatform.NewStorageComponentSubCommand(parentCommand)
+	versionplatform.NewPolicyComponentSubCommand(parentCommand)
+	versionplatform.NewBackupComponentSubCommand(parentCommand)
+	versionplatform.NewServiceMeshComponentSubCommand(parentCommand)
+	versionapplication.NewMessagingComponentSubCommand(parentCommand)
+	versionapplication.NewCacheComponentSubCommand(parentCommand)
+	versionapplication.NewRedisInstanceSubCommand(parentCommand)
+	versionplatform.NewObjectStorageComponentSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:version
 }
 
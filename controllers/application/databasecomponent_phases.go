@@ -21,6 +21,10 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/phases"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/prune"
+	"github.com/nukleros/support-services-operator/internal/tier"
 )
 
 // InitializePhases defines what phases should be run for each event loop. phases are executed
@@ -34,12 +38,30 @@ func (r *DatabaseComponentReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Effective-Settings",
+		tier.EffectiveSettingsPhase,
+		phases.CreateEvent,
+	)
+
+	r.Phases.Register(
+		"Verify-Images",
+		imagepolicy.VerifyImagesPhase,
+		phases.CreateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
 		phases.CreateEvent,
 	)
 
+	r.Phases.Register(
+		"Prune-Resources",
+		prune.PrunePhase,
+		phases.CreateEvent,
+	)
+
 	r.Phases.Register(
 		"Check-Ready",
 		phases.CheckReadyPhase,
@@ -61,12 +83,30 @@ func (r *DatabaseComponentReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Effective-Settings",
+		tier.EffectiveSettingsPhase,
+		phases.UpdateEvent,
+	)
+
+	r.Phases.Register(
+		"Verify-Images",
+		imagepolicy.VerifyImagesPhase,
+		phases.UpdateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
 		phases.UpdateEvent,
 	)
 
+	r.Phases.Register(
+		"Prune-Resources",
+		prune.PrunePhase,
+		phases.UpdateEvent,
+	)
+
 	r.Phases.Register(
 		"Check-Ready",
 		phases.CheckReadyPhase,
@@ -25,10 +25,14 @@ import (
 	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
 	"github.com/nukleros/support-services-operator/apis/setup/v1alpha1/supportservicescollection"
@@ -190,6 +194,10 @@ func (r *SupportServicesReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	baseController, err := ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(predicates.WorkloadPredicates()).
 		For(&setupv1alpha1.SupportServices{}).
+		Watches(
+			&source.Kind{Type: &setupv1alpha1.TierProfile{}},
+			handler.EnqueueRequestsFromMapFunc(r.mapTierProfile),
+		).
 		Build(r)
 	if err != nil {
 		return fmt.Errorf("unable to setup controller, %w", err)
@@ -199,3 +207,32 @@ func (r *SupportServicesReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return nil
 }
+
+// mapTierProfile maps a tier profile to the collections which use its tier, so that changes to
+// the profile are rolled out to the components of those collections.
+func (r *SupportServicesReconciler) mapTierProfile(profile client.Object) []reconcile.Request {
+	collections := &setupv1alpha1.SupportServicesList{}
+
+	if err := r.List(context.Background(), collections); err != nil {
+		r.Log.Error(err, "unable to list collections for tier profile", "name", profile.GetName())
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range collections.Items {
+		if collections.Items[i].Spec.Tier != profile.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      collections.Items[i].GetName(),
+				Namespace: collections.Items[i].GetNamespace(),
+			},
+		})
+	}
+
+	return requests
+}
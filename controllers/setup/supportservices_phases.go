@@ -21,6 +21,8 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/phases"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nukleros/support-services-operator/internal/tier"
 )
 
 // InitializePhases defines what phases should be run for each event loop. phases are executed
@@ -34,6 +36,12 @@ func (r *SupportServicesReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Resolve-Tier",
+		tier.ResolvePhase,
+		phases.CreateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
@@ -61,6 +69,12 @@ func (r *SupportServicesReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Resolve-Tier",
+		tier.ResolvePhase,
+		phases.UpdateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
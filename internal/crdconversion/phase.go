@@ -0,0 +1,182 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CAInjectionAnnotation is the annotation which requests that cert-manager inject a certificate
+// authority into an object.
+const CAInjectionAnnotation = "cert-manager.io/inject-ca-from"
+
+// SyncPhase applies the conversion strategy and the certificate authority injection annotation of
+// the generated custom resource definitions to those in the cluster.  Custom resource definitions
+// are never updated by the create phase, so without this phase a change to the conversion webhook
+// would only apply to newly created definitions.  The phase must run before the resources of the
+// webhook are pruned, otherwise existing definitions would reference a webhook which no longer
+// exists and every read of their custom resources would fail.  The certificate authority bundle is
+// left untouched as it is injected by cert-manager or the cert-controller.
+func SyncPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	desiredResources, err := r.GetResources(req)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve resources, %w", err)
+	}
+
+	for _, resource := range desiredResources {
+		if resource.GetObjectKind().GroupVersionKind().Kind != "CustomResourceDefinition" {
+			continue
+		}
+
+		desired, ok := resource.(*unstructured.Unstructured)
+		if !ok {
+			return false, fmt.Errorf("unable to convert object %s to unstructured", resource.GetName())
+		}
+
+		actual := &unstructured.Unstructured{}
+		actual.SetGroupVersionKind(desired.GroupVersionKind())
+
+		if err := r.Get(req.Context, client.ObjectKey{Name: desired.GetName()}, actual); err != nil {
+			// the definition is created by the create phase on the next reconciliation
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+
+			return false, fmt.Errorf("unable to retrieve custom resource definition %s, %w", desired.GetName(), err)
+		}
+
+		patch, err := Patch(desired, actual)
+		if err != nil {
+			return false, err
+		}
+
+		if patch == nil {
+			continue
+		}
+
+		req.Log.Info("updating conversion of custom resource definition", "name", desired.GetName())
+
+		if err := r.Patch(req.Context, actual, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			return false, fmt.Errorf("unable to update conversion of custom resource definition %s, %w", desired.GetName(), err)
+		}
+	}
+
+	return true, nil
+}
+
+// Patch returns the merge patch which applies the conversion and certificate authority injection
+// annotation of the desired custom resource definition to the actual one, or nil if they already
+// match.  Fields which are not desired are explicitly removed, as a merge patch otherwise leaves
+// them in place.
+func Patch(desired, actual *unstructured.Unstructured) ([]byte, error) {
+	desiredConversion, err := conversion(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	actualConversion, err := conversion(actual)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredCA, hasDesiredCA := desired.GetAnnotations()[CAInjectionAnnotation]
+	actualCA, hasActualCA := actual.GetAnnotations()[CAInjectionAnnotation]
+
+	if reflect.DeepEqual(desiredConversion, actualConversion) && desiredCA == actualCA && hasDesiredCA == hasActualCA {
+		return nil, nil
+	}
+
+	conversionPatch := map[string]interface{}{}
+
+	for field, value := range desiredConversion {
+		conversionPatch[field] = value
+	}
+
+	if _, ok := desiredConversion["webhook"]; !ok {
+		conversionPatch["webhook"] = nil
+	}
+
+	var caPatch interface{}
+	if hasDesiredCA {
+		caPatch = desiredCA
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				CAInjectionAnnotation: caPatch,
+			},
+		},
+		"spec": map[string]interface{}{
+			"conversion": conversionPatch,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create conversion patch for %s, %w", desired.GetName(), err)
+	}
+
+	return patch, nil
+}
+
+// conversion returns the conversion of a custom resource definition without the certificate
+// authority bundle.  The conversion is normalized through json so that the generated and the
+// retrieved definitions may be compared.
+func conversion(crd *unstructured.Unstructured) (map[string]interface{}, error) {
+	field, _, err := unstructured.NestedFieldNoCopy(crd.Object, "spec", "conversion")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get conversion of %s, %w", crd.GetName(), err)
+	}
+
+	data, err := json.Marshal(field)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get conversion of %s, %w", crd.GetName(), err)
+	}
+
+	normalized := map[string]interface{}{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("unable to get conversion of %s, %w", crd.GetName(), err)
+	}
+
+	if normalized == nil {
+		normalized = map[string]interface{}{}
+	}
+
+	unstructured.RemoveNestedField(normalized, "webhook", "clientConfig", "caBundle")
+
+	// the api server defaults the strategy and the port of the webhook service when they are not set
+	if _, ok := normalized["strategy"]; !ok {
+		normalized["strategy"] = "None"
+	}
+
+	if service, ok, _ := unstructured.NestedMap(normalized, "webhook", "clientConfig", "service"); ok {
+		if _, ok := service["port"]; !ok {
+			if err := unstructured.SetNestedField(normalized, float64(443), "webhook", "clientConfig", "service", "port"); err != nil {
+				return nil, fmt.Errorf("unable to get conversion of %s, %w", crd.GetName(), err)
+			}
+		}
+	}
+
+	return normalized, nil
+}
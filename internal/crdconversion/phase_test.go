@@ -0,0 +1,186 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func webhookCRD(annotations map[string]string, caBundle string, port int64) *unstructured.Unstructured {
+	service := map[string]interface{}{
+		"name":      "external-secrets-webhook",
+		"namespace": "nukleros-secrets-system",
+		"path":      "/convert",
+	}
+
+	if port != 0 {
+		service["port"] = port
+	}
+
+	clientConfig := map[string]interface{}{"service": service}
+
+	if caBundle != "" {
+		clientConfig["caBundle"] = caBundle
+	}
+
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "externalsecrets.external-secrets.io"},
+		"spec": map[string]interface{}{
+			"conversion": map[string]interface{}{
+				"strategy": "Webhook",
+				"webhook": map[string]interface{}{
+					"conversionReviewVersions": []interface{}{"v1"},
+					"clientConfig":             clientConfig,
+				},
+			},
+		},
+	}}
+
+	crd.SetAnnotations(annotations)
+
+	return crd
+}
+
+func noneCRD() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "externalsecrets.external-secrets.io"},
+		"spec": map[string]interface{}{
+			"conversion": map[string]interface{}{"strategy": "None"},
+		},
+	}}
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+
+	injectCA := map[string]string{CAInjectionAnnotation: "nukleros-secrets-system/external-secrets-webhook"}
+
+	for _, tt := range []struct {
+		name    string
+		desired *unstructured.Unstructured
+		actual  *unstructured.Unstructured
+		want    map[string]interface{}
+	}{
+		{
+			name:    "unchanged webhook with an injected bundle and defaulted port",
+			desired: webhookCRD(injectCA, "", 0),
+			actual:  webhookCRD(injectCA, "Y2E=", 443),
+			want:    nil,
+		},
+		{
+			name:    "webhook disabled",
+			desired: noneCRD(),
+			actual:  webhookCRD(injectCA, "Y2E=", 443),
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{CAInjectionAnnotation: nil},
+				},
+				"spec": map[string]interface{}{
+					"conversion": map[string]interface{}{"strategy": "None", "webhook": nil},
+				},
+			},
+		},
+		{
+			name:    "cert-manager disabled",
+			desired: webhookCRD(nil, "", 0),
+			actual:  webhookCRD(injectCA, "Y2E=", 443),
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{CAInjectionAnnotation: nil},
+				},
+				"spec": map[string]interface{}{
+					"conversion": map[string]interface{}{
+						"strategy": "Webhook",
+						"webhook": map[string]interface{}{
+							"conversionReviewVersions": []interface{}{"v1"},
+							"clientConfig": map[string]interface{}{
+								"service": map[string]interface{}{
+									"name":      "external-secrets-webhook",
+									"namespace": "nukleros-secrets-system",
+									"path":      "/convert",
+									"port":      float64(443),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "webhook enabled",
+			desired: webhookCRD(injectCA, "", 0),
+			actual:  noneCRD(),
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{CAInjectionAnnotation: injectCA[CAInjectionAnnotation]},
+				},
+				"spec": map[string]interface{}{
+					"conversion": map[string]interface{}{
+						"strategy": "Webhook",
+						"webhook": map[string]interface{}{
+							"conversionReviewVersions": []interface{}{"v1"},
+							"clientConfig": map[string]interface{}{
+								"service": map[string]interface{}{
+									"name":      "external-secrets-webhook",
+									"namespace": "nukleros-secrets-system",
+									"path":      "/convert",
+									"port":      float64(443),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			patch, err := Patch(tt.desired, tt.actual)
+			if err != nil {
+				t.Fatalf("Patch() error = %v", err)
+			}
+
+			if tt.want == nil {
+				if patch != nil {
+					t.Fatalf("Patch() = %s, want no patch", patch)
+				}
+
+				return
+			}
+
+			got := map[string]interface{}{}
+			if err := json.Unmarshal(patch, &got); err != nil {
+				t.Fatalf("unable to unmarshal patch, %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Patch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
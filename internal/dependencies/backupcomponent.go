@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// backupStorageLocationGVK is the kind of the velero backup storage locations.
+var backupStorageLocationGVK = schema.GroupVersionKind{Group: "velero.io", Version: "v1", Kind: "BackupStorageLocation"}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+
+// BackupComponentCheckReady performs the logic to determine if a BackupComponent object is ready.
+// The component is ready once velero is available and has verified that it is able to reach the
+// object storage of its backup storage location.
+func BackupComponentCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.BackupComponent)
+	if !ok {
+		return false, platformv1alpha1.ErrUnableToConvertBackupComponent
+	}
+
+	deployment := &appsv1.Deployment{}
+
+	name := types.NamespacedName{Name: "velero", Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, deployment); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get velero deployment %s, %w", name, err)
+	}
+
+	if !deploymentAvailable(deployment) {
+		return false, nil
+	}
+
+	location := &unstructured.Unstructured{}
+	location.SetGroupVersionKind(backupStorageLocationGVK)
+
+	name = types.NamespacedName{Name: "default", Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, location); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get backup storage location %s, %w", name, err)
+	}
+
+	phase, _, err := unstructured.NestedString(location.Object, "status", "phase")
+	if err != nil {
+		return false, fmt.Errorf("unable to get phase of backup storage location %s, %w", name, err)
+	}
+
+	return phase == "Available", nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// LoggingComponentCheckReady performs the logic to determine if a LoggingComponent object is ready.
+// The component is ready once the collector daemonset is fully scheduled and available on every
+// node it targets, and the aggregator, if enabled, has all of its replicas available.
+func LoggingComponentCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.LoggingComponent)
+	if !ok {
+		return false, platformv1alpha1.ErrUnableToConvertLoggingComponent
+	}
+
+	collector := &appsv1.DaemonSet{}
+
+	name := types.NamespacedName{Name: component.Spec.Collector.Type, Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, collector); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get collector daemonset %s, %w", name, err)
+	}
+
+	if !daemonSetScheduled(collector) {
+		return false, nil
+	}
+
+	if !component.UsesAggregator() {
+		return true, nil
+	}
+
+	aggregator := &appsv1.Deployment{}
+
+	name = types.NamespacedName{Name: "vector-aggregator", Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, aggregator); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get aggregator deployment %s, %w", name, err)
+	}
+
+	return deploymentAvailable(aggregator), nil
+}
+
+// daemonSetScheduled returns whether the current generation of a daemonset is scheduled, updated
+// and ready on every node which it targets.
+func daemonSetScheduled(daemonSet *appsv1.DaemonSet) bool {
+	status := daemonSet.Status
+
+	if status.ObservedGeneration < daemonSet.Generation {
+		return false
+	}
+
+	return status.NumberMisscheduled == 0 &&
+		status.NumberUnavailable == 0 &&
+		status.CurrentNumberScheduled == status.DesiredNumberScheduled &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+		status.NumberReady == status.DesiredNumberScheduled
+}
+
+// deploymentAvailable returns whether all replicas of the current generation of a deployment are
+// updated and available.
+func deploymentAvailable(deployment *appsv1.Deployment) bool {
+	status := deployment.Status
+
+	if status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return status.UpdatedReplicas == replicas && status.AvailableReplicas == replicas
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// tenantGVK is the kind of the minio tenants.
+var tenantGVK = schema.GroupVersionKind{Group: "minio.min.io", Version: "v2", Kind: "Tenant"}
+
+// ObjectStorageComponentCheckReady performs the logic to determine if a ObjectStorageComponent object is ready.
+// The component is ready once minio is ready to serve requests and the buckets of the component
+// have been created.
+func ObjectStorageComponentCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.ObjectStorageComponent)
+	if !ok {
+		return false, platformv1alpha1.ErrUnableToConvertObjectStorageComponent
+	}
+
+	var ready bool
+
+	var err error
+
+	if component.Spec.Mode == "operator" {
+		ready, err = tenantReady(r, req, component)
+	} else {
+		ready, err = minioReady(r, req, component)
+	}
+
+	if err != nil || !ready {
+		return false, err
+	}
+
+	return bucketsReady(r, req, component)
+}
+
+// minioReady returns whether the minio statefulset of a standalone install is ready.
+func minioReady(
+	r workload.Reconciler, req *workload.Request,
+	component *platformv1alpha1.ObjectStorageComponent,
+) (bool, error) {
+	statefulSet := &appsv1.StatefulSet{}
+
+	name := types.NamespacedName{Name: "minio", Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, statefulSet); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get minio statefulset %s, %w", name, err)
+	}
+
+	return statefulSetReady(statefulSet), nil
+}
+
+// tenantReady returns whether the minio operator has initialized the minio tenant.
+func tenantReady(
+	r workload.Reconciler, req *workload.Request,
+	component *platformv1alpha1.ObjectStorageComponent,
+) (bool, error) {
+	tenant := &unstructured.Unstructured{}
+	tenant.SetGroupVersionKind(tenantGVK)
+
+	name := types.NamespacedName{Name: "minio", Namespace: component.Spec.Namespace}
+	if err := r.Get(req.Context, name, tenant); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get minio tenant %s, %w", name, err)
+	}
+
+	state, _, err := unstructured.NestedString(tenant.Object, "status", "currentState")
+	if err != nil {
+		return false, fmt.Errorf("unable to get state of minio tenant %s, %w", name, err)
+	}
+
+	return state == "Initialized", nil
+}
+
+// bucketsReady returns whether the job which creates the buckets of the component has succeeded.
+// Jobs of previous buckets are pruned, so every remaining job of the component must succeed.
+func bucketsReady(
+	r workload.Reconciler, req *workload.Request,
+	component *platformv1alpha1.ObjectStorageComponent,
+) (bool, error) {
+	if len(component.Spec.Buckets) == 0 {
+		return true, nil
+	}
+
+	jobs := &batchv1.JobList{}
+
+	if err := r.List(
+		req.Context, jobs,
+		client.InNamespace(component.Spec.Namespace),
+		client.MatchingLabels{"app.kubernetes.io/name": "minio-buckets"},
+	); err != nil {
+		return false, fmt.Errorf("unable to list minio bucket jobs, %w", err)
+	}
+
+	found := false
+
+	for i := range jobs.Items {
+		if !metav1.IsControlledBy(&jobs.Items[i], component) {
+			continue
+		}
+
+		if jobs.Items[i].Status.Succeeded == 0 {
+			return false, nil
+		}
+
+		found = true
+	}
+
+	return found, nil
+}
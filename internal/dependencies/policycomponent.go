@@ -0,0 +1,110 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent"
+)
+
+// constraintGroup is the api group of the gatekeeper constraints.
+const constraintGroup = "constraints.gatekeeper.sh"
+
+// PolicyComponentCheckReady performs the logic to determine if a PolicyComponent object is ready.
+// The component is ready once the deployments of the engine have all of their replicas available
+// and, for gatekeeper, every constraint exists.  The constraints are skipped until gatekeeper
+// serves their kinds, so they are created while waiting for the component to become ready.
+func PolicyComponentCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.PolicyComponent)
+	if !ok {
+		return false, platformv1alpha1.ErrUnableToConvertPolicyComponent
+	}
+
+	deployments := []string{component.AdmissionController()}
+	if component.Spec.Engine.Type == "gatekeeper" {
+		deployments = append(deployments, "gatekeeper-audit")
+	}
+
+	for _, name := range deployments {
+		deployment := &appsv1.Deployment{}
+
+		key := types.NamespacedName{Name: name, Namespace: component.Spec.Namespace}
+		if err := r.Get(req.Context, key, deployment); err != nil {
+			if apierrs.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unable to get engine deployment %s, %w", key, err)
+		}
+
+		if !deploymentAvailable(deployment) {
+			return false, nil
+		}
+	}
+
+	if component.Spec.Engine.Type != "gatekeeper" {
+		return true, nil
+	}
+
+	return constraintsExist(r, req)
+}
+
+// constraintsExist returns whether every gatekeeper constraint of a component exists.  The
+// resources are generated without the reconciler, as when generating them from the CLI, so that
+// the constraints whose kinds are not served yet are included.
+func constraintsExist(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, collection, err := policycomponent.ConvertWorkload(req.Workload, req.Collection)
+	if err != nil {
+		return false, err
+	}
+
+	resources, err := policycomponent.Generate(*component, *collection, nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("unable to generate resources, %w", err)
+	}
+
+	for _, resource := range resources {
+		gvk := resource.GetObjectKind().GroupVersionKind()
+		if gvk.Group != constraintGroup {
+			continue
+		}
+
+		constraint := &unstructured.Unstructured{}
+		constraint.SetGroupVersionKind(gvk)
+
+		if err := r.Get(req.Context, client.ObjectKeyFromObject(resource), constraint); err != nil {
+			if apierrs.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unable to get constraint %s, %w", resource.GetName(), err)
+		}
+	}
+
+	return true, nil
+}
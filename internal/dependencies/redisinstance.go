@@ -0,0 +1,75 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+)
+
+// RedisInstanceCheckReady performs the logic to determine if a RedisInstance object is ready.
+// The instance is ready once every statefulset which the redis operator creates for its topology
+// has all of its replicas ready.
+func RedisInstanceCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	instance, ok := req.Workload.(*applicationv1alpha1.RedisInstance)
+	if !ok {
+		return false, applicationv1alpha1.ErrUnableToConvertRedisInstance
+	}
+
+	for statefulSetName := range instance.StatefulSets() {
+		statefulSet := &appsv1.StatefulSet{}
+
+		name := types.NamespacedName{Name: statefulSetName, Namespace: instance.Namespace}
+		if err := r.Get(req.Context, name, statefulSet); err != nil {
+			if apierrs.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unable to get redis statefulset %s, %w", name, err)
+		}
+
+		if !statefulSetReady(statefulSet) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// statefulSetReady returns whether all replicas of the current generation of a statefulset are
+// updated and ready.
+func statefulSetReady(statefulSet *appsv1.StatefulSet) bool {
+	status := statefulSet.Status
+
+	if status.ObservedGeneration < statefulSet.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	return status.UpdatedReplicas == replicas && status.ReadyReplicas == replicas
+}
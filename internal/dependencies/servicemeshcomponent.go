@@ -0,0 +1,75 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// ServiceMeshComponentCheckReady performs the logic to determine if a ServiceMeshComponent object is ready.
+// The component is ready once every deployment of the control plane of the mesh is available and
+// its daemonsets, if any, are scheduled on every node which they target.
+func ServiceMeshComponentCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.ServiceMeshComponent)
+	if !ok {
+		return false, platformv1alpha1.ErrUnableToConvertServiceMeshComponent
+	}
+
+	for _, deploymentName := range component.ControlPlaneDeployments() {
+		deployment := &appsv1.Deployment{}
+
+		name := types.NamespacedName{Name: deploymentName, Namespace: component.Spec.Namespace}
+		if err := r.Get(req.Context, name, deployment); err != nil {
+			if apierrs.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unable to get control plane deployment %s, %w", name, err)
+		}
+
+		if !deploymentAvailable(deployment) {
+			return false, nil
+		}
+	}
+
+	for _, daemonSetName := range component.ControlPlaneDaemonSets() {
+		daemonSet := &appsv1.DaemonSet{}
+
+		name := types.NamespacedName{Name: daemonSetName, Namespace: component.Spec.Namespace}
+		if err := r.Get(req.Context, name, daemonSet); err != nil {
+			if apierrs.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("unable to get control plane daemonset %s, %w", name, err)
+		}
+
+		if !daemonSetScheduled(daemonSet) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
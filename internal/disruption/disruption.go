@@ -0,0 +1,63 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// SetBudget applies the pod disruption budget settings to a generated pod disruption budget.  As
+// minAvailable and maxUnavailable are mutually exclusive, setting either removes the other.  The
+// other field is explicitly set to null rather than deleted, as resources are updated with a merge
+// patch which leaves fields that are missing from the patch in place.
+func SetBudget(object client.Object, budget setupv1alpha1.PodDisruptionBudgetSpec) error {
+	pdb, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unable to convert object %s to unstructured", object.GetName())
+	}
+
+	spec, ok := pdb.Object["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("missing spec for pod disruption budget %s", object.GetName())
+	}
+
+	switch {
+	case budget.MinAvailable != nil:
+		spec["maxUnavailable"] = nil
+		spec["minAvailable"] = intOrString(*budget.MinAvailable)
+	case budget.MaxUnavailable != nil:
+		spec["minAvailable"] = nil
+		spec["maxUnavailable"] = intOrString(*budget.MaxUnavailable)
+	}
+
+	return nil
+}
+
+// intOrString returns the unstructured representation of an int or string value.
+func intOrString(value intstr.IntOrString) interface{} {
+	if value.Type == intstr.String {
+		return value.StrVal
+	}
+
+	return int(value.IntVal)
+}
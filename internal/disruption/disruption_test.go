@@ -0,0 +1,145 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/nukleros/operator-builder-tools/pkg/resources"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// generatedBudget returns a pod disruption budget as generated from the manifests, which default
+// to a maximum of one unavailable pod.
+func generatedBudget() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "PodDisruptionBudget",
+		"metadata": map[string]interface{}{
+			"name":      "nginx-ingress",
+			"namespace": "nukleros-ingress-system",
+		},
+		"spec": map[string]interface{}{
+			"maxUnavailable": 1,
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "nginx-ingress"},
+			},
+		},
+	}}
+}
+
+// update applies the desired budget to the actual budget with a merge patch, as the resources of
+// a workload are updated, and returns the spec of the result.
+func update(t *testing.T, desired, actual *unstructured.Unstructured) map[string]interface{} {
+	t.Helper()
+
+	original, err := json.Marshal(actual.Object)
+	if err != nil {
+		t.Fatalf("unable to marshal actual budget, %v", err)
+	}
+
+	patch, err := json.Marshal(desired.Object)
+	if err != nil {
+		t.Fatalf("unable to marshal desired budget, %v", err)
+	}
+
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		t.Fatalf("unable to apply patch, %v", err)
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		t.Fatalf("unable to unmarshal patched budget, %v", err)
+	}
+
+	actual.Object = result
+
+	spec, _ := result["spec"].(map[string]interface{})
+
+	return spec
+}
+
+func TestSetBudgetSwitchesField(t *testing.T) {
+	t.Parallel()
+
+	minAvailable := intstr.FromString("50%")
+	maxUnavailable := intstr.FromInt(2)
+
+	// the live budget was created with the default of the manifest
+	actual := generatedBudget()
+	update(t, generatedBudget(), actual)
+
+	for _, tt := range []struct {
+		name    string
+		budget  setupv1alpha1.PodDisruptionBudgetSpec
+		field   string
+		value   interface{}
+		removed string
+	}{
+		{
+			name:    "switch to minAvailable",
+			budget:  setupv1alpha1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+			field:   "minAvailable",
+			value:   "50%",
+			removed: "maxUnavailable",
+		},
+		{
+			name:    "switch back to maxUnavailable",
+			budget:  setupv1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: &maxUnavailable},
+			field:   "maxUnavailable",
+			value:   float64(2),
+			removed: "minAvailable",
+		},
+	} {
+		desired := generatedBudget()
+
+		if err := SetBudget(desired, tt.budget); err != nil {
+			t.Fatalf("%s: SetBudget() error = %v", tt.name, err)
+		}
+
+		equal, err := resources.AreEqual(desired, actual)
+		if err != nil {
+			t.Fatalf("%s: unable to compare budgets, %v", tt.name, err)
+		}
+
+		if equal {
+			t.Fatalf("%s: budget is not updated", tt.name)
+		}
+
+		spec := update(t, desired, actual)
+
+		if got := spec[tt.field]; !reflect.DeepEqual(got, tt.value) {
+			t.Errorf("%s: %s = %v, want %v", tt.name, tt.field, got, tt.value)
+		}
+
+		if got, ok := spec[tt.removed]; ok {
+			t.Errorf("%s: %s = %v, want it to be removed", tt.name, tt.removed, got)
+		}
+
+		// the budget is not updated again once the switch is applied
+		if equal, err := resources.AreEqual(desired, actual); err != nil || !equal {
+			t.Errorf("%s: budget is updated again after the switch, equal = %t, error = %v", tt.name, equal, err)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/registry"
+)
+
+// resolveTTL is the duration for which the digest that a tag resolves to is cached.  The resources
+// of a component are generated several times per reconciliation, so without the cache every image
+// would be resolved against its registry each time.  Tags rarely move, so a change is picked up
+// with a short delay at most.
+const resolveTTL = 5 * time.Minute
+
+var (
+	// resolved caches the digests which tags resolve to, keyed by the reference of the tag.
+	resolved sync.Map
+
+	// verified caches the images which have passed signature verification.  Signatures are
+	// verified against an immutable digest, so a successful verification never needs to be
+	// repeated for the same key.
+	verified sync.Map
+)
+
+// resolution is a digest which a tag resolved to.
+type resolution struct {
+	digest  string
+	expires time.Time
+}
+
+// VerificationError is returned when an image does not have a valid signature.
+type VerificationError struct {
+	Image string
+	Err   error
+}
+
+func (err *VerificationError) Error() string {
+	return fmt.Sprintf("image verification failed, %s", err.Err)
+}
+
+func (err *VerificationError) Unwrap() error {
+	return err.Err
+}
+
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Apply pins the images of all containers in a generated workload object to their digests and
+// verifies their signatures, as configured by the image verification settings of the collection.
+// It requires access to the cluster and to the image registries, so it must only be called when
+// reconciling.  Resolved digests and verified signatures are cached, so the registries are only
+// accessed, and the image pull secrets only read, for images which are not cached.
+func Apply(
+	reconciler workload.Reconciler,
+	req *workload.Request,
+	object client.Object,
+	collection *setupv1alpha1.SupportServices,
+) error {
+	if !collection.PinsImages() {
+		return nil
+	}
+
+	containers, err := podtemplate.Containers(object)
+	if err != nil {
+		return err
+	}
+
+	var registryClient *registry.Client
+
+	// newClient returns the registry client, which is only created once it is first needed.
+	newClient := func() (*registry.Client, error) {
+		if registryClient != nil {
+			return registryClient, nil
+		}
+
+		created := registry.NewClient()
+		created.Insecure = collection.Spec.ImageVerification.InsecureRegistries
+
+		if err := setCredentials(req.Context, reconciler, created, object.GetNamespace(), collection); err != nil {
+			return nil, err
+		}
+
+		registryClient = created
+
+		return registryClient, nil
+	}
+
+	for _, container := range containers {
+		image, _ := container["image"].(string)
+
+		ref, err := registry.ParseReference(image)
+		if err != nil {
+			return err
+		}
+
+		digest, err := resolve(req.Context, newClient, ref)
+		if err != nil {
+			return err
+		}
+
+		if collection.VerifiesImages() {
+			if err := verify(req.Context, newClient, ref, digest, collection.Spec.ImageVerification.PublicKey); err != nil {
+				return err
+			}
+		}
+
+		podtemplate.PinImage(container, digest)
+	}
+
+	return nil
+}
+
+// resolve returns the digest which the reference resolves to, from the cache if it has been
+// resolved recently.
+func resolve(ctx context.Context, newClient func() (*registry.Client, error), ref registry.Reference) (string, error) {
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+
+	if cached, ok := resolved.Load(ref.String()); ok && time.Now().Before(cached.(resolution).expires) {
+		return cached.(resolution).digest, nil
+	}
+
+	registryClient, err := newClient()
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := registryClient.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	resolved.Store(ref.String(), resolution{digest: digest, expires: time.Now().Add(resolveTTL)})
+
+	return digest, nil
+}
+
+// verify verifies the signature of the image with the given digest against the public key.
+func verify(
+	ctx context.Context,
+	newClient func() (*registry.Client, error),
+	ref registry.Reference,
+	digest, publicKey string,
+) error {
+	fingerprint := sha256.Sum256([]byte(publicKey))
+	cacheKey := hex.EncodeToString(fingerprint[:]) + "/" + ref.Name() + "@" + digest
+
+	if _, ok := verified.Load(cacheKey); ok {
+		return nil
+	}
+
+	key, err := registry.ParsePublicKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("unable to parse image verification public key, %w", err)
+	}
+
+	registryClient, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := registryClient.VerifySignature(ctx, ref, digest, key); err != nil {
+		if errors.Is(err, registry.ErrUnsigned) || errors.Is(err, registry.ErrInvalidSignature) {
+			return &VerificationError{Image: ref.WithDigest(digest).String(), Err: err}
+		}
+
+		return err
+	}
+
+	verified.Store(cacheKey, struct{}{})
+
+	return nil
+}
+
+// setCredentials reads the registry credentials from the image pull secrets of the collection in
+// the given namespace.  Secrets which do not exist are skipped, as the images they are meant for
+// may well be public.
+func setCredentials(
+	ctx context.Context,
+	reconciler workload.Reconciler,
+	registryClient *registry.Client,
+	namespace string,
+	collection *setupv1alpha1.SupportServices,
+) error {
+	for _, pullSecret := range collection.Spec.ImagePullSecrets {
+		secret := &corev1.Secret{}
+
+		if err := reconciler.Get(ctx, types.NamespacedName{Name: pullSecret.Name, Namespace: namespace}, secret); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+
+			return fmt.Errorf("unable to get image pull secret %s/%s, %w", namespace, pullSecret.Name, err)
+		}
+
+		if err := registryClient.Keychain.AddDockerConfig(secret.Data[corev1.DockerConfigJsonKey]); err != nil {
+			return fmt.Errorf("unable to read image pull secret %s/%s, %w", namespace, pullSecret.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// DegradedCondition is the condition which is set on a component when any of its images fail
+// signature verification.
+const DegradedCondition = "Degraded"
+
+// VerifyImagesPhase generates the child resources of a workload ahead of creating them, which
+// pins and verifies their images.  When an image fails verification, the workload is marked as
+// degraded and the phase fails so that none of its resources are rolled out.
+func VerifyImagesPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	collection, ok := req.Collection.(*setupv1alpha1.SupportServices)
+	if !ok || !collection.VerifiesImages() {
+		return true, nil
+	}
+
+	_, err := r.GetResources(req)
+
+	var verificationErr *VerificationError
+
+	switch {
+	case errors.As(err, &verificationErr):
+		if updateErr := setDegradedCondition(r, req, status.PhaseStateFailed, err.Error()); updateErr != nil {
+			return false, updateErr
+		}
+
+		return false, err
+	case err != nil:
+		return false, fmt.Errorf("unable to retrieve resources, %w", err)
+	}
+
+	// only clear the condition if it was previously set, to avoid cluttering the status
+	for _, condition := range req.Workload.GetPhaseConditions() {
+		if condition.Phase == DegradedCondition && condition.State == status.PhaseStateFailed {
+			return true, setDegradedCondition(r, req, status.PhaseStateComplete, "All images passed verification")
+		}
+	}
+
+	return true, nil
+}
+
+// setDegradedCondition sets the degraded condition on the workload.
+func setDegradedCondition(r workload.Reconciler, req *workload.Request, state status.PhaseState, message string) error {
+	req.Workload.SetPhaseCondition(&status.PhaseCondition{
+		State:        state,
+		Phase:        DegradedCondition,
+		Message:      message,
+		LastModified: time.Now().UTC().String(),
+	})
+
+	if err := r.Status().Update(req.Context, req.Workload); err != nil {
+		return fmt.Errorf("unable to update degraded condition for %s, %w", req.Workload.GetWorkloadGVK().Kind, err)
+	}
+
+	return nil
+}
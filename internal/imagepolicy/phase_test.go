@@ -0,0 +1,280 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/imagepolicy"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+	"github.com/nukleros/support-services-operator/internal/registry/registrytest"
+)
+
+// unimplemented satisfies the methods of a reconciler which are not used by the phase.
+type unimplemented struct {
+	workload.Reconciler
+}
+
+// reconciler is a reconciler which generates a single deployment running the given image and
+// applies the image policy of the collection to it.
+type reconciler struct {
+	unimplemented
+	client.Client
+
+	image string
+}
+
+func (r *reconciler) GetResources(req *workload.Request) ([]client.Object, error) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "secret-reloader",
+			"namespace": "nukleros-secrets-system",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "secret-reloader", "image": r.image},
+					},
+				},
+			},
+		},
+	}}
+
+	collection, _ := req.Collection.(*setupv1alpha1.SupportServices)
+
+	if err := imagepolicy.Apply(r, req, deployment, collection); err != nil {
+		return nil, err
+	}
+
+	return []client.Object{deployment}, nil
+}
+
+func newRequest(t *testing.T, host, image, publicKey string) (*reconciler, *workload.Request) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := setupv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add scheme, %v", err)
+	}
+
+	collection := &setupv1alpha1.SupportServices{
+		ObjectMeta: metav1.ObjectMeta{Name: "supportservices-sample"},
+		Spec: setupv1alpha1.SupportServicesSpec{
+			ImageVerification: setupv1alpha1.SupportServicesSpecImageVerification{
+				PublicKey:          publicKey,
+				InsecureRegistries: []string{host},
+			},
+		},
+	}
+
+	r := &reconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(collection).Build(),
+		image:  image,
+	}
+
+	return r, &workload.Request{
+		Context:    context.Background(),
+		Workload:   collection,
+		Collection: collection,
+		Log:        logr.Discard(),
+	}
+}
+
+func degraded(req *workload.Request) *status.PhaseCondition {
+	for _, condition := range req.Workload.GetPhaseConditions() {
+		if condition.Phase == imagepolicy.DegradedCondition {
+			return condition
+		}
+	}
+
+	return nil
+}
+
+func TestVerifyImagesPhase(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	signingKey, publicKey := registrytest.GenerateKey(t)
+	otherKey, _ := registrytest.GenerateKey(t)
+
+	signed := reg.PushImage("nukleros/signed", "v1", "signed")
+	reg.Sign("nukleros/signed", signed, signed, signingKey)
+
+	reg.PushImage("nukleros/unsigned", "v1", "unsigned")
+
+	wrongKey := reg.PushImage("nukleros/wrong-key", "v1", "wrong-key")
+	reg.Sign("nukleros/wrong-key", wrongKey, wrongKey, otherKey)
+
+	for _, tt := range []struct {
+		name    string
+		image   string
+		proceed bool
+	}{
+		{name: "signed image", image: reg.Host + "/nukleros/signed:v1", proceed: true},
+		{name: "unsigned image", image: reg.Host + "/nukleros/unsigned:v1"},
+		{name: "image signed with another key", image: reg.Host + "/nukleros/wrong-key:v1"},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, req := newRequest(t, reg.Host, tt.image, publicKey)
+
+			proceed, err := imagepolicy.VerifyImagesPhase(r, req)
+			if proceed != tt.proceed {
+				t.Fatalf("VerifyImagesPhase() proceed = %t, want %t", proceed, tt.proceed)
+			}
+
+			if tt.proceed {
+				if err != nil {
+					t.Fatalf("VerifyImagesPhase() error = %v", err)
+				}
+
+				if condition := degraded(req); condition != nil {
+					t.Errorf("VerifyImagesPhase() set degraded condition %+v", condition)
+				}
+
+				return
+			}
+
+			var verificationErr *imagepolicy.VerificationError
+			if !errors.As(err, &verificationErr) {
+				t.Fatalf("VerifyImagesPhase() error = %v, want a verification error", err)
+			}
+
+			condition := degraded(req)
+			if condition == nil || condition.State != status.PhaseStateFailed {
+				t.Fatalf("VerifyImagesPhase() degraded condition = %+v, want failed", condition)
+			}
+
+			// the degraded condition is persisted on the workload
+			persisted := &setupv1alpha1.SupportServices{}
+			if err := r.Get(req.Context, client.ObjectKeyFromObject(req.Workload), persisted); err != nil {
+				t.Fatalf("unable to get workload, %v", err)
+			}
+
+			if len(persisted.Status.Conditions) == 0 {
+				t.Errorf("degraded condition was not persisted")
+			}
+		})
+	}
+}
+
+func TestApplyPinsImages(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	digest := reg.PushImage("nukleros/pinned", "v1", "pinned")
+
+	r, req := newRequest(t, reg.Host, reg.Host+"/nukleros/pinned:v1", "")
+
+	collection, _ := req.Collection.(*setupv1alpha1.SupportServices)
+	collection.Spec.ImageVerification.ResolveDigests = true
+
+	resources, err := r.GetResources(req)
+	if err != nil {
+		t.Fatalf("GetResources() error = %v", err)
+	}
+
+	container, err := podtemplate.Container(resources[0], "secret-reloader")
+	if err != nil {
+		t.Fatalf("unable to get container, %v", err)
+	}
+
+	if got, want := container["image"], reg.Host+"/nukleros/pinned:v1@"+digest; got != want {
+		t.Errorf("Apply() image = %v, want %s", got, want)
+	}
+}
+
+func TestApplyCachesResolvedDigests(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	digest := reg.PushImage("nukleros/cached", "v1", "cached")
+
+	r, req := newRequest(t, reg.Host, reg.Host+"/nukleros/cached:v1", "")
+
+	collection, _ := req.Collection.(*setupv1alpha1.SupportServices)
+	collection.Spec.ImageVerification.ResolveDigests = true
+
+	for i, content := range []string{"cached", "moved"} {
+		// the tag is moved to another image, which is only picked up once the cache expires
+		if i > 0 {
+			reg.PushImage("nukleros/cached", "v1", content)
+		}
+
+		resources, err := r.GetResources(req)
+		if err != nil {
+			t.Fatalf("GetResources() error = %v", err)
+		}
+
+		container, err := podtemplate.Container(resources[0], "secret-reloader")
+		if err != nil {
+			t.Fatalf("unable to get container, %v", err)
+		}
+
+		if got, want := container["image"], reg.Host+"/nukleros/cached:v1@"+digest; got != want {
+			t.Errorf("Apply() image = %v, want the cached %s", got, want)
+		}
+	}
+}
+
+func TestApplyPinsRedisImages(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	digest := reg.PushImage("nukleros/redis", "v7.0.5", "redis")
+
+	r, req := newRequest(t, reg.Host, "", "")
+
+	collection, _ := req.Collection.(*setupv1alpha1.SupportServices)
+	collection.Spec.ImageVerification.ResolveDigests = true
+
+	redis := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "redis.redis.opstreelabs.in/v1beta1",
+		"kind":       "Redis",
+		"metadata":   map[string]interface{}{"name": "redisinstance-sample", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"kubernetesConfig": map[string]interface{}{"image": reg.Host + "/nukleros/redis:v7.0.5"},
+		},
+	}}
+
+	if err := imagepolicy.Apply(r, req, redis, collection); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	image, _, _ := unstructured.NestedString(redis.Object, "spec", "kubernetesConfig", "image")
+	if want := reg.Host + "/nukleros/redis:v7.0.5@" + digest; image != want {
+		t.Errorf("Apply() image = %s, want %s", image, want)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meshinjection
+
+import (
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+// InjectionLabel is the label which records the mesh that a namespace was joined to by a service
+// mesh component.  Only namespaces which carry the label are removed from the mesh again.
+const InjectionLabel = "platform.nukleros.io/mesh-injection"
+
+// systemNamespaces are the namespaces which never join the mesh.
+var systemNamespaces = map[string]bool{
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;update;patch
+
+// InjectionPhase joins the namespaces which are selected by the injection namespace selector of a
+// service mesh component to the mesh and removes the namespaces which are no longer selected from
+// it.  Namespaces are annotated for proxy injection by linkerd or labeled for the ambient mesh of
+// istio.  When the component is deleted, all namespaces which it joined are removed from the
+// mesh.  Pods which already run in a namespace are only injected by linkerd once they are
+// restarted.
+func InjectionPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.ServiceMeshComponent)
+	if !ok {
+		return true, nil
+	}
+
+	selector, err := namespaceSelector(component)
+	if err != nil {
+		return false, err
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(req.Context, namespaces); err != nil {
+		return false, fmt.Errorf("unable to list namespaces, %w", err)
+	}
+
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		original := namespace.DeepCopy()
+
+		var changed bool
+
+		if selected(component, selector, namespace) {
+			changed = join(namespace, component.Spec.Mesh.Type)
+		} else {
+			changed = leave(namespace)
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := r.Patch(req.Context, namespace, client.MergeFrom(original)); err != nil {
+			return false, fmt.Errorf("unable to update mesh injection of namespace %s, %w", namespace.Name, err)
+		}
+	}
+
+	return true, nil
+}
+
+// namespaceSelector returns the selector of the namespaces which join the mesh.  No namespaces
+// are selected when the component does not set a selector or is being deleted.
+func namespaceSelector(component *platformv1alpha1.ServiceMeshComponent) (labels.Selector, error) {
+	if component.Spec.Injection.NamespaceSelector == nil || !component.GetDeletionTimestamp().IsZero() {
+		return labels.Nothing(), nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(component.Spec.Injection.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid injection namespace selector, %w", err)
+	}
+
+	return selector, nil
+}
+
+// selected returns whether a namespace joins the mesh.
+func selected(
+	component *platformv1alpha1.ServiceMeshComponent,
+	selector labels.Selector,
+	namespace *corev1.Namespace,
+) bool {
+	if systemNamespaces[namespace.Name] || namespace.Name == component.Spec.Namespace {
+		return false
+	}
+
+	return selector.Matches(labels.Set(namespace.Labels))
+}
+
+// join marks a namespace for the given mesh and returns whether the namespace changed.  The marker
+// of a mesh which the namespace was previously joined to is removed.
+func join(namespace *corev1.Namespace, mesh string) bool {
+	if namespace.Labels[InjectionLabel] == mesh && marked(namespace, mesh) {
+		return false
+	}
+
+	leave(namespace)
+
+	if namespace.Labels == nil {
+		namespace.Labels = map[string]string{}
+	}
+
+	namespace.Labels[InjectionLabel] = mesh
+
+	switch mesh {
+	case "istio":
+		namespace.Labels["istio.io/dataplane-mode"] = "ambient"
+	default:
+		if namespace.Annotations == nil {
+			namespace.Annotations = map[string]string{}
+		}
+
+		namespace.Annotations["linkerd.io/inject"] = "enabled"
+	}
+
+	return true
+}
+
+// leave removes the marker of the mesh which a namespace was joined to and returns whether the
+// namespace changed.
+func leave(namespace *corev1.Namespace) bool {
+	mesh, ok := namespace.Labels[InjectionLabel]
+	if !ok {
+		return false
+	}
+
+	delete(namespace.Labels, InjectionLabel)
+
+	switch mesh {
+	case "istio":
+		delete(namespace.Labels, "istio.io/dataplane-mode")
+	default:
+		delete(namespace.Annotations, "linkerd.io/inject")
+	}
+
+	return true
+}
+
+// marked returns whether a namespace carries the marker of the given mesh.
+func marked(namespace *corev1.Namespace, mesh string) bool {
+	if mesh == "istio" {
+		return namespace.Labels["istio.io/dataplane-mode"] == "ambient"
+	}
+
+	return namespace.Annotations["linkerd.io/inject"] == "enabled"
+}
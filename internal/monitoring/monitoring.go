@@ -0,0 +1,44 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+)
+
+// Served returns whether the kind of a generated object is served by the cluster.  The
+// ServiceMonitor, PodMonitor and PrometheusRule resources of the components are only created when
+// the prometheus-operator custom resource definitions are installed, so that monitoring may be
+// enabled before, or without, deploying the monitoring component.
+func Served(reconciler workload.Reconciler, object client.Object) (bool, error) {
+	gvk := object.GetObjectKind().GroupVersionKind()
+
+	if _, err := reconciler.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to determine whether %s is served, %w", gvk.Kind, err)
+	}
+
+	return true, nil
+}
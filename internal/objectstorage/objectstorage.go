@@ -0,0 +1,68 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstorage
+
+import (
+	"errors"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+)
+
+var (
+	ErrMissingObjectStorage   = errors.New("unable to find object storage component")
+	ErrMissingStorageBucket   = errors.New("bucket is not declared by object storage component")
+	ErrUnpublishedCredentials = errors.New("bucket credentials are not published in namespace")
+)
+
+// +kubebuilder:rbac:groups=platform.addons.nukleros.io,resources=objectstoragecomponents,verbs=get;list;watch
+
+// Get returns the ObjectStorageComponent with the given name, which another component references
+// to store its data in.  The component must declare the bucket and publish its credentials in the
+// namespace of the referencing component.
+func Get(
+	reconciler workload.Reconciler, req *workload.Request,
+	name, bucket, namespace string,
+) (*platformv1alpha1.ObjectStorageComponent, error) {
+	component := &platformv1alpha1.ObjectStorageComponent{}
+
+	if err := reconciler.Get(req.Context, types.NamespacedName{Name: name}, component); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, fmt.Errorf("%w %s", ErrMissingObjectStorage, name)
+		}
+
+		return nil, fmt.Errorf("unable to get object storage component %s, %w", name, err)
+	}
+
+	declared := component.Bucket(bucket)
+	if declared == nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrMissingStorageBucket, name, bucket)
+	}
+
+	for _, published := range component.CredentialsNamespaces(declared) {
+		if published == namespace {
+			return component, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %s: %s", ErrUnpublishedCredentials, namespace, bucket)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstorage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/objectstorage"
+)
+
+// unimplemented satisfies the methods of a reconciler which are not used by the lookup.
+type unimplemented struct {
+	workload.Reconciler
+}
+
+type reconciler struct {
+	unimplemented
+	client.Client
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := platformv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add scheme, %v", err)
+	}
+
+	component := &platformv1alpha1.ObjectStorageComponent{
+		ObjectMeta: metav1.ObjectMeta{Name: "objectstoragecomponent-sample"},
+		Spec: platformv1alpha1.ObjectStorageComponentSpec{
+			Namespace: "nukleros-object-storage-system",
+			Buckets: []platformv1alpha1.ObjectStorageComponentBucket{
+				{Name: "logs", Namespaces: []string{"nukleros-logging-system"}},
+			},
+		},
+	}
+
+	r := &reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(component).Build()}
+	req := &workload.Request{Context: context.Background(), Log: logr.Discard()}
+
+	for _, tt := range []struct {
+		name      string
+		component string
+		bucket    string
+		namespace string
+		err       error
+	}{
+		{name: "published bucket", component: component.Name, bucket: "logs", namespace: "nukleros-logging-system"},
+		{name: "missing component", component: "other", bucket: "logs", namespace: "nukleros-logging-system", err: objectstorage.ErrMissingObjectStorage},
+		{name: "undeclared bucket", component: component.Name, bucket: "backups", namespace: "nukleros-logging-system", err: objectstorage.ErrMissingStorageBucket},
+		{name: "unpublished credentials", component: component.Name, bucket: "logs", namespace: "nukleros-database-system", err: objectstorage.ErrUnpublishedCredentials},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := objectstorage.Get(r, req, tt.component, tt.bucket, tt.namespace)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Get() error = %v, want %v", err, tt.err)
+			}
+
+			if tt.err == nil && got.Name != component.Name {
+				t.Errorf("Get() = %s, want %s", got.Name, component.Name)
+			}
+		})
+	}
+}
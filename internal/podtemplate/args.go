@@ -0,0 +1,133 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SetArg sets a flag in the args of the named container in a generated workload object.  An
+// existing value of the flag is replaced, otherwise the flag is appended.
+func SetArg(object client.Object, name, flag, value string) error {
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	args, _ := container["args"].([]interface{})
+
+	for i := range args {
+		if arg, ok := args[i].(string); ok && strings.HasPrefix(arg, flag+"=") {
+			args[i] = flag + "=" + value
+
+			return nil
+		}
+	}
+
+	container["args"] = append(args, flag+"="+value)
+
+	return nil
+}
+
+// SetArgs sets a flag which may be repeated in the args of the named container in a generated
+// workload object.  Existing values of the flag are replaced by the given values.
+func SetArgs(object client.Object, name, flag string, values []string) error {
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	existing, _ := container["args"].([]interface{})
+
+	args := []interface{}{}
+
+	for i := range existing {
+		if arg, ok := existing[i].(string); ok && strings.HasPrefix(arg, flag+"=") {
+			continue
+		}
+
+		args = append(args, existing[i])
+	}
+
+	for _, value := range values {
+		args = append(args, flag+"="+value)
+	}
+
+	container["args"] = args
+
+	return nil
+}
+
+// SetEnv sets an environment variable of the named container in a generated workload object.
+// An existing value of the variable is replaced, otherwise the variable is appended.
+func SetEnv(object client.Object, name, variable, value string) error {
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	env, _ := container["env"].([]interface{})
+
+	for i := range env {
+		if existing, ok := env[i].(map[string]interface{}); ok && existing["name"] == variable {
+			env[i] = map[string]interface{}{"name": variable, "value": value}
+
+			return nil
+		}
+	}
+
+	container["env"] = append(env, map[string]interface{}{"name": variable, "value": value})
+
+	return nil
+}
+
+// SetEnvFromSecret sets an environment variable of the named container in a generated workload
+// object to a key of a secret.  An existing value of the variable is replaced, otherwise the
+// variable is appended.
+func SetEnvFromSecret(object client.Object, name, variable, secret, key string, optional bool) error {
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	value := map[string]interface{}{
+		"name": variable,
+		"valueFrom": map[string]interface{}{
+			"secretKeyRef": map[string]interface{}{
+				"name":     secret,
+				"key":      key,
+				"optional": optional,
+			},
+		},
+	}
+
+	env, _ := container["env"].([]interface{})
+
+	for i := range env {
+		if existing, ok := env[i].(map[string]interface{}); ok && existing["name"] == variable {
+			env[i] = value
+
+			return nil
+		}
+	}
+
+	container["env"] = append(env, value)
+
+	return nil
+}
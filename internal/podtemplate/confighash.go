@@ -0,0 +1,70 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigHashAnnotation is the pod template annotation which holds the hash of the configuration of
+// a workload.  The pods of the workload are rolled out whenever the hash, and so the configuration,
+// changes.
+const ConfigHashAnnotation = "platform.nukleros.io/config-hash"
+
+// SetConfigHash annotates the pod template of a generated workload object with the hash of its
+// configuration data.
+func SetConfigHash(object client.Object, data map[string]string) error {
+	metadata, err := Metadata(object)
+	if err != nil {
+		return err
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+
+	annotations[ConfigHashAnnotation] = HashConfig(data)
+
+	return nil
+}
+
+// HashConfig returns a hash of configuration data which does not depend on the order of its keys.
+func HashConfig(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	hash := sha256.New()
+
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(data[key]))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/registry"
+)
+
+// SetImageRegistry rewrites the images of all containers in a generated workload object to use
+// the registry mirrors of the collection and adds the image pull secrets of the collection.  When
+// neither the workload nor the collection has image pull secrets, they are explicitly set to null
+// so that the merge patch which updates the workload removes previously added secrets.
+func SetImageRegistry(object client.Object, collection *setupv1alpha1.SupportServices) error {
+	podSpec, err := Spec(object)
+	if err != nil {
+		return err
+	}
+
+	containers, err := Containers(object)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		if image, ok := container["image"].(string); ok {
+			container["image"] = MirrorImage(image, collection.Spec.RegistryMirrors)
+		}
+	}
+
+	pullSecrets, _ := podSpec["imagePullSecrets"].([]interface{})
+
+	if len(pullSecrets) == 0 && len(collection.Spec.ImagePullSecrets) == 0 {
+		podSpec["imagePullSecrets"] = nil
+
+		return nil
+	}
+
+	for _, secret := range collection.Spec.ImagePullSecrets {
+		pullSecrets = append(pullSecrets, map[string]interface{}{"name": secret.Name})
+	}
+
+	podSpec["imagePullSecrets"] = pullSecrets
+
+	return nil
+}
+
+// MirrorImage returns the image rewritten to use the mirror of its registry, if one exists in
+// the given mirrors.  Mirrors are keyed by the source registry (e.g. quay.io) and images which
+// do not specify a registry are considered to belong to docker.io.
+func MirrorImage(image string, mirrors map[string]string) string {
+	if len(mirrors) == 0 {
+		return image
+	}
+
+	source, repository := registry.SplitRegistry(image)
+
+	mirror, ok := mirrors[source]
+	if !ok {
+		return image
+	}
+
+	return strings.TrimSuffix(mirror, "/") + "/" + repository
+}
+
+// SetImageDigest pins the image of the named container in a generated workload object to the
+// given digest.  The tag is kept alongside the digest for readability, although it is ignored
+// by the container runtime.  An empty digest leaves the image untouched.
+func SetImageDigest(object client.Object, name, digest string) error {
+	if digest == "" {
+		return nil
+	}
+
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	PinImage(container, digest)
+
+	return nil
+}
+
+// PinImage pins the image of a container, as returned by Containers, to the given digest.
+func PinImage(container map[string]interface{}, digest string) {
+	image, _ := container["image"].(string)
+
+	// strip any existing digest so that the image is not pinned twice
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+
+	container["image"] = image + "@" + digest
+}
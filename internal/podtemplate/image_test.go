@@ -0,0 +1,72 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate_test
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+func TestSetImageRegistryRemovesPullSecrets(t *testing.T) {
+	t.Parallel()
+
+	collection := &setupv1alpha1.SupportServices{}
+	collection.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "registry-credentials"}}
+
+	// the live deployment was created with the image pull secrets of the collection
+	actual := generatedDeployment()
+
+	desired := generatedDeployment()
+	if err := podtemplate.SetImageRegistry(desired, collection); err != nil {
+		t.Fatalf("SetImageRegistry() error = %v", err)
+	}
+
+	podSpec := update(t, desired, actual)
+
+	want := []interface{}{map[string]interface{}{"name": "registry-credentials"}}
+	if got := podSpec["imagePullSecrets"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("imagePullSecrets = %v, want %v", got, want)
+	}
+
+	// the image pull secrets are removed from the collection
+	collection.Spec.ImagePullSecrets = nil
+
+	desired = generatedDeployment()
+	if err := podtemplate.SetImageRegistry(desired, collection); err != nil {
+		t.Fatalf("SetImageRegistry() error = %v", err)
+	}
+
+	desiredSpec, err := podtemplate.Spec(desired)
+	if err != nil {
+		t.Fatalf("unable to get pod spec, %v", err)
+	}
+
+	if value, ok := desiredSpec["imagePullSecrets"]; !ok || value != nil {
+		t.Errorf("desired imagePullSecrets = %v, want null", value)
+	}
+
+	podSpec = update(t, desired, actual)
+
+	if got, ok := podSpec["imagePullSecrets"]; ok {
+		t.Errorf("imagePullSecrets = %v, want them to be removed", got)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ErrUnsupportedObject = errors.New("object is not a supported workload")
+	ErrMissingContainer  = errors.New("unable to find container")
+)
+
+// Spec returns the pod spec from the pod template of a generated workload object.  The
+// returned map is not a copy, so any changes to it are reflected in the workload object.
+//
+// NOTE: generated objects contain values which cannot be deep copied by the unstructured
+// helpers (e.g. int), so all access must go through the NoCopy variants.
+func Spec(object client.Object) (map[string]interface{}, error) {
+	return templateField(object, "spec")
+}
+
+// Metadata returns the metadata from the pod template of a generated workload object.  As with
+// Spec, the returned map is not a copy.
+func Metadata(object client.Object) (map[string]interface{}, error) {
+	return templateField(object, "metadata")
+}
+
+// templateField returns the named field of the pod template of a generated workload object.
+//
+// The custom resources of prometheus-operator do not have a pod template.  Instead, they embed the
+// pod spec fields in their spec and merge the containers of their spec into the containers which
+// the operator generates, so their spec and pod metadata stand in for those of the pod template.
+func templateField(object client.Object, field string) (map[string]interface{}, error) {
+	workload, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("%w; %s is not unstructured", ErrUnsupportedObject, object.GetName())
+	}
+
+	var path []string
+
+	switch workload.GetKind() {
+	case "Deployment", "DaemonSet", "StatefulSet", "Job":
+		path = []string{"spec", "template", field}
+	case "CronJob":
+		path = []string{"spec", "jobTemplate", "spec", "template", field}
+	case "Prometheus", "Alertmanager":
+		path = map[string][]string{
+			"spec":     {"spec"},
+			"metadata": {"spec", "podMetadata"},
+		}[field]
+	default:
+		return nil, fmt.Errorf("%w; unsupported kind %s", ErrUnsupportedObject, workload.GetKind())
+	}
+
+	value, found, err := unstructured.NestedFieldNoCopy(workload.Object, path...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pod %s for %s %s, %w", field, workload.GetKind(), workload.GetName(), err)
+	}
+
+	fieldValue, ok := value.(map[string]interface{})
+	if !found || !ok {
+		return nil, fmt.Errorf("%w; missing pod %s for %s %s", ErrUnsupportedObject, field, workload.GetKind(), workload.GetName())
+	}
+
+	return fieldValue, nil
+}
+
+// Containers returns all containers, including init containers, from the pod template of
+// a generated workload object.
+//
+// The custom resources of the redis operator do not have a pod spec either.  They configure the
+// image of the redis servers in their kubernetes config and that of the metrics exporter in their
+// exporter config, so those stand in for the containers.  They are not named, so they can only be
+// modified through the returned maps.
+func Containers(object client.Object) ([]map[string]interface{}, error) {
+	if workload, ok := object.(*unstructured.Unstructured); ok {
+		switch workload.GetKind() {
+		case "Redis", "RedisCluster", "RedisReplication", "RedisSentinel":
+			return redisContainers(workload), nil
+		}
+	}
+
+	podSpec, err := Spec(object)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := []map[string]interface{}{}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		list, ok := podSpec[field].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for i := range list {
+			if container, ok := list[i].(map[string]interface{}); ok {
+				containers = append(containers, container)
+			}
+		}
+	}
+
+	return containers, nil
+}
+
+// redisContainers returns the configs of a redis operator custom resource which hold an image.
+func redisContainers(redis *unstructured.Unstructured) []map[string]interface{} {
+	containers := []map[string]interface{}{}
+
+	for _, field := range []string{"kubernetesConfig", "redisExporter"} {
+		value, _, _ := unstructured.NestedFieldNoCopy(redis.Object, "spec", field)
+
+		if container, ok := value.(map[string]interface{}); ok && container["image"] != nil {
+			containers = append(containers, container)
+		}
+	}
+
+	return containers
+}
+
+// Container returns the container with the given name from the pod template of a generated
+// workload object.
+func Container(object client.Object, name string) (map[string]interface{}, error) {
+	containers, err := Containers(object)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, container := range containers {
+		if container["name"] == name {
+			return container, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w; %s in %s", ErrMissingContainer, name, object.GetName())
+}
+
+// RemoveContainer removes the container with the given name from the pod template of a generated
+// workload object, e.g. a sidecar which is only needed when an optional feature is enabled.
+func RemoveContainer(object client.Object, name string) error {
+	podSpec, err := Spec(object)
+	if err != nil {
+		return err
+	}
+
+	list, ok := podSpec["containers"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	containers := make([]interface{}, 0, len(list))
+
+	for i := range list {
+		if container, ok := list[i].(map[string]interface{}); ok && container["name"] == name {
+			continue
+		}
+
+		containers = append(containers, list[i])
+	}
+
+	podSpec["containers"] = containers
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SetReplicas sets the number of replicas of a generated workload object.  Only deployments,
+// stateful sets and the prometheus and alertmanager custom resources have replicas, so other
+// objects are left as is.
+func SetReplicas(object client.Object, replicas int) error {
+	spec, err := replicatedSpec(object)
+	if err != nil || spec == nil {
+		return err
+	}
+
+	spec["replicas"] = replicas
+
+	return nil
+}
+
+// UnsetReplicas removes the number of replicas from a generated workload object, e.g. when its
+// replicas are managed by an autoscaler.  Updates are merged into the existing object, so its
+// current number of replicas is kept.
+func UnsetReplicas(object client.Object) error {
+	spec, err := replicatedSpec(object)
+	if err != nil || spec == nil {
+		return err
+	}
+
+	delete(spec, "replicas")
+
+	return nil
+}
+
+// replicatedSpec returns the spec of a generated workload object which has replicas, or nil if
+// the object does not have replicas.
+func replicatedSpec(object client.Object) (map[string]interface{}, error) {
+	workload, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("%w; %s is not unstructured", ErrUnsupportedObject, object.GetName())
+	}
+
+	switch workload.GetKind() {
+	case "Deployment", "StatefulSet", "Prometheus", "Alertmanager":
+	default:
+		return nil, nil
+	}
+
+	spec, ok := workload.Object["spec"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w; missing spec for %s %s", ErrUnsupportedObject, workload.GetKind(), workload.GetName())
+	}
+
+	return spec, nil
+}
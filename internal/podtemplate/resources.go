@@ -0,0 +1,190 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ScaleResources scales the resource requests and limits of all containers in a generated workload
+// object by the given percentage.
+func ScaleResources(object client.Object, percent int) error {
+	if percent == 100 {
+		return nil
+	}
+
+	containers, err := Containers(object)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		resources, ok := container["resources"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := ScaleRequirements(resources, percent); err != nil {
+			return fmt.Errorf("unable to scale resources of container %s, %w", container["name"], err)
+		}
+	}
+
+	return nil
+}
+
+// ScaleRequirements scales the requests and limits of the resources field of a generated object
+// by the given percentage, e.g. the resources of a pool of a minio tenant which are not part of
+// a pod template.
+func ScaleRequirements(resources map[string]interface{}, percent int) error {
+	if percent == 100 {
+		return nil
+	}
+
+	for _, field := range []string{"requests", "limits"} {
+		quantities, ok := resources[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for name, value := range quantities {
+			quantity, err := parseQuantity(value)
+			if err != nil {
+				return fmt.Errorf("unable to scale %s %s, %w", name, field, err)
+			}
+
+			quantities[name] = scaleQuantity(quantity, percent).String()
+		}
+	}
+
+	return nil
+}
+
+// SetResources sets the resource requests and limits of the named container in a generated
+// workload object.  Only the requests and limits which are specified are set, while the others
+// keep their defaults.  Defaults which conflict with the specified values are adjusted so that
+// no request exceeds its limit.
+func SetResources(object client.Object, name string, requirements corev1.ResourceRequirements) error {
+	if len(requirements.Requests) == 0 && len(requirements.Limits) == 0 {
+		return nil
+	}
+
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	resources, ok := container["resources"].(map[string]interface{})
+	if !ok {
+		resources = map[string]interface{}{}
+		container["resources"] = resources
+	}
+
+	if err := SetRequirements(resources, requirements); err != nil {
+		return fmt.Errorf("unable to set resources of container %s, %w", name, err)
+	}
+
+	return nil
+}
+
+// SetRequirements sets the requests and limits of the resources field of a generated object in the
+// same way as SetResources sets those of a container.
+func SetRequirements(resources map[string]interface{}, requirements corev1.ResourceRequirements) error {
+	requests := setQuantities(resources, "requests", requirements.Requests)
+	limits := setQuantities(resources, "limits", requirements.Limits)
+
+	for resourceName, limitValue := range limits {
+		requestValue, ok := requests[resourceName]
+		if !ok {
+			continue
+		}
+
+		request, err := parseQuantity(requestValue)
+		if err != nil {
+			return fmt.Errorf("unable to set %s requests, %w", resourceName, err)
+		}
+
+		limit, err := parseQuantity(limitValue)
+		if err != nil {
+			return fmt.Errorf("unable to set %s limits, %w", resourceName, err)
+		}
+
+		if request.Cmp(limit) <= 0 {
+			continue
+		}
+
+		// prefer the value which was specified over the default.  If both were specified, they
+		// are left as is so that the invalid requirements are reported when they are applied.
+		_, requestSpecified := requirements.Requests[corev1.ResourceName(resourceName)]
+		_, limitSpecified := requirements.Limits[corev1.ResourceName(resourceName)]
+
+		switch {
+		case requestSpecified && !limitSpecified:
+			limits[resourceName] = request.String()
+		case limitSpecified && !requestSpecified:
+			requests[resourceName] = limit.String()
+		}
+	}
+
+	return nil
+}
+
+// setQuantities sets the given quantities on the requests or limits field of the resources of a
+// container and returns the resulting field.
+func setQuantities(resources map[string]interface{}, field string, values corev1.ResourceList) map[string]interface{} {
+	quantities, ok := resources[field].(map[string]interface{})
+	if !ok {
+		quantities = map[string]interface{}{}
+	}
+
+	for name, value := range values {
+		quantities[string(name)] = value.String()
+	}
+
+	if len(quantities) > 0 {
+		resources[field] = quantities
+	}
+
+	return quantities
+}
+
+// parseQuantity parses a quantity from a generated workload object.
+func parseQuantity(value interface{}) (resource.Quantity, error) {
+	switch value := value.(type) {
+	case string:
+		return resource.ParseQuantity(value)
+	case int:
+		return *resource.NewQuantity(int64(value), resource.DecimalSI), nil
+	case int64:
+		return *resource.NewQuantity(value, resource.DecimalSI), nil
+	default:
+		return resource.Quantity{}, fmt.Errorf("unsupported quantity %v", value)
+	}
+}
+
+// scaleQuantity scales a quantity by the given percentage, keeping its format.  Quantities are
+// scaled in milli units so that small cpu quantities remain accurate.
+func scaleQuantity(quantity resource.Quantity, percent int) *resource.Quantity {
+	if quantity.Format == resource.BinarySI {
+		return resource.NewQuantity(quantity.Value()*int64(percent)/100, resource.BinarySI)
+	}
+
+	return resource.NewMilliQuantity(quantity.MilliValue()*int64(percent)/100, quantity.Format)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// affinityTerms are the fields of each type of affinity.
+var affinityTerms = []string{
+	"requiredDuringSchedulingIgnoredDuringExecution",
+	"preferredDuringSchedulingIgnoredDuringExecution",
+}
+
+// SetScheduling applies the scheduling settings to the pod template of a generated workload object.
+// The node selector is added to the default node selector of the workload, while all other settings
+// replace those of the workload.
+func SetScheduling(object client.Object, scheduling setupv1alpha1.SchedulingSpec) error {
+	podSpec, err := Spec(object)
+	if err != nil {
+		return err
+	}
+
+	return SetSchedulingFields(podSpec, scheduling)
+}
+
+// SetSchedulingFields applies the scheduling settings to a generated object which holds the
+// scheduling fields of a pod spec, e.g. a pool of a minio tenant, in the same way as
+// SetScheduling applies them to a pod template.
+//
+// Resources are updated with a merge patch, which leaves fields that are missing from the patch in
+// place and merges nested objects.  Fields which are neither set nor defaulted by the workload are
+// therefore explicitly set to null so that removing a setting removes it from the workload, and the
+// affinity is completed with nulls so that it replaces the existing affinity as a whole.  Keys
+// which are removed from the node selector can only be determined from the existing workload, see
+// PruneNodeSelector.
+func SetSchedulingFields(podSpec map[string]interface{}, scheduling setupv1alpha1.SchedulingSpec) error {
+	var err error
+
+	if len(scheduling.NodeSelector) > 0 {
+		nodeSelector, ok := podSpec["nodeSelector"].(map[string]interface{})
+		if !ok {
+			nodeSelector = map[string]interface{}{}
+		}
+
+		for key, value := range scheduling.NodeSelector {
+			nodeSelector[key] = value
+		}
+
+		podSpec["nodeSelector"] = nodeSelector
+	}
+
+	if len(scheduling.Tolerations) > 0 {
+		tolerations := make([]interface{}, len(scheduling.Tolerations))
+
+		for i := range scheduling.Tolerations {
+			if tolerations[i], err = toUnstructured(&scheduling.Tolerations[i]); err != nil {
+				return err
+			}
+		}
+
+		podSpec["tolerations"] = tolerations
+	}
+
+	if scheduling.Affinity != nil {
+		if podSpec["affinity"], err = toUnstructured(scheduling.Affinity); err != nil {
+			return err
+		}
+	}
+
+	if scheduling.PriorityClassName != "" {
+		podSpec["priorityClassName"] = scheduling.PriorityClassName
+	}
+
+	if len(scheduling.TopologySpreadConstraints) > 0 {
+		constraints := make([]interface{}, len(scheduling.TopologySpreadConstraints))
+
+		for i := range scheduling.TopologySpreadConstraints {
+			if constraints[i], err = toUnstructured(&scheduling.TopologySpreadConstraints[i]); err != nil {
+				return err
+			}
+		}
+
+		podSpec["topologySpreadConstraints"] = constraints
+	}
+
+	for _, field := range []string{"nodeSelector", "tolerations", "affinity", "priorityClassName", "topologySpreadConstraints"} {
+		if _, found := podSpec[field]; !found {
+			podSpec[field] = nil
+		}
+	}
+
+	if affinity, ok := podSpec["affinity"].(map[string]interface{}); ok {
+		completeAffinity(affinity)
+	}
+
+	return nil
+}
+
+// completeAffinity sets the types of affinity and their terms which are missing from an affinity
+// to null.  The terms themselves are lists, which a merge patch replaces as a whole.
+func completeAffinity(affinity map[string]interface{}) {
+	for _, kind := range []string{"nodeAffinity", "podAffinity", "podAntiAffinity"} {
+		terms, ok := affinity[kind].(map[string]interface{})
+		if !ok {
+			affinity[kind] = nil
+
+			continue
+		}
+
+		for _, term := range affinityTerms {
+			if _, found := terms[term]; !found {
+				terms[term] = nil
+			}
+		}
+	}
+}
+
+// PruneNodeSelector sets the keys of the node selector of the existing workload object which are
+// missing from the node selector of the generated workload object to null, so that keys which are
+// removed from the node selector settings are removed from the workload.  It requires access to
+// the cluster, so it must only be called when reconciling.
+func PruneNodeSelector(ctx context.Context, reader client.Reader, object client.Object) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(object.GetObjectKind().GroupVersionKind())
+
+	if err := reader.Get(ctx, client.ObjectKeyFromObject(object), existing); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to get %s %s, %w", existing.GetKind(), object.GetName(), err)
+	}
+
+	existingSpec, err := Spec(existing)
+	if err != nil {
+		return err
+	}
+
+	existingSelector, _ := existingSpec["nodeSelector"].(map[string]interface{})
+	if len(existingSelector) == 0 {
+		return nil
+	}
+
+	podSpec, err := Spec(object)
+	if err != nil {
+		return err
+	}
+
+	nodeSelector, ok := podSpec["nodeSelector"].(map[string]interface{})
+	if !ok {
+		nodeSelector = map[string]interface{}{}
+	}
+
+	for key := range existingSelector {
+		if _, found := nodeSelector[key]; !found {
+			nodeSelector[key] = nil
+		}
+	}
+
+	podSpec["nodeSelector"] = nodeSelector
+
+	return nil
+}
+
+// toUnstructured converts a typed object into its unstructured representation.
+func toUnstructured(object interface{}) (map[string]interface{}, error) {
+	converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert %T to unstructured, %w", object, err)
+	}
+
+	return converted, nil
+}
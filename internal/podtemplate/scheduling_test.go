@@ -0,0 +1,239 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/podtemplate"
+)
+
+// defaultAffinity is the affinity of the generated deployment, which spreads its pods over nodes.
+func defaultAffinity() map[string]interface{} {
+	return map[string]interface{}{
+		"podAntiAffinity": map[string]interface{}{
+			"preferredDuringSchedulingIgnoredDuringExecution": []interface{}{
+				map[string]interface{}{
+					"weight": int64(100),
+					"podAffinityTerm": map[string]interface{}{
+						"topologyKey":   "kubernetes.io/hostname",
+						"labelSelector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "webhook"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// generatedDeployment returns a deployment as generated from the manifests, which select linux
+// nodes and spread their pods over nodes by default.
+func generatedDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "webhook",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"nodeSelector": map[string]interface{}{"kubernetes.io/os": "linux"},
+					"affinity":     defaultAffinity(),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "webhook", "image": "webhook:v1.0.0"},
+					},
+				},
+			},
+		},
+	}}
+}
+
+// scheduling returns scheduling settings which set every field.
+func scheduling() setupv1alpha1.SchedulingSpec {
+	return setupv1alpha1.SchedulingSpec{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/infra": "true"},
+		Tolerations: []corev1.Toleration{
+			{Key: "infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}},
+						},
+					}},
+				},
+			},
+		},
+		PriorityClassName: "infra-critical",
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.DoNotSchedule},
+		},
+	}
+}
+
+// update applies the desired deployment to the actual deployment with a merge patch, as the
+// resources of a workload are updated, and returns the pod spec of the result.
+func update(t *testing.T, desired, actual *unstructured.Unstructured) map[string]interface{} {
+	t.Helper()
+
+	original, err := json.Marshal(actual.Object)
+	if err != nil {
+		t.Fatalf("unable to marshal actual deployment, %v", err)
+	}
+
+	patch, err := json.Marshal(desired.Object)
+	if err != nil {
+		t.Fatalf("unable to marshal desired deployment, %v", err)
+	}
+
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		t.Fatalf("unable to apply patch, %v", err)
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		t.Fatalf("unable to unmarshal patched deployment, %v", err)
+	}
+
+	actual.Object = result
+
+	podSpec, err := podtemplate.Spec(actual)
+	if err != nil {
+		t.Fatalf("unable to get pod spec, %v", err)
+	}
+
+	return podSpec
+}
+
+// normalize returns the value as it is represented after a round trip through json.
+func normalize(t *testing.T, value interface{}) interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("unable to marshal value, %v", err)
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		t.Fatalf("unable to unmarshal value, %v", err)
+	}
+
+	return normalized
+}
+
+func TestSetSchedulingReplacesAffinity(t *testing.T) {
+	t.Parallel()
+
+	actual := generatedDeployment()
+	update(t, generatedDeployment(), actual)
+
+	desired := generatedDeployment()
+	if err := podtemplate.SetScheduling(desired, scheduling()); err != nil {
+		t.Fatalf("SetScheduling() error = %v", err)
+	}
+
+	podSpec := update(t, desired, actual)
+
+	affinity, _ := podSpec["affinity"].(map[string]interface{})
+
+	if got, ok := affinity["podAntiAffinity"]; ok {
+		t.Errorf("podAntiAffinity = %v, want the default affinity to be replaced", got)
+	}
+
+	if _, ok := affinity["nodeAffinity"]; !ok {
+		t.Errorf("affinity = %v, want nodeAffinity", affinity)
+	}
+}
+
+func TestSetSchedulingRemovesSettings(t *testing.T) {
+	t.Parallel()
+
+	// the live deployment was created with all scheduling settings
+	actual := generatedDeployment()
+
+	desired := generatedDeployment()
+	if err := podtemplate.SetScheduling(desired, scheduling()); err != nil {
+		t.Fatalf("SetScheduling() error = %v", err)
+	}
+
+	update(t, desired, actual)
+
+	reader := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(actual.DeepCopy()).Build()
+
+	// all scheduling settings are removed again
+	desired = generatedDeployment()
+	if err := podtemplate.SetScheduling(desired, setupv1alpha1.SchedulingSpec{}); err != nil {
+		t.Fatalf("SetScheduling() error = %v", err)
+	}
+
+	if err := podtemplate.PruneNodeSelector(context.Background(), reader, desired); err != nil {
+		t.Fatalf("PruneNodeSelector() error = %v", err)
+	}
+
+	desiredSpec, err := podtemplate.Spec(desired)
+	if err != nil {
+		t.Fatalf("unable to get pod spec, %v", err)
+	}
+
+	for _, field := range []string{"tolerations", "priorityClassName", "topologySpreadConstraints"} {
+		if value, ok := desiredSpec[field]; !ok || value != nil {
+			t.Errorf("desired %s = %v, want null", field, value)
+		}
+	}
+
+	nodeSelector, _ := desiredSpec["nodeSelector"].(map[string]interface{})
+	if value, ok := nodeSelector["node-role.kubernetes.io/infra"]; !ok || value != nil {
+		t.Errorf("desired node selector = %v, want the removed key to be null", nodeSelector)
+	}
+
+	affinity, _ := desiredSpec["affinity"].(map[string]interface{})
+	if value, ok := affinity["nodeAffinity"]; !ok || value != nil {
+		t.Errorf("desired affinity = %v, want nodeAffinity to be null", affinity)
+	}
+
+	podSpec := update(t, desired, actual)
+
+	for _, field := range []string{"tolerations", "priorityClassName", "topologySpreadConstraints"} {
+		if value, ok := podSpec[field]; ok {
+			t.Errorf("%s = %v, want it to be removed", field, value)
+		}
+	}
+
+	want := map[string]interface{}{"kubernetes.io/os": "linux"}
+	if got := podSpec["nodeSelector"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("nodeSelector = %v, want %v", got, want)
+	}
+
+	if got, want := podSpec["affinity"], normalize(t, defaultAffinity()); !reflect.DeepEqual(got, want) {
+		t.Errorf("affinity = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtemplate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MountSecret mounts a secret read-only into the named container of a generated workload object.
+// An existing volume or volume mount of the same name is replaced, otherwise they are appended.
+func MountSecret(object client.Object, name, volume, secret, mountPath string) error {
+	spec, err := Spec(object)
+	if err != nil {
+		return err
+	}
+
+	container, err := Container(object, name)
+	if err != nil {
+		return err
+	}
+
+	spec["volumes"] = setNamed(spec["volumes"], map[string]interface{}{
+		"name": volume,
+		"secret": map[string]interface{}{
+			"secretName": secret,
+		},
+	})
+
+	container["volumeMounts"] = setNamed(container["volumeMounts"], map[string]interface{}{
+		"name":      volume,
+		"mountPath": mountPath,
+		"readOnly":  true,
+	})
+
+	return nil
+}
+
+// setNamed replaces the item with the name of the given item in a list of named items, or
+// appends the item if the list does not contain an item of that name.
+func setNamed(list interface{}, item map[string]interface{}) []interface{} {
+	items, _ := list.([]interface{})
+
+	for i := range items {
+		if existing, ok := items[i].(map[string]interface{}); ok && existing["name"] == item["name"] {
+			items[i] = item
+
+			return items
+		}
+	}
+
+	return append(items, item)
+}
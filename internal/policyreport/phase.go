@@ -0,0 +1,195 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyreport
+
+import (
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/apis/platform/v1alpha1/policycomponent"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// reportKinds are the kinds of the policy reports which kyverno writes the results of the
+// policies to.
+var reportKinds = []schema.GroupVersionKind{
+	{Group: "wgpolicyk8s.io", Version: "v1alpha2", Kind: "PolicyReport"},
+	{Group: "wgpolicyk8s.io", Version: "v1alpha2", Kind: "ClusterPolicyReport"},
+}
+
+// +kubebuilder:rbac:groups=wgpolicyk8s.io,resources=policyreports;clusterpolicyreports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=constraints.gatekeeper.sh,resources=*,verbs=get;list;watch
+
+// SummaryPhase summarizes the results of the policies of a policy component in its status.  The
+// results of kyverno policies are counted from the policy reports, while gatekeeper only reports
+// the number of violations of each constraint, which it updates when auditing the cluster.  The
+// summary is refreshed whenever the component is reconciled, which includes whenever the results
+// in the policy reports or the violations of the constraints change.
+func SummaryPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(*platformv1alpha1.PolicyComponent)
+	if !ok {
+		return true, nil
+	}
+
+	collection, ok := req.Collection.(*setupv1alpha1.SupportServices)
+	if !ok {
+		return true, nil
+	}
+
+	// generate the policies without the reconciler, so that the constraints whose kinds are not
+	// served yet are included
+	resources, err := policycomponent.Generate(*component, *collection, nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("unable to generate resources, %w", err)
+	}
+
+	report := &platformv1alpha1.PolicyComponentReport{Violations: map[string]int{}}
+
+	for _, resource := range resources {
+		gvk := resource.GetObjectKind().GroupVersionKind()
+
+		switch gvk.Group {
+		case "kyverno.io":
+			report.Violations[resource.GetName()] = 0
+		case "constraints.gatekeeper.sh":
+			violations, err := constraintViolations(r, req, resource)
+			if err != nil {
+				return false, err
+			}
+
+			report.Violations[resource.GetName()] = violations
+			report.Fail += violations
+		}
+	}
+
+	if component.Spec.Engine.Type != "gatekeeper" {
+		if err := countResults(r, req, report); err != nil {
+			return false, err
+		}
+	}
+
+	if equality.Semantic.DeepEqual(component.GetPolicyReport(), report) {
+		return true, nil
+	}
+
+	component.SetPolicyReport(report)
+
+	if err := r.Status().Update(req.Context, component); err != nil {
+		return false, fmt.Errorf("unable to update policy report for %s, %w", component.GetName(), err)
+	}
+
+	return true, nil
+}
+
+// constraintViolations returns the number of violations of a gatekeeper constraint, as found by
+// the last audit.  Constraints which do not exist yet have no violations.
+func constraintViolations(r workload.Reconciler, req *workload.Request, resource client.Object) (int, error) {
+	constraint := &unstructured.Unstructured{}
+	constraint.SetGroupVersionKind(resource.GetObjectKind().GroupVersionKind())
+
+	if err := r.Get(req.Context, client.ObjectKeyFromObject(resource), constraint); err != nil {
+		// the kind of the constraint is not served until its template is created
+		if meta.IsNoMatchError(err) {
+			return 0, nil
+		}
+
+		if !apierrs.IsNotFound(err) {
+			return 0, fmt.Errorf("unable to get constraint %s, %w", resource.GetName(), err)
+		}
+	}
+
+	if err := watch(r, req, constraint.GroupVersionKind()); err != nil {
+		return 0, err
+	}
+
+	violations, _, err := unstructured.NestedInt64(constraint.Object, "status", "totalViolations")
+	if err != nil {
+		return 0, fmt.Errorf("unable to read violations of constraint %s, %w", resource.GetName(), err)
+	}
+
+	return int(violations), nil
+}
+
+// countResults counts the results of the kyverno policies of a component from the policy reports.
+// Results of other policies are ignored.
+func countResults(r workload.Reconciler, req *workload.Request, report *platformv1alpha1.PolicyComponentReport) error {
+	for _, gvk := range reportKinds {
+		reports := &unstructured.UnstructuredList{}
+		reports.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := r.List(req.Context, reports); err != nil {
+			// the kind is not served until the engine is deployed
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return fmt.Errorf("unable to list %s resources, %w", gvk.Kind, err)
+		}
+
+		if err := watch(r, req, gvk); err != nil {
+			return err
+		}
+
+		for i := range reports.Items {
+			results, _, err := unstructured.NestedSlice(reports.Items[i].Object, "results")
+			if err != nil {
+				return fmt.Errorf("unable to read results of %s %s, %w", gvk.Kind, reports.Items[i].GetName(), err)
+			}
+
+			for _, entry := range results {
+				result, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				policy, _ := result["policy"].(string)
+				if _, ok := report.Violations[policy]; !ok {
+					continue
+				}
+
+				count(report, policy, result["result"])
+			}
+		}
+	}
+
+	return nil
+}
+
+// count counts a single result of a policy in the report.
+func count(report *platformv1alpha1.PolicyComponentReport, policy string, result interface{}) {
+	switch result {
+	case "pass":
+		report.Pass++
+	case "fail":
+		report.Fail++
+		report.Violations[policy]++
+	case "warn":
+		report.Warn++
+	case "error":
+		report.Error++
+	case "skip":
+		report.Skip++
+	}
+}
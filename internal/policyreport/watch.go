@@ -0,0 +1,108 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyreport
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// summaryFields are the fields of the policy reports and gatekeeper constraints which the summary
+// is computed from.
+var summaryFields = [][]string{
+	{"summary"},
+	{"status", "totalViolations"},
+}
+
+// watch enqueues the component whenever the results of the resources of the given kind change, so
+// that the summary is refreshed as soon as the engine reports new results rather than only when
+// the component itself changes.  The kind is only watched once it is served, as the engine
+// installs it.
+func watch(r workload.Reconciler, req *workload.Request, gvk schema.GroupVersionKind) error {
+	for _, watched := range r.GetWatches() {
+		if watched.GetObjectKind().GroupVersionKind() == gvk {
+			return nil
+		}
+	}
+
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(gvk)
+
+	// create a function which maps any change of a result to this specific reconcile request
+	mapFn := func(client.Object) []reconcile.Request {
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name:      req.Workload.GetName(),
+					Namespace: req.Workload.GetNamespace(),
+				},
+			},
+		}
+	}
+
+	if err := r.GetController().Watch(
+		&source.Kind{Type: resource},
+		handler.EnqueueRequestsFromMapFunc(mapFn),
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return resultsChanged(e.ObjectOld, e.ObjectNew)
+			},
+			GenericFunc: func(e event.GenericEvent) bool {
+				return false
+			},
+		},
+	); err != nil {
+		return fmt.Errorf("unable to watch %s resources, %w", gvk.Kind, err)
+	}
+
+	r.SetWatch(resource)
+
+	return nil
+}
+
+// resultsChanged returns whether the fields which the summary is computed from differ between two
+// versions of a policy report or constraint.
+func resultsChanged(oldObject, newObject client.Object) bool {
+	oldResource, oldOK := oldObject.(*unstructured.Unstructured)
+	newResource, newOK := newObject.(*unstructured.Unstructured)
+
+	if !oldOK || !newOK {
+		return true
+	}
+
+	for _, field := range summaryFields {
+		oldValue, _, _ := unstructured.NestedFieldNoCopy(oldResource.Object, field...)
+		newValue, _, _ := unstructured.NestedFieldNoCopy(newResource.Object, field...)
+
+		if !reflect.DeepEqual(oldValue, newValue) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prune
+
+import (
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PrunableKinds are the kinds of child resources which are only generated while the component is
+// in a certain state, e.g. a workload scaled to more than one replica or an optional feature which
+// is enabled.  Child resources are otherwise only removed when their parent is deleted, so
+// resources of these kinds which are no longer generated must be pruned.  Other kinds, such as
+// namespaces and custom resource definitions, are never pruned as deleting them would also delete
+// the resources within them.
+var PrunableKinds = []schema.GroupVersionKind{
+	{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"},
+	{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"},
+	{Group: "configuration.konghq.com", Version: "v1", Kind: "KongClusterPlugin"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "GatewayClass"},
+	{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "Gateway"},
+	{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"},
+	{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	{Group: "", Version: "v1", Kind: "Service"},
+	{Group: "", Version: "v1", Kind: "ConfigMap"},
+	{Group: "", Version: "v1", Kind: "ServiceAccount"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	{Group: "networking.k8s.io", Version: "v1", Kind: "IngressClass"},
+	{Group: "monitoring.coreos.com", Version: "v1", Kind: "Alertmanager"},
+	{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"},
+	{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"},
+	{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"},
+	{Group: "storage.k8s.io", Version: "v1", Kind: "CSIDriver"},
+	{Group: "snapshot.storage.k8s.io", Version: "v1", Kind: "VolumeSnapshotClass"},
+	{Group: "kyverno.io", Version: "v1", Kind: "ClusterPolicy"},
+	{Group: "templates.gatekeeper.sh", Version: "v1", Kind: "ConstraintTemplate"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"},
+	{Group: "velero.io", Version: "v1", Kind: "VolumeSnapshotLocation"},
+	{Group: "velero.io", Version: "v1", Kind: "Schedule"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"},
+	{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "Redis"},
+	{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "RedisReplication"},
+	{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "RedisSentinel"},
+	{Group: "redis.redis.opstreelabs.in", Version: "v1beta1", Kind: "RedisCluster"},
+	{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	{Group: "", Version: "v1", Kind: "Secret"},
+	{Group: "batch", Version: "v1", Kind: "Job"},
+	{Group: "minio.min.io", Version: "v2", Kind: "Tenant"},
+}
+
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=configuration.konghq.com,resources=kongclusterplugins,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=core,resources=services;configmaps;serviceaccounts,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings;roles;rolebindings,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=alertmanagers,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;podmonitors;prometheusrules,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csidrivers,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=kyverno.io,resources=clusterpolicies,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=templates.gatekeeper.sh,resources=constrainttemplates,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=volumesnapshotlocations;schedules,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=redis.redis.opstreelabs.in,resources=redis;redisreplications;redissentinels;redisclusters,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=minio.min.io,resources=tenants,verbs=get;list;watch;delete
+
+// childResourceRemover is implemented by workloads which are able to remove the status of a pruned
+// child resource.
+type childResourceRemover interface {
+	RemoveChildResourceCondition(*status.ChildResource)
+}
+
+// PrunePhase deletes the child resources of a workload which are of a prunable kind but which are
+// no longer generated, e.g. the pod disruption budget of a deployment which has been scaled down
+// to a single replica.  The child resources which were previously generated are taken from the
+// status of the workload, where they are recorded when they are created, so that only the
+// resources of the workload itself are retrieved from the cluster.
+func PrunePhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	desiredResources, err := r.GetResources(req)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve resources, %w", err)
+	}
+
+	desired := map[string]bool{}
+
+	for _, resource := range desiredResources {
+		gvk := resource.GetObjectKind().GroupVersionKind()
+		desired[key(gvk.Group, gvk.Kind, resource.GetNamespace(), resource.GetName())] = true
+	}
+
+	// copy the recorded resources as pruned resources are removed from the status while iterating
+	recorded := append([]*status.ChildResource{}, req.Workload.GetChildResourceConditions()...)
+
+	for _, child := range recorded {
+		if !prunable[child.Group+"/"+child.Kind] || desired[key(child.Group, child.Kind, child.Namespace, child.Name)] {
+			continue
+		}
+
+		if err := prune(r, req, child); err != nil {
+			return false, err
+		}
+
+		if remover, ok := req.Workload.(childResourceRemover); ok {
+			remover.RemoveChildResourceCondition(child)
+		}
+	}
+
+	return true, nil
+}
+
+// prune deletes a child resource which is no longer generated, if it still exists and is
+// controlled by the workload.
+func prune(r workload.Reconciler, req *workload.Request, child *status.ChildResource) error {
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(schema.GroupVersionKind{Group: child.Group, Version: child.Version, Kind: child.Kind})
+
+	if err := r.Get(req.Context, types.NamespacedName{Namespace: child.Namespace, Name: child.Name}, resource); err != nil {
+		// the kind is no longer served when the component which installs it has been removed
+		if apierrs.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to retrieve %s %s, %w", child.Kind, child.Name, err)
+	}
+
+	if !metav1.IsControlledBy(resource, req.Workload) {
+		return nil
+	}
+
+	req.Log.Info("pruning resource", "kind", child.Kind, "name", child.Name, "namespace", child.Namespace)
+
+	// delete in the background so that the pods of pruned jobs are deleted along with them
+	err := r.Delete(req.Context, resource, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("unable to prune %s %s, %w", child.Kind, child.Name, err)
+	}
+
+	return nil
+}
+
+// prunable is the set of prunable kinds, keyed by group and kind.
+var prunable = func() map[string]bool {
+	kinds := map[string]bool{}
+
+	for _, gvk := range PrunableKinds {
+		kinds[gvk.Group+"/"+gvk.Kind] = true
+	}
+
+	return kinds
+}()
+
+// key returns the key which identifies a child resource of a workload.  The version is not part of
+// the key, as the same resource may be served in several versions.
+func key(group, kind, namespace, name string) string {
+	return group + "/" + kind + "/" + types.NamespacedName{Namespace: namespace, Name: name}.String()
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prune_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/prune"
+)
+
+// unimplemented satisfies the methods of a reconciler which are not used by the phase.
+type unimplemented struct {
+	workload.Reconciler
+}
+
+// reconciler is a reconciler which generates the given resources.
+type reconciler struct {
+	unimplemented
+	client.Client
+
+	resources []client.Object
+}
+
+func (r *reconciler) GetResources(*workload.Request) ([]client.Object, error) {
+	return r.resources, nil
+}
+
+func TestPrunePhase(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, platformv1alpha1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("unable to add scheme, %v", err)
+		}
+	}
+
+	component := &platformv1alpha1.IngressComponent{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingresscomponent-sample", UID: "ingress"},
+	}
+
+	owned := func(object client.Object) client.Object {
+		if err := ctrl.SetControllerReference(component, object, scheme); err != nil {
+			t.Fatalf("unable to set owner, %v", err)
+		}
+
+		return object
+	}
+
+	namespace := owned(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nukleros-ingress-system"}})
+	generated := owned(&policyv1.PodDisruptionBudget{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-ingress", Namespace: "nukleros-ingress-system"},
+	})
+	stale := owned(&policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress-kong", Namespace: "nukleros-ingress-system"},
+	})
+	unowned := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-budget", Namespace: "nukleros-ingress-system"},
+	}
+
+	for _, child := range []*status.ChildResource{
+		{Version: "v1", Kind: "Namespace", Name: "nukleros-ingress-system"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget", Name: "nginx-ingress", Namespace: "nukleros-ingress-system"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget", Name: "ingress-kong", Namespace: "nukleros-ingress-system"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget", Name: "user-budget", Namespace: "nukleros-ingress-system"},
+		{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget", Name: "already-pruned", Namespace: "nukleros-ingress-system"},
+	} {
+		component.SetChildResourceCondition(child)
+	}
+
+	r := &reconciler{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(component, namespace, generated, stale, unowned).Build(),
+		resources: []client.Object{generated},
+	}
+
+	req := &workload.Request{Context: context.Background(), Workload: component, Log: logr.Discard()}
+
+	proceed, err := prune.PrunePhase(r, req)
+	if err != nil || !proceed {
+		t.Fatalf("PrunePhase() = %t, %v, want to proceed", proceed, err)
+	}
+
+	for _, tt := range []struct {
+		object client.Object
+		exists bool
+	}{
+		{object: &corev1.Namespace{}, exists: true},
+		{object: &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "nginx-ingress"}}, exists: true},
+		{object: &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "ingress-kong"}}, exists: false},
+		{object: &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "user-budget"}}, exists: true},
+	} {
+		name := tt.object.GetName()
+		key := client.ObjectKey{Name: name, Namespace: "nukleros-ingress-system"}
+
+		if name == "" {
+			key = client.ObjectKey{Name: "nukleros-ingress-system"}
+		}
+
+		err := r.Get(req.Context, key, tt.object)
+
+		if exists := !apierrs.IsNotFound(err); exists != tt.exists {
+			t.Errorf("%T %s exists = %t, want %t (%v)", tt.object, key, exists, tt.exists, err)
+		}
+	}
+
+	// the pruned resources are removed from the status, the others are kept
+	remaining := map[string]bool{}
+	for _, child := range component.GetChildResourceConditions() {
+		remaining[child.Name] = true
+	}
+
+	want := map[string]bool{"nukleros-ingress-system": true, "nginx-ingress": true}
+	if len(remaining) != len(want) || !remaining["nukleros-ingress-system"] || !remaining["nginx-ingress"] {
+		t.Errorf("PrunePhase() left child resources %v in status, want %v", remaining, want)
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// maxResponseSize limits the size of responses which are read from a registry.  Only manifests
+// and signature payloads are read, which are far smaller than this.
+const maxResponseSize = 4 << 20
+
+var (
+	ErrNotFound     = errors.New("not found in registry")
+	ErrUnauthorized = errors.New("unauthorized to access registry")
+	ErrTooLarge     = errors.New("response from registry exceeds size limit")
+)
+
+// Client resolves image tags to digests and verifies image signatures against the registries of
+// the images.
+type Client struct {
+	// Transport is the transport used for all requests.  The default transport of the remote
+	// package is used when nil.
+	Transport http.RoundTripper
+
+	// Timeout limits the duration of each operation against a registry, if set.
+	Timeout time.Duration
+
+	// Insecure lists the registries which are accessed over plain HTTP rather than HTTPS.
+	Insecure []string
+
+	// Keychain provides the credentials for each registry.  Registries without credentials are
+	// accessed anonymously.
+	Keychain Keychain
+}
+
+// NewClient returns a new client with a timeout suitable for use during reconciliation.
+func NewClient() *Client {
+	return &Client{
+		Timeout:  30 * time.Second,
+		Keychain: Keychain{},
+	}
+}
+
+// Resolve returns the digest of the manifest which the reference points to.  References which are
+// already pinned to a digest are returned as is.
+func (client *Client) Resolve(ctx context.Context, ref Reference) (string, error) {
+	if ref.Digest != "" {
+		return ref.Digest, nil
+	}
+
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	options := client.options(ctx)
+
+	remoteRef, err := client.reference(ref)
+	if err != nil {
+		return "", err
+	}
+
+	descriptor, err := remote.Head(remoteRef, options...)
+	if err == nil {
+		return descriptor.Digest.String(), nil
+	}
+
+	// registries are not required to return the digest of a manifest from a HEAD request, so fall
+	// back to computing the digest from the manifest unless the registry rejected the request
+	var registryErr *transport.Error
+	if errors.As(err, &registryErr) {
+		return "", fmt.Errorf("unable to resolve image %s, %w", ref, wrapError(err))
+	}
+
+	manifest, err := remote.Get(remoteRef, options...)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve image %s, %w", ref, wrapError(err))
+	}
+
+	return manifest.Digest.String(), nil
+}
+
+// reference returns the reference in the form used by the remote package.
+func (client *Client) reference(ref Reference) (name.Reference, error) {
+	var options []name.Option
+
+	for _, insecure := range client.Insecure {
+		if insecure == ref.Registry {
+			options = append(options, name.Insecure)
+		}
+	}
+
+	repository, err := name.NewRepository(ref.Name(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("%w; %s", ErrInvalidReference, err)
+	}
+
+	if ref.Digest != "" {
+		return repository.Digest(ref.Digest), nil
+	}
+
+	return repository.Tag(ref.Tag), nil
+}
+
+// options returns the options for all requests of the remote package.
+func (client *Client) options(ctx context.Context) []remote.Option {
+	base := client.Transport
+	if base == nil {
+		base = remote.DefaultTransport
+	}
+
+	keychain := authn.Keychain(client.Keychain)
+	if client.Keychain == nil {
+		keychain = authn.NewMultiKeychain()
+	}
+
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(keychain),
+		remote.WithTransport(&limitedTransport{base: base}),
+	}
+}
+
+// withTimeout returns the context limited to the timeout of the client, if set.
+func (client *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if client.Timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, client.Timeout)
+}
+
+// wrapError wraps the errors returned by a registry in the matching error of this package.
+func wrapError(err error) error {
+	var registryErr *transport.Error
+	if !errors.As(err, &registryErr) {
+		return err
+	}
+
+	switch registryErr.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w; %s", ErrNotFound, err)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w; %s", ErrUnauthorized, err)
+	default:
+		return err
+	}
+}
+
+// limitedTransport fails requests whose responses exceed the maximum response size, rather than
+// reading an unbounded amount of data from a registry.
+type limitedTransport struct {
+	base http.RoundTripper
+}
+
+func (limited *limitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := limited.base.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.ContentLength > maxResponseSize {
+		response.Body.Close()
+
+		return nil, fmt.Errorf("%w; %d bytes from %s", ErrTooLarge, response.ContentLength, request.URL.Redacted())
+	}
+
+	response.Body = &limitedBody{ReadCloser: response.Body, remaining: maxResponseSize, url: request.URL.Redacted()}
+
+	return response, nil
+}
+
+// limitedBody is a response body which fails once more than the maximum response size is read.
+type limitedBody struct {
+	io.ReadCloser
+
+	remaining int64
+	url       string
+}
+
+func (body *limitedBody) Read(p []byte) (int, error) {
+	if body.remaining < 0 {
+		return 0, fmt.Errorf("%w; response from %s", ErrTooLarge, body.url)
+	}
+
+	// read one byte beyond the limit to detect that the body exceeds it
+	if int64(len(p)) > body.remaining+1 {
+		p = p[:body.remaining+1]
+	}
+
+	n, err := body.ReadCloser.Read(p)
+	body.remaining -= int64(n)
+
+	if body.remaining < 0 {
+		return 0, fmt.Errorf("%w; response from %s", ErrTooLarge, body.url)
+	}
+
+	return n, err
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nukleros/support-services-operator/internal/registry"
+	"github.com/nukleros/support-services-operator/internal/registry/registrytest"
+)
+
+func newClient(host string) *registry.Client {
+	client := registry.NewClient()
+	client.Insecure = []string{host}
+
+	return client
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	digest := reg.PushImage("nukleros/reloader", "v1", "reloader")
+	client := newClient(reg.Host)
+
+	ref, err := registry.ParseReference(reg.Host + "/nukleros/reloader:v1")
+	if err != nil {
+		t.Fatalf("ParseReference() error = %v", err)
+	}
+
+	got, err := client.Resolve(context.Background(), ref)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	if got != digest {
+		t.Errorf("Resolve() = %s, want %s", got, digest)
+	}
+
+	// pinned references are not resolved again
+	pinned := ref.WithDigest(digest)
+
+	if got, err := client.Resolve(context.Background(), pinned); err != nil || got != digest {
+		t.Errorf("Resolve() of pinned reference = %s, %v, want %s", got, err, digest)
+	}
+
+	missing := ref
+	missing.Tag = "v2"
+
+	if _, err := client.Resolve(context.Background(), missing); !errors.Is(err, registry.ErrNotFound) {
+		t.Errorf("Resolve() of missing tag error = %v, want %v", err, registry.ErrNotFound)
+	}
+}
+
+func TestResolveWithoutDigestHeader(t *testing.T) {
+	t.Parallel()
+
+	// a registry which serves the same manifest for every request, without a digest header
+	manifest := []byte(`{"schemaVersion":2}`)
+
+	bare := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(manifest)
+	}))
+	t.Cleanup(bare.Close)
+
+	host := strings.TrimPrefix(bare.URL, "http://")
+
+	ref, err := registry.ParseReference(host + "/nukleros/reloader:v1")
+	if err != nil {
+		t.Fatalf("ParseReference() error = %v", err)
+	}
+
+	// the digest is computed from the manifest instead
+	got, err := newClient(host).Resolve(context.Background(), ref)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	if want := registrytest.Digest(manifest); got != want {
+		t.Errorf("Resolve() = %s, want %s", got, want)
+	}
+}
+
+func TestResolveOversizedManifest(t *testing.T) {
+	t.Parallel()
+
+	// a registry which streams a manifest of unknown length which exceeds the size limit
+	oversized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/" {
+			return
+		}
+
+		chunk := []byte(strings.Repeat(" ", 1<<20))
+
+		for i := 0; i < 8; i++ {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+
+			w.(http.Flusher).Flush()
+		}
+	}))
+	t.Cleanup(oversized.Close)
+
+	host := strings.TrimPrefix(oversized.URL, "http://")
+
+	ref, err := registry.ParseReference(host + "/nukleros/reloader:v1")
+	if err != nil {
+		t.Fatalf("ParseReference() error = %v", err)
+	}
+
+	if _, err := newClient(host).Resolve(context.Background(), ref); !errors.Is(err, registry.ErrTooLarge) {
+		t.Errorf("Resolve() error = %v, want %v", err, registry.ErrTooLarge)
+	}
+}
+
+func TestResolveWithBasicAuth(t *testing.T) {
+	t.Parallel()
+
+	reg := registrytest.New(t)
+	digest := reg.PushImage("nukleros/reloader", "v1", "reloader")
+
+	// guard the registry with basic authentication
+	guarded := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		proxied, err := http.NewRequestWithContext(r.Context(), r.Method, "http://"+reg.Host+r.URL.Path, http.NoBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		proxied.Header = r.Header.Clone()
+
+		response, err := http.DefaultClient.Do(proxied)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+
+			return
+		}
+		defer response.Body.Close()
+
+		for key, values := range response.Header {
+			w.Header()[key] = values
+		}
+
+		w.WriteHeader(response.StatusCode)
+	}))
+	t.Cleanup(guarded.Close)
+
+	host := strings.TrimPrefix(guarded.URL, "http://")
+	client := newClient(host)
+
+	ref, err := registry.ParseReference(host + "/nukleros/reloader:v1")
+	if err != nil {
+		t.Fatalf("ParseReference() error = %v", err)
+	}
+
+	if _, err := client.Resolve(context.Background(), ref); !errors.Is(err, registry.ErrUnauthorized) {
+		t.Fatalf("Resolve() without credentials error = %v, want %v", err, registry.ErrUnauthorized)
+	}
+
+	config := `{"auths": {"http://` + host + `/v2/": {"auth": "dXNlcjpzZWNyZXQ="}}}`
+
+	if err := client.Keychain.AddDockerConfig([]byte(config)); err != nil {
+		t.Fatalf("AddDockerConfig() error = %v", err)
+	}
+
+	got, err := client.Resolve(context.Background(), ref)
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+
+	if got != digest {
+		t.Errorf("Resolve() = %s, want %s", got, digest)
+	}
+}
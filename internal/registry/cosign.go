@@ -0,0 +1,176 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// signatureAnnotation is the layer annotation in which cosign stores the signature of the layer.
+const signatureAnnotation = "dev.cosignproject.cosign/signature"
+
+var (
+	ErrInvalidPublicKey = errors.New("invalid public key")
+	ErrUnsigned         = errors.New("image is not signed")
+	ErrInvalidSignature = errors.New("image signature does not match")
+)
+
+// signaturePayload is the subset of the simple signing payload of a cosign signature which is
+// needed to verify the signature.
+type signaturePayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// ParsePublicKey parses a PEM encoded public key as generated by cosign.
+func ParsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("%w; unable to decode pem block", ErrInvalidPublicKey)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w; %s", ErrInvalidPublicKey, err)
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w; unsupported key type %T", ErrInvalidPublicKey, key)
+	}
+}
+
+// VerifySignature verifies that the image with the given digest has a cosign signature in the
+// repository of the reference which was made with the private key of the given public key.  The
+// signature is verified offline against the key; transparency log entries are not checked.
+func (client *Client) VerifySignature(ctx context.Context, ref Reference, digest string, key crypto.PublicKey) error {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	signatures := ref
+	signatures.Digest = ""
+	signatures.Tag = strings.Replace(digest, ":", "-", 1) + ".sig"
+
+	remoteRef, err := client.reference(signatures)
+	if err != nil {
+		return err
+	}
+
+	image, err := remote.Image(remoteRef, client.options(ctx)...)
+	if err != nil {
+		if err = wrapError(err); errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w; %s", ErrUnsigned, ref.WithDigest(digest))
+		}
+
+		return fmt.Errorf("unable to get signatures of %s, %w", ref.WithDigest(digest), err)
+	}
+
+	manifest, err := image.Manifest()
+	if err != nil {
+		return fmt.Errorf("unable to decode signature manifest for %s, %w", ref.WithDigest(digest), err)
+	}
+
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[signatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		payload, err := readLayer(image, layer.Digest)
+		if err != nil {
+			return fmt.Errorf("unable to get signature payload of %s, %w", ref.WithDigest(digest), wrapError(err))
+		}
+
+		if verifyPayload(key, payload, signature, digest) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w; no valid signature for %s", ErrInvalidSignature, ref.WithDigest(digest))
+}
+
+// readLayer returns the content of the layer of the image, which is verified against its digest
+// as it is read.
+func readLayer(image v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := image.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return io.ReadAll(content)
+}
+
+// verifyPayload returns whether the signature is a valid signature of the payload by the key and
+// whether the payload was signed for the given digest.
+func verifyPayload(key crypto.PublicKey, payload []byte, encodedSignature, digest string) bool {
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(payload)
+
+	var valid bool
+
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, payload, signature)
+	}
+
+	if !valid {
+		return false
+	}
+
+	// the signature only covers the payload, so the payload must refer to the image being verified
+	var decoded signaturePayload
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return false
+	}
+
+	return decoded.Critical.Image.DockerManifestDigest == digest
+}
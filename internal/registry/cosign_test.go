@@ -0,0 +1,99 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nukleros/support-services-operator/internal/registry"
+	"github.com/nukleros/support-services-operator/internal/registry/registrytest"
+)
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	signingKey, publicKey := registrytest.GenerateKey(t)
+	otherKey, _ := registrytest.GenerateKey(t)
+
+	for _, tt := range []struct {
+		name    string
+		sign    func(reg *registrytest.Registry, digest string)
+		wantErr error
+	}{
+		{
+			name: "valid signature",
+			sign: func(reg *registrytest.Registry, digest string) {
+				reg.Sign("nukleros/reloader", digest, digest, signingKey)
+			},
+		},
+		{
+			name:    "missing signature",
+			sign:    func(reg *registrytest.Registry, digest string) {},
+			wantErr: registry.ErrUnsigned,
+		},
+		{
+			name: "wrong key",
+			sign: func(reg *registrytest.Registry, digest string) {
+				reg.Sign("nukleros/reloader", digest, digest, otherKey)
+			},
+			wantErr: registry.ErrInvalidSignature,
+		},
+		{
+			name: "signature of another image",
+			sign: func(reg *registrytest.Registry, digest string) {
+				other := reg.PushImage("nukleros/reloader", "other", "other")
+				reg.Sign("nukleros/reloader", digest, other, signingKey)
+			},
+			wantErr: registry.ErrInvalidSignature,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reg := registrytest.New(t)
+			digest := reg.PushImage("nukleros/reloader", "v1", "reloader")
+			tt.sign(reg, digest)
+
+			key, err := registry.ParsePublicKey(publicKey)
+			if err != nil {
+				t.Fatalf("ParsePublicKey() error = %v", err)
+			}
+
+			ref, err := registry.ParseReference(reg.Host + "/nukleros/reloader:v1")
+			if err != nil {
+				t.Fatalf("ParseReference() error = %v", err)
+			}
+
+			err = newClient(reg.Host).VerifySignature(context.Background(), ref, digest, key)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifySignature() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	if _, err := registry.ParsePublicKey("not a key"); !errors.Is(err, registry.ErrInvalidPublicKey) {
+		t.Errorf("ParsePublicKey() error = %v, want %v", err, registry.ErrInvalidPublicKey)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// Keychain holds the credentials for registries, keyed by the registry as named by the remote
+// package, e.g. index.docker.io rather than docker.io.
+type Keychain map[string]authn.AuthConfig
+
+// Resolve returns the authenticator for the registry of the resource, which is anonymous for
+// registries without credentials.
+func (keychain Keychain) Resolve(resource authn.Resource) (authn.Authenticator, error) {
+	config, ok := keychain[resource.RegistryStr()]
+	if !ok {
+		return authn.Anonymous, nil
+	}
+
+	return authn.FromConfig(config), nil
+}
+
+// AddDockerConfig adds the credentials of a docker config, as stored in the .dockerconfigjson key
+// of an image pull secret.
+func (keychain Keychain) AddDockerConfig(data []byte) error {
+	var config struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("unable to decode docker config, %w", err)
+	}
+
+	for server, auth := range config.Auths {
+		credential := authn.AuthConfig{Username: auth.Username, Password: auth.Password}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return fmt.Errorf("unable to decode credentials for %s, %w", server, err)
+			}
+
+			credential.Username, credential.Password, _ = strings.Cut(string(decoded), ":")
+		}
+
+		registry, err := name.NewRegistry(registryHost(server))
+		if err != nil {
+			return fmt.Errorf("invalid registry %s in docker config, %w", server, err)
+		}
+
+		keychain[registry.RegistryStr()] = credential
+	}
+
+	return nil
+}
+
+// registryHost returns the registry for a server in a docker config, which may be a full URL
+// (e.g. https://index.docker.io/v1/).
+func registryHost(server string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+
+	if host == dockerHubHost {
+		return name.DefaultRegistry
+	}
+
+	return host
+}
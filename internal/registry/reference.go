@@ -0,0 +1,132 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultRegistry is the registry that images are pulled from when no registry is specified.
+	DefaultRegistry = "docker.io"
+
+	// defaultTag is the tag that is pulled when an image specifies neither a tag nor a digest.
+	defaultTag = "latest"
+
+	// dockerHubHost is the host which serves the registry API for docker.io.
+	dockerHubHost = "registry-1.docker.io"
+)
+
+var ErrInvalidReference = errors.New("invalid image reference")
+
+var (
+	digestPattern     = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	repositoryPattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:\.|_|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:\.|_|__|-+)[a-z0-9]+)*)*$`)
+	tagPattern        = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+)
+
+// Reference is a parsed image reference.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseReference parses an image reference in the form [registry/]repository[:tag][@digest].
+func ParseReference(image string) (Reference, error) {
+	registry, remainder := SplitRegistry(image)
+
+	ref := Reference{Registry: registry}
+
+	if i := strings.Index(remainder, "@"); i >= 0 {
+		remainder, ref.Digest = remainder[:i], remainder[i+1:]
+
+		if !digestPattern.MatchString(ref.Digest) {
+			return Reference{}, fmt.Errorf("%w; unsupported digest %q in %s", ErrInvalidReference, ref.Digest, image)
+		}
+	}
+
+	// the registry has already been removed, so any remaining colon separates the tag
+	if i := strings.LastIndex(remainder, ":"); i >= 0 {
+		remainder, ref.Tag = remainder[:i], remainder[i+1:]
+
+		if !tagPattern.MatchString(ref.Tag) {
+			return Reference{}, fmt.Errorf("%w; invalid tag %q in %s", ErrInvalidReference, ref.Tag, image)
+		}
+	}
+
+	if !repositoryPattern.MatchString(remainder) {
+		return Reference{}, fmt.Errorf("%w; invalid repository %q in %s", ErrInvalidReference, remainder, image)
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	ref.Repository = remainder
+
+	return ref, nil
+}
+
+// SplitRegistry splits an image into its registry and the remainder of the image within the
+// registry.  Images which do not specify a registry belong to docker.io, where official images
+// live under the library repository.
+func SplitRegistry(image string) (registry, remainder string) {
+	parts := strings.SplitN(image, "/", 2)
+
+	// the first part of the image is only a registry if it looks like a host
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], parts[1]
+	}
+
+	if len(parts) == 1 {
+		return DefaultRegistry, "library/" + image
+	}
+
+	return DefaultRegistry, image
+}
+
+// Name returns the reference without its tag or digest.
+func (ref Reference) Name() string {
+	return ref.Registry + "/" + ref.Repository
+}
+
+// String returns the full reference including the tag and digest, if they are set.
+func (ref Reference) String() string {
+	image := ref.Name()
+
+	if ref.Tag != "" {
+		image += ":" + ref.Tag
+	}
+
+	if ref.Digest != "" {
+		image += "@" + ref.Digest
+	}
+
+	return image
+}
+
+// WithDigest returns a copy of the reference which is pinned to the given digest.
+func (ref Reference) WithDigest(digest string) Reference {
+	ref.Digest = digest
+
+	return ref
+}
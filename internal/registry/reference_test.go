@@ -0,0 +1,117 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	for _, tt := range []struct {
+		image   string
+		want    Reference
+		wantErr error
+	}{
+		{
+			image: "nginx",
+			want:  Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "latest"},
+		},
+		{
+			image: "nginx/nginx-ingress:2.4.0",
+			want:  Reference{Registry: "docker.io", Repository: "nginx/nginx-ingress", Tag: "2.4.0"},
+		},
+		{
+			image: "quay.io/jetstack/cert-manager-controller:v1.9.1",
+			want:  Reference{Registry: "quay.io", Repository: "jetstack/cert-manager-controller", Tag: "v1.9.1"},
+		},
+		{
+			image: "localhost:5000/secrets/reloader:v0.0.125",
+			want:  Reference{Registry: "localhost:5000", Repository: "secrets/reloader", Tag: "v0.0.125"},
+		},
+		{
+			image: "localhost/reloader",
+			want:  Reference{Registry: "localhost", Repository: "reloader", Tag: "latest"},
+		},
+		{
+			image: "ghcr.io/external-secrets/external-secrets@" + digest,
+			want:  Reference{Registry: "ghcr.io", Repository: "external-secrets/external-secrets", Digest: digest},
+		},
+		{
+			image: "ghcr.io/external-secrets/external-secrets:v0.6.1@" + digest,
+			want:  Reference{Registry: "ghcr.io", Repository: "external-secrets/external-secrets", Tag: "v0.6.1", Digest: digest},
+		},
+		{
+			image:   "ghcr.io/external-secrets/external-secrets@sha256:abc",
+			wantErr: ErrInvalidReference,
+		},
+		{
+			image:   "ghcr.io/external-secrets/external-secrets@md5:" + digest[7:],
+			wantErr: ErrInvalidReference,
+		},
+		{
+			image:   ":latest",
+			wantErr: ErrInvalidReference,
+		},
+		{
+			image:   "quay.io/Jetstack/cert-manager-controller",
+			wantErr: ErrInvalidReference,
+		},
+		{
+			image:   "quay.io/jetstack/cert-manager-controller:",
+			wantErr: ErrInvalidReference,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.image, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseReference(tt.image)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseReference() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseReference() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReferenceString(t *testing.T) {
+	t.Parallel()
+
+	ref := Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "1.23"}
+	digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	if got, want := ref.String(), "docker.io/library/nginx:1.23"; got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+
+	if got, want := ref.WithDigest(digest).String(), "docker.io/library/nginx:1.23@"+digest; got != want {
+		t.Errorf("WithDigest().String() = %s, want %s", got, want)
+	}
+
+	if ref.Digest != "" {
+		t.Errorf("WithDigest() modified the original reference")
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registrytest provides an in-process OCI registry with helpers to push images and
+// cosign signatures, for use in tests.
+package registrytest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+)
+
+const (
+	manifestMediaType   = "application/vnd.oci.image.manifest.v1+json"
+	configMediaType     = "application/vnd.oci.image.config.v1+json"
+	layerMediaType      = "application/vnd.oci.image.layer.v1.tar+gzip"
+	signingMediaType    = "application/vnd.dev.cosign.simplesigning.v1+json"
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// Registry is an in-process registry which is served over plain HTTP.
+type Registry struct {
+	t *testing.T
+
+	// Host is the host and port of the registry, which is used as the registry of image references.
+	Host string
+}
+
+// New starts an in-process registry which is stopped when the test finishes.
+func New(t *testing.T) *Registry {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	return &Registry{t: t, Host: strings.TrimPrefix(server.URL, "http://")}
+}
+
+// PushImage pushes a single layer image with the given content to the repository under the tag
+// and returns the digest of its manifest.
+func (registry *Registry) PushImage(repository, tag, content string) string {
+	registry.t.Helper()
+
+	layer := []byte(content)
+
+	return registry.pushManifest(repository, tag, registry.manifest(repository, layerMediaType, layer, nil))
+}
+
+// Sign pushes a cosign signature of the image with the given digest, signed by the key, to the
+// repository.  The signed payload refers to the signed digest, which may differ from the digest
+// of the image to simulate a signature which was made for another image.
+func (registry *Registry) Sign(repository, digest, signedDigest string, key crypto.Signer) {
+	registry.t.Helper()
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"critical": map[string]interface{}{
+			"identity": map[string]interface{}{"docker-reference": registry.Host + "/" + repository},
+			"image":    map[string]interface{}{"docker-manifest-digest": signedDigest},
+			"type":     "cosign container image signature",
+		},
+		"optional": nil,
+	})
+	if err != nil {
+		registry.t.Fatalf("unable to marshal signature payload, %v", err)
+	}
+
+	hash := sha256.Sum256(payload)
+
+	signature, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	if err != nil {
+		registry.t.Fatalf("unable to sign payload, %v", err)
+	}
+
+	annotations := map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(signature)}
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+
+	registry.pushManifest(repository, tag, registry.manifest(repository, signingMediaType, payload, annotations))
+}
+
+// GenerateKey generates an ecdsa key pair as generated by cosign and returns the private key and
+// the PEM encoded public key.
+func GenerateKey(t *testing.T) (crypto.Signer, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key, %v", err)
+	}
+
+	public, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatalf("unable to marshal public key, %v", err)
+	}
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public}))
+}
+
+// manifest pushes an empty config and the layer and returns a manifest which refers to them.
+func (registry *Registry) manifest(
+	repository string,
+	mediaType string,
+	layer []byte,
+	annotations map[string]string,
+) []byte {
+	registry.t.Helper()
+
+	config := []byte("{}")
+
+	manifest, err := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     manifestMediaType,
+		"config": map[string]interface{}{
+			"mediaType": configMediaType,
+			"digest":    registry.pushBlob(repository, config),
+			"size":      len(config),
+		},
+		"layers": []interface{}{
+			map[string]interface{}{
+				"mediaType":   mediaType,
+				"digest":      registry.pushBlob(repository, layer),
+				"size":        len(layer),
+				"annotations": annotations,
+			},
+		},
+	})
+	if err != nil {
+		registry.t.Fatalf("unable to marshal manifest, %v", err)
+	}
+
+	return manifest
+}
+
+// pushBlob pushes a blob to the repository and returns its digest.
+func (registry *Registry) pushBlob(repository string, blob []byte) string {
+	registry.t.Helper()
+
+	digest := Digest(blob)
+
+	response := registry.do(http.MethodPost, "/v2/"+repository+"/blobs/uploads/", nil, "")
+	location := response.Header.Get("Location")
+
+	if !strings.HasPrefix(location, "http") {
+		location = "http://" + registry.Host + location
+	}
+
+	registry.do(http.MethodPut, location+"?digest="+digest, blob, "application/octet-stream")
+
+	return digest
+}
+
+// pushManifest pushes a manifest to the repository under the tag and returns its digest.
+func (registry *Registry) pushManifest(repository, tag string, manifest []byte) string {
+	registry.t.Helper()
+
+	registry.do(http.MethodPut, "/v2/"+repository+"/manifests/"+tag, manifest, manifestMediaType)
+
+	return Digest(manifest)
+}
+
+// do performs a request against the registry and fails the test if it is not successful.
+func (registry *Registry) do(method, path string, body []byte, contentType string) *http.Response {
+	registry.t.Helper()
+
+	endpoint := path
+	if !strings.HasPrefix(endpoint, "http") {
+		endpoint = "http://" + registry.Host + path
+	}
+
+	request, err := http.NewRequest(method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		registry.t.Fatalf("unable to create request for %s, %v", endpoint, err)
+	}
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		registry.t.Fatalf("unable to perform request to %s, %v", endpoint, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		message, _ := io.ReadAll(response.Body)
+		registry.t.Fatalf("unexpected status %s from %s %s: %s", response.Status, method, endpoint, message)
+	}
+
+	return response
+}
+
+// Digest returns the sha256 digest of the content.
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return fmt.Sprintf("sha256:%s", hex.EncodeToString(sum[:]))
+}
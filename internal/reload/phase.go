@@ -0,0 +1,103 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reload
+
+import (
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretAnnotation is the annotation which instructs reloader to restart a workload when any of
+// the comma separated secrets changes.
+const SecretAnnotation = "secret.reloader.stakater.com/reload"
+
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;patch
+
+// StatefulSetsPhase copies the reloader annotation of the custom resources of a workload to the
+// statefulsets which the operators of the custom resources create for them.  Reloader only acts
+// on the annotations of the statefulsets themselves, which the operators do not propagate from
+// their custom resources.  The phase requeues until the operators have created the statefulsets.
+func StatefulSetsPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	resources, err := r.GetResources(req)
+	if err != nil {
+		return false, fmt.Errorf("unable to get resources, %w", err)
+	}
+
+	for _, resource := range resources {
+		secrets := resource.GetAnnotations()[SecretAnnotation]
+		if secrets == "" {
+			continue
+		}
+
+		statefulSets := &appsv1.StatefulSetList{}
+		if err := r.List(req.Context, statefulSets, client.InNamespace(resource.GetNamespace())); err != nil {
+			return false, fmt.Errorf("unable to list statefulsets in namespace %s, %w", resource.GetNamespace(), err)
+		}
+
+		created := false
+
+		for i := range statefulSets.Items {
+			statefulSet := &statefulSets.Items[i]
+
+			if !ownedBy(statefulSet, resource) {
+				continue
+			}
+
+			created = true
+
+			if statefulSet.Annotations[SecretAnnotation] == secrets {
+				continue
+			}
+
+			patch := client.MergeFrom(statefulSet.DeepCopy())
+			metav1.SetMetaDataAnnotation(&statefulSet.ObjectMeta, SecretAnnotation, secrets)
+
+			if err := r.Patch(req.Context, statefulSet, patch); err != nil {
+				return false, fmt.Errorf("unable to annotate statefulset %s, %w", statefulSet.Name, err)
+			}
+		}
+
+		if !created {
+			req.Log.Info(
+				"waiting for statefulsets to be created",
+				"kind", resource.GetObjectKind().GroupVersionKind().Kind,
+				"name", resource.GetName(),
+			)
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ownedBy returns whether the statefulset is owned by the given resource.
+func ownedBy(statefulSet *appsv1.StatefulSet, resource client.Object) bool {
+	gvk := resource.GetObjectKind().GroupVersionKind()
+
+	for _, owner := range statefulSet.OwnerReferences {
+		if owner.APIVersion == gvk.GroupVersion().String() && owner.Kind == gvk.Kind && owner.Name == resource.GetName() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reload_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	applicationv1alpha1 "github.com/nukleros/support-services-operator/apis/application/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/reload"
+)
+
+// unimplemented satisfies the methods of a reconciler which are not used by the phase.
+type unimplemented struct {
+	workload.Reconciler
+}
+
+// reconciler is a reconciler which generates the given resources.
+type reconciler struct {
+	unimplemented
+	client.Client
+
+	resources []client.Object
+}
+
+func (r *reconciler) GetResources(*workload.Request) ([]client.Object, error) {
+	return r.resources, nil
+}
+
+func redisCluster() *unstructured.Unstructured {
+	redis := &unstructured.Unstructured{}
+	redis.SetAPIVersion("redis.redis.opstreelabs.in/v1beta1")
+	redis.SetKind("RedisCluster")
+	redis.SetName("redisinstance-sample")
+	redis.SetNamespace("default")
+	redis.SetAnnotations(map[string]string{reload.SecretAnnotation: "redisinstance-sample-connection"})
+
+	return redis
+}
+
+func statefulSet(name, owner string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "redis.redis.opstreelabs.in/v1beta1", Kind: "RedisCluster", Name: owner, UID: "redis"},
+			},
+		},
+	}
+}
+
+func TestStatefulSetsPhase(t *testing.T) {
+	t.Parallel()
+
+	instance := &applicationv1alpha1.RedisInstance{ObjectMeta: metav1.ObjectMeta{Name: "redisinstance-sample", Namespace: "default"}}
+	req := &workload.Request{Context: context.Background(), Workload: instance, Log: logr.Discard()}
+
+	r := &reconciler{
+		Client:    fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		resources: []client.Object{redisCluster()},
+	}
+
+	// the phase waits until the redis operator has created the statefulsets
+	proceed, err := reload.StatefulSetsPhase(r, req)
+	if err != nil || proceed {
+		t.Fatalf("StatefulSetsPhase() = %t, %v, want to requeue", proceed, err)
+	}
+
+	for _, object := range []client.Object{
+		statefulSet("redisinstance-sample-leader", "redisinstance-sample"),
+		statefulSet("redisinstance-sample-follower", "redisinstance-sample"),
+		statefulSet("other-leader", "other"),
+	} {
+		if err := r.Create(req.Context, object); err != nil {
+			t.Fatalf("unable to create statefulset, %v", err)
+		}
+	}
+
+	proceed, err = reload.StatefulSetsPhase(r, req)
+	if err != nil || !proceed {
+		t.Fatalf("StatefulSetsPhase() = %t, %v, want to proceed", proceed, err)
+	}
+
+	for name, want := range map[string]string{
+		"redisinstance-sample-leader":   "redisinstance-sample-connection",
+		"redisinstance-sample-follower": "redisinstance-sample-connection",
+		"other-leader":                  "",
+	} {
+		got := &appsv1.StatefulSet{}
+		if err := r.Get(req.Context, client.ObjectKey{Name: name, Namespace: "default"}, got); err != nil {
+			t.Fatalf("unable to get statefulset %s, %v", name, err)
+		}
+
+		if annotation := got.Annotations[reload.SecretAnnotation]; annotation != want {
+			t.Errorf("statefulset %s annotation = %q, want %q", name, annotation, want)
+		}
+	}
+}
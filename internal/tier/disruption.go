@@ -0,0 +1,83 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tier
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// ProtectsWorkload returns whether the named workload of a component is protected with a pod
+// disruption budget.  Workloads are only protected when the tier of the collection uses pod
+// disruption budgets and the workload runs more than one replica, as a budget for a single replica
+// would block node drains entirely.
+func ProtectsWorkload(collection *setupv1alpha1.SupportServices, component Component, name string) (bool, error) {
+	profile, err := ForCollection(collection)
+	if err != nil {
+		return false, err
+	}
+
+	return profile.UsesPodDisruptionBudgets() && component.EffectiveReplicas(profile.TierProfileSpec)[name] > 1, nil
+}
+
+// BudgetedComponent is a component which protects some of its workloads with pod disruption
+// budgets.
+type BudgetedComponent interface {
+	// PodDisruptionBudgets returns the pod disruption budget settings of the workloads of the
+	// component which are protected once they run more than one replica, keyed by the workload
+	// name.
+	PodDisruptionBudgets() map[string]setupv1alpha1.PodDisruptionBudgetSpec
+}
+
+// EffectiveBudgets returns the pod disruption budgets which protect the workloads of a component,
+// keyed by the workload name, with the budget of the workload taking precedence over that of the
+// collection.  The budget is reported as it is applied, i.e. with only one of minAvailable and
+// maxUnavailable set.
+func EffectiveBudgets(
+	collection *setupv1alpha1.SupportServices,
+	profile setupv1alpha1.TierProfileSpec,
+	component Component,
+) map[string]setupv1alpha1.PodDisruptionBudgetSpec {
+	budgeted, ok := component.(BudgetedComponent)
+	if !ok || !profile.UsesPodDisruptionBudgets() {
+		return nil
+	}
+
+	replicas := component.EffectiveReplicas(profile)
+	budgets := map[string]setupv1alpha1.PodDisruptionBudgetSpec{}
+
+	for name, budget := range budgeted.PodDisruptionBudgets() {
+		if replicas[name] <= 1 {
+			continue
+		}
+
+		budget = collection.Spec.PodDisruptionBudget.Override(budget)
+
+		switch {
+		case budget.MinAvailable != nil:
+			budget.MaxUnavailable = nil
+		case budget.MaxUnavailable == nil:
+			maxUnavailable := intstr.FromInt(1)
+			budget.MaxUnavailable = &maxUnavailable
+		}
+
+		budgets[name] = budget
+	}
+
+	return budgets
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tier
+
+import (
+	"fmt"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+// Component is a workload whose settings are defaulted from the tier of its collection.
+type Component interface {
+	workload.Workload
+
+	GetEffectiveSettings() *setupv1alpha1.EffectiveSettings
+	SetEffectiveSettings(settings *setupv1alpha1.EffectiveSettings)
+	EffectiveReplicas(profile setupv1alpha1.TierProfileSpec) map[string]int
+}
+
+// ResolvePhase resolves the tier profile of a collection and records it in the status of the
+// collection, where it is picked up by the components of the collection.
+func ResolvePhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	collection, ok := req.Workload.(*setupv1alpha1.SupportServices)
+	if !ok {
+		return false, fmt.Errorf("unable to resolve tier of %s, not a collection", req.Workload.GetWorkloadGVK().Kind)
+	}
+
+	profile, err := Resolve(req.Context, r, collection.Spec.Tier)
+	if err != nil {
+		return false, err
+	}
+
+	if equality.Semantic.DeepEqual(collection.Status.TierProfile, &profile) {
+		return true, nil
+	}
+
+	collection.Status.TierProfile = &profile
+
+	if err := r.Status().Update(req.Context, collection); err != nil {
+		return false, fmt.Errorf("unable to update tier profile for %s, %w", collection.GetName(), err)
+	}
+
+	return true, nil
+}
+
+// EffectiveSettingsPhase records the settings which are in effect for a component after merging
+// the tier profile of its collection with its spec.
+func EffectiveSettingsPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	component, ok := req.Workload.(Component)
+	if !ok {
+		return true, nil
+	}
+
+	collection, ok := req.Collection.(*setupv1alpha1.SupportServices)
+	if !ok {
+		return true, nil
+	}
+
+	profile, err := ForCollection(collection)
+	if err != nil {
+		return false, err
+	}
+
+	effective := &setupv1alpha1.EffectiveSettings{
+		TierProfile:          profile,
+		Replicas:             component.EffectiveReplicas(profile.TierProfileSpec),
+		PodDisruptionBudgets: EffectiveBudgets(collection, profile.TierProfileSpec, component),
+		ResourcePercent:      profile.ResourcePercent,
+		LogLevel:             profile.LogLevel,
+		Issuer:               profile.Issuer,
+	}
+
+	if equality.Semantic.DeepEqual(component.GetEffectiveSettings(), effective) {
+		return true, nil
+	}
+
+	component.SetEffectiveSettings(effective)
+
+	if err := r.Status().Update(req.Context, component); err != nil {
+		return false, fmt.Errorf("unable to update effective settings for %s, %w", component.GetWorkloadGVK().Kind, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tier_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	platformv1alpha1 "github.com/nukleros/support-services-operator/apis/platform/v1alpha1"
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+	"github.com/nukleros/support-services-operator/internal/tier"
+)
+
+// unimplemented satisfies the methods of a reconciler which are not used by the phase.
+type unimplemented struct {
+	workload.Reconciler
+}
+
+// reconciler is a reconciler which only provides access to the cluster.
+type reconciler struct {
+	unimplemented
+	client.Client
+}
+
+func TestEffectiveSettingsPhase(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := platformv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add scheme, %v", err)
+	}
+
+	collectionBudget := intstr.FromInt(2)
+	componentBudget := intstr.FromString("50%")
+
+	collection := &setupv1alpha1.SupportServices{
+		ObjectMeta: metav1.ObjectMeta{Name: "supportservices-sample"},
+		Spec: setupv1alpha1.SupportServicesSpec{
+			Tier:                tier.Production,
+			PodDisruptionBudget: setupv1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: &collectionBudget},
+		},
+	}
+
+	component := &platformv1alpha1.SecretsComponent{ObjectMeta: metav1.ObjectMeta{Name: "secretscomponent-sample"}}
+	component.Spec.ExternalSecrets.Controller.PodDisruptionBudget.MinAvailable = &componentBudget
+	component.Spec.ExternalSecrets.Webhook.Replicas = 1
+
+	r := &reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(component).Build()}
+	req := &workload.Request{Context: context.Background(), Workload: component, Collection: collection, Log: logr.Discard()}
+
+	proceed, err := tier.EffectiveSettingsPhase(r, req)
+	if err != nil || !proceed {
+		t.Fatalf("EffectiveSettingsPhase() = %t, %v, want to proceed", proceed, err)
+	}
+
+	persisted := &platformv1alpha1.SecretsComponent{}
+	if err := r.Get(req.Context, client.ObjectKeyFromObject(component), persisted); err != nil {
+		t.Fatalf("unable to get component, %v", err)
+	}
+
+	effective := persisted.Status.Effective
+	if effective == nil {
+		t.Fatalf("effective settings were not recorded")
+	}
+
+	if effective.TierProfile.Name != tier.Production {
+		t.Errorf("tier profile = %s, want %s", effective.TierProfile.Name, tier.Production)
+	}
+
+	if effective.ResourcePercent != 200 || effective.LogLevel != tier.LogLevelInfo || effective.Issuer != tier.IssuerProduction {
+		t.Errorf(
+			"resourcePercent, logLevel, issuer = %d, %s, %s, want 200, %s, %s",
+			effective.ResourcePercent, effective.LogLevel, effective.Issuer, tier.LogLevelInfo, tier.IssuerProduction,
+		)
+	}
+
+	// the webhook runs a single replica, so it is not protected
+	want := map[string]setupv1alpha1.PodDisruptionBudgetSpec{
+		"external-secrets":                 {MinAvailable: &componentBudget},
+		"external-secrets-cert-controller": {MaxUnavailable: &collectionBudget},
+	}
+
+	if !reflect.DeepEqual(effective.PodDisruptionBudgets, want) {
+		t.Errorf("podDisruptionBudgets = %+v, want %+v", effective.PodDisruptionBudgets, want)
+	}
+}
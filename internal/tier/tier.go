@@ -0,0 +1,152 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	setupv1alpha1 "github.com/nukleros/support-services-operator/apis/setup/v1alpha1"
+)
+
+const (
+	Development = "development"
+	Staging     = "staging"
+	Production  = "production"
+)
+
+const (
+	IssuerStaging    = "letsencrypt-staging"
+	IssuerProduction = "letsencrypt-production"
+)
+
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+const (
+	PolicyModeEnforce = "enforce"
+	PolicyModeAudit   = "audit"
+)
+
+var (
+	ErrUnknownTier    = errors.New("unknown tier")
+	ErrUnresolvedTier = errors.New("tier has not been resolved")
+)
+
+// profiles are the profiles of the built-in tiers.  The manifests are sized for staging, with
+// development clusters getting less and production clusters getting more.
+var profiles = map[string]setupv1alpha1.TierProfileSpec{
+	Development: {
+		Replicas:             1,
+		ResourcePercent:      50,
+		PodDisruptionBudgets: boolPtr(false),
+		LogLevel:             LogLevelDebug,
+		Issuer:               IssuerStaging,
+		PolicyMode:           PolicyModeAudit,
+	},
+	Staging: {
+		Replicas:             2,
+		ResourcePercent:      100,
+		PodDisruptionBudgets: boolPtr(true),
+		LogLevel:             LogLevelInfo,
+		Issuer:               IssuerStaging,
+		PolicyMode:           PolicyModeAudit,
+	},
+	Production: {
+		Replicas:             3,
+		ResourcePercent:      200,
+		PodDisruptionBudgets: boolPtr(true),
+		LogLevel:             LogLevelInfo,
+		Issuer:               IssuerProduction,
+		PolicyMode:           PolicyModeEnforce,
+	},
+}
+
+// +kubebuilder:rbac:groups=setup.addons.nukleros.io,resources=tierprofiles,verbs=get;list;watch
+
+// Resolve returns the profile of the named tier.  A TierProfile with the name of the tier takes
+// precedence over the built-in tier of the same name, with any settings which it does not set
+// taken from the built-in tier, or from the staging tier for custom tiers.
+func Resolve(ctx context.Context, reader client.Reader, name string) (setupv1alpha1.ResolvedTierProfile, error) {
+	builtin, isBuiltin := profiles[name]
+
+	custom := &setupv1alpha1.TierProfile{}
+
+	if err := reader.Get(ctx, types.NamespacedName{Name: name}, custom); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return setupv1alpha1.ResolvedTierProfile{}, fmt.Errorf("unable to get tier profile %s, %w", name, err)
+		}
+
+		if !isBuiltin {
+			return setupv1alpha1.ResolvedTierProfile{}, fmt.Errorf("%w; %s is neither a built-in tier nor a tier profile", ErrUnknownTier, name)
+		}
+
+		return setupv1alpha1.ResolvedTierProfile{Name: name, TierProfileSpec: builtin}, nil
+	}
+
+	if !isBuiltin {
+		builtin = profiles[Staging]
+	}
+
+	return setupv1alpha1.ResolvedTierProfile{Name: name, TierProfileSpec: custom.Spec.Merge(builtin)}, nil
+}
+
+// ForCollection returns the profile of the tier of the collection.  The profile which was resolved
+// by the collection controller is preferred, so that custom tiers are honored.  Otherwise, which is
+// always the case when generating manifests from the CLI, only the built-in tiers are available.
+func ForCollection(collection *setupv1alpha1.SupportServices) (setupv1alpha1.ResolvedTierProfile, error) {
+	if resolved := collection.Status.TierProfile; resolved != nil && resolved.Name == collection.Spec.Tier {
+		return *resolved, nil
+	}
+
+	if builtin, ok := profiles[collection.Spec.Tier]; ok {
+		return setupv1alpha1.ResolvedTierProfile{Name: collection.Spec.Tier, TierProfileSpec: builtin}, nil
+	}
+
+	return setupv1alpha1.ResolvedTierProfile{}, fmt.Errorf(
+		"%w; custom tier %s is only available once resolved by collection %s",
+		ErrUnresolvedTier, collection.Spec.Tier, collection.GetName(),
+	)
+}
+
+// Verbosity returns the klog verbosity which corresponds to the given log level, for workloads
+// which are configured with a numeric verbosity rather than a log level.
+func Verbosity(level string) int {
+	switch level {
+	case LogLevelDebug:
+		return 4
+	case LogLevelWarn:
+		return 1
+	case LogLevelError:
+		return 0
+	default:
+		return 2
+	}
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
@@ -107,6 +107,16 @@ func main() {
 		platformcontrollers.NewCertificatesComponentReconciler(mgr),
 		platformcontrollers.NewIngressComponentReconciler(mgr),
 		platformcontrollers.NewSecretsComponentReconciler(mgr),
+		platformcontrollers.NewMonitoringComponentReconciler(mgr),
+		platformcontrollers.NewLoggingComponentReconciler(mgr),
+		platformcontrollers.NewStorageComponentReconciler(mgr),
+		platformcontrollers.NewPolicyComponentReconciler(mgr),
+		platformcontrollers.NewBackupComponentReconciler(mgr),
+		platformcontrollers.NewServiceMeshComponentReconciler(mgr),
+		applicationcontrollers.NewMessagingComponentReconciler(mgr),
+		applicationcontrollers.NewCacheComponentReconciler(mgr),
+		applicationcontrollers.NewRedisInstanceReconciler(mgr),
+		platformcontrollers.NewObjectStorageComponentReconciler(mgr),
 		//+kubebuilder:scaffold:reconcilers
 	}
 